@@ -15,7 +15,7 @@ from chromadb.proto import chroma_pb2 as chromadb_dot_proto_dot_chroma__pb2
 from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n chromadb/proto/coordinator.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\x1a\x1bgoogle/protobuf/empty.proto\"A\n\x15\x43reateDatabaseRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06tenant\x18\x03 \x01(\t\"&\n\x16\x43reateDatabaseResponseJ\x04\x08\x01\x10\x02R\x06status\"2\n\x12GetDatabaseRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\"G\n\x13GetDatabaseResponse\x12\"\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.DatabaseJ\x04\x08\x02\x10\x03R\x06status\"#\n\x13\x43reateTenantRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\"$\n\x14\x43reateTenantResponseJ\x04\x08\x01\x10\x02R\x06status\" \n\x10GetTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"A\n\x11GetTenantResponse\x12\x1e\n\x06tenant\x18\x01 \x01(\x0b\x32\x0e.chroma.TenantJ\x04\x08\x02\x10\x03R\x06status\"8\n\x14\x43reateSegmentRequest\x12 \n\x07segment\x18\x01 \x01(\x0b\x32\x0f.chroma.Segment\"%\n\x15\x43reateSegmentResponseJ\x04\x08\x01\x10\x02R\x06status\"6\n\x14\x44\x65leteSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\ncollection\x18\x02 \x01(\t\"%\n\x15\x44\x65leteSegmentResponseJ\x04\x08\x01\x10\x02R\x06status\"\x90\x01\n\x12GetSegmentsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04type\x18\x02 \x01(\tH\x01\x88\x01\x01\x12(\n\x05scope\x18\x03 \x01(\x0e\x32\x14.chroma.SegmentScopeH\x02\x88\x01\x01\x12\x12\n\ncollection\x18\x04 \x01(\tB\x05\n\x03_idB\x07\n\x05_typeB\x08\n\x06_scope\"F\n\x13GetSegmentsResponse\x12!\n\x08segments\x18\x01 \x03(\x0b\x32\x0f.chroma.SegmentJ\x04\x08\x02\x10\x03R\x06status\"\x8f\x01\n\x14UpdateSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\ncollection\x18\x04 \x01(\t\x12*\n\x08metadata\x18\x06 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x07 \x01(\x08H\x00\x42\x11\n\x0fmetadata_update\"%\n\x15UpdateSegmentResponseJ\x04\x08\x01\x10\x02R\x06status\"\xa8\x02\n\x17\x43reateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x1e\n\x16\x63onfiguration_json_str\x18\x03 \x01(\t\x12-\n\x08metadata\x18\x04 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x88\x01\x01\x12\x16\n\tdimension\x18\x05 \x01(\x05H\x01\x88\x01\x01\x12\x1a\n\rget_or_create\x18\x06 \x01(\x08H\x02\x88\x01\x01\x12\x0e\n\x06tenant\x18\x07 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x08 \x01(\t\x12!\n\x08segments\x18\t \x03(\x0b\x32\x0f.chroma.SegmentB\x0b\n\t_metadataB\x0c\n\n_dimensionB\x10\n\x0e_get_or_create\"a\n\x18\x43reateCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\x12\x0f\n\x07\x63reated\x18\x02 \x01(\x08J\x04\x08\x03\x10\x04R\x06status\"\\\n\x17\x44\x65leteCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x03 \x01(\t\x12\x13\n\x0bsegment_ids\x18\x04 \x03(\t\"(\n\x18\x44\x65leteCollectionResponseJ\x04\x08\x01\x10\x02R\x06status\"\xab\x01\n\x15GetCollectionsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04name\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x0e\n\x06tenant\x18\x04 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x05 \x01(\t\x12\x12\n\x05limit\x18\x06 \x01(\x05H\x02\x88\x01\x01\x12\x13\n\x06offset\x18\x07 \x01(\x05H\x03\x88\x01\x01\x42\x05\n\x03_idB\x07\n\x05_nameB\x08\n\x06_limitB\t\n\x07_offset\"O\n\x16GetCollectionsResponse\x12\'\n\x0b\x63ollections\x18\x01 \x03(\x0b\x32\x12.chroma.CollectionJ\x04\x08\x02\x10\x03R\x06status\"\xc0\x01\n\x17UpdateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\x04name\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x16\n\tdimension\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12*\n\x08metadata\x18\x05 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x06 \x01(\x08H\x00\x42\x11\n\x0fmetadata_updateB\x07\n\x05_nameB\x0c\n\n_dimension\"(\n\x18UpdateCollectionResponseJ\x04\x08\x01\x10\x02R\x06status\"\"\n\x12ResetStateResponseJ\x04\x08\x01\x10\x02R\x06status\":\n%GetLastCompactionTimeForTenantRequest\x12\x11\n\ttenant_id\x18\x01 \x03(\t\"K\n\x18TenantLastCompactionTime\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x1c\n\x14last_compaction_time\x18\x02 \x01(\x03\"o\n&GetLastCompactionTimeForTenantResponse\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x03(\x0b\x32 .chroma.TenantLastCompactionTime\"n\n%SetLastCompactionTimeForTenantRequest\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x01(\x0b\x32 .chroma.TenantLastCompactionTime\"\xbc\x01\n\x1a\x46lushSegmentCompactionInfo\x12\x12\n\nsegment_id\x18\x01 \x01(\t\x12\x45\n\nfile_paths\x18\x02 \x03(\x0b\x32\x31.chroma.FlushSegmentCompactionInfo.FilePathsEntry\x1a\x43\n\x0e\x46ilePathsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.chroma.FilePaths:\x02\x38\x01\"\xc3\x01\n FlushCollectionCompactionRequest\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x14\n\x0clog_position\x18\x03 \x01(\x03\x12\x1a\n\x12\x63ollection_version\x18\x04 \x01(\x05\x12\x43\n\x17segment_compaction_info\x18\x05 \x03(\x0b\x32\".chroma.FlushSegmentCompactionInfo\"t\n!FlushCollectionCompactionResponse\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x1a\n\x12\x63ollection_version\x18\x02 \x01(\x05\x12\x1c\n\x14last_compaction_time\x18\x03 \x01(\x03\x32\xf4\n\n\x05SysDB\x12Q\n\x0e\x43reateDatabase\x12\x1d.chroma.CreateDatabaseRequest\x1a\x1e.chroma.CreateDatabaseResponse\"\x00\x12H\n\x0bGetDatabase\x12\x1a.chroma.GetDatabaseRequest\x1a\x1b.chroma.GetDatabaseResponse\"\x00\x12K\n\x0c\x43reateTenant\x12\x1b.chroma.CreateTenantRequest\x1a\x1c.chroma.CreateTenantResponse\"\x00\x12\x42\n\tGetTenant\x12\x18.chroma.GetTenantRequest\x1a\x19.chroma.GetTenantResponse\"\x00\x12N\n\rCreateSegment\x12\x1c.chroma.CreateSegmentRequest\x1a\x1d.chroma.CreateSegmentResponse\"\x00\x12N\n\rDeleteSegment\x12\x1c.chroma.DeleteSegmentRequest\x1a\x1d.chroma.DeleteSegmentResponse\"\x00\x12H\n\x0bGetSegments\x12\x1a.chroma.GetSegmentsRequest\x1a\x1b.chroma.GetSegmentsResponse\"\x00\x12N\n\rUpdateSegment\x12\x1c.chroma.UpdateSegmentRequest\x1a\x1d.chroma.UpdateSegmentResponse\"\x00\x12W\n\x10\x43reateCollection\x12\x1f.chroma.CreateCollectionRequest\x1a .chroma.CreateCollectionResponse\"\x00\x12W\n\x10\x44\x65leteCollection\x12\x1f.chroma.DeleteCollectionRequest\x1a .chroma.DeleteCollectionResponse\"\x00\x12Q\n\x0eGetCollections\x12\x1d.chroma.GetCollectionsRequest\x1a\x1e.chroma.GetCollectionsResponse\"\x00\x12W\n\x10UpdateCollection\x12\x1f.chroma.UpdateCollectionRequest\x1a .chroma.UpdateCollectionResponse\"\x00\x12\x42\n\nResetState\x12\x16.google.protobuf.Empty\x1a\x1a.chroma.ResetStateResponse\"\x00\x12\x81\x01\n\x1eGetLastCompactionTimeForTenant\x12-.chroma.GetLastCompactionTimeForTenantRequest\x1a..chroma.GetLastCompactionTimeForTenantResponse\"\x00\x12i\n\x1eSetLastCompactionTimeForTenant\x12-.chroma.SetLastCompactionTimeForTenantRequest\x1a\x16.google.protobuf.Empty\"\x00\x12r\n\x19\x46lushCollectionCompaction\x12(.chroma.FlushCollectionCompactionRequest\x1a).chroma.FlushCollectionCompactionResponse\"\x00\x42:Z8github.com/chroma-core/chroma/go/pkg/proto/coordinatorpbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n chromadb/proto/coordinator.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\x1a\x1bgoogle/protobuf/empty.proto\"}\n\x15\x43reateDatabaseRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06tenant\x18\x03 \x01(\t\x12-\n\x08metadata\x18\x04 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x88\x01\x01\x42\x0b\n\t_metadata\"&\n\x16\x43reateDatabaseResponseJ\x04\x08\x01\x10\x02R\x06status\"2\n\x12GetDatabaseRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\"G\n\x13GetDatabaseResponse\x12\"\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.DatabaseJ\x04\x08\x02\x10\x03R\x06status\"\x96\x01\n\x14ListDatabasesRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x18\n\x0bname_prefix\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05limit\x18\x03 \x01(\x05H\x01\x88\x01\x01\x12\x17\n\npage_token\x18\x04 \x01(\tH\x02\x88\x01\x01\x42\x0e\n\x0c_name_prefixB\x08\n\x06_limitB\r\n\x0b_page_token\"U\n\x15ListDatabasesResponse\x12#\n\tdatabases\x18\x01 \x03(\x0b\x32\x10.chroma.Database\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\xa6\x01\n\x15UpdateDatabaseRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12*\n\x08metadata\x18\x03 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x04 \x01(\x08H\x00\x12\x16\n\x0emerge_metadata\x18\x05 \x01(\x08\x42\x11\n\x0fmetadata_update\"<\n\x16UpdateDatabaseResponse\x12\"\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.Database\"5\n\x15\x44\x65leteDatabaseRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\"\x18\n\x16\x44\x65leteDatabaseResponse\"_\n\x13\x43reateTenantRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\x12-\n\x08metadata\x18\x03 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x88\x01\x01\x42\x0b\n\t_metadata\"$\n\x14\x43reateTenantResponseJ\x04\x08\x01\x10\x02R\x06status\" \n\x10GetTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"A\n\x11GetTenantResponse\x12\x1e\n\x06tenant\x18\x01 \x01(\x0b\x32\x0e.chroma.TenantJ\x04\x08\x02\x10\x03R\x06status\"\x84\x01\n\x12ListTenantsRequest\x12\x18\n\x0bname_prefix\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05limit\x18\x02 \x01(\x05H\x01\x88\x01\x01\x12\x17\n\npage_token\x18\x03 \x01(\tH\x02\x88\x01\x01\x42\x0e\n\x0c_name_prefixB\x08\n\x06_limitB\r\n\x0b_page_token\"O\n\x13ListTenantsResponse\x12\x1f\n\x07tenants\x18\x01 \x03(\x0b\x32\x0e.chroma.Tenant\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\x94\x01\n\x13UpdateTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x08metadata\x18\x02 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x03 \x01(\x08H\x00\x12\x16\n\x0emerge_metadata\x18\x04 \x01(\x08\x42\x11\n\x0fmetadata_update\"6\n\x14UpdateTenantResponse\x12\x1e\n\x06tenant\x18\x01 \x01(\x0b\x32\x0e.chroma.Tenant\"#\n\x13\x44\x65leteTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x16\n\x14\x44\x65leteTenantResponse\"$\n\x14SuspendTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x17\n\x15SuspendTenantResponse\"#\n\x13ResumeTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x16\n\x14ResumeTenantResponse\"\xe1\x02\n\x0bTenantQuota\x12\x1a\n\rmax_databases\x18\x01 \x01(\x05H\x00\x88\x01\x01\x12)\n\x1cmax_collections_per_database\x18\x02 \x01(\x05H\x01\x88\x01\x01\x12-\n max_metadata_keys_per_collection\x18\x03 \x01(\x05H\x02\x88\x01\x01\x12$\n\x17max_metadata_value_size\x18\x04 \x01(\x05H\x03\x88\x01\x01\x12%\n\x18max_collection_dimension\x18\x05 \x01(\x05H\x04\x88\x01\x01\x42\x10\n\x0e_max_databasesB\x1f\n\x1d_max_collections_per_databaseB#\n!_max_metadata_keys_per_collectionB\x1a\n\x18_max_metadata_value_sizeB\x1b\n\x19_max_collection_dimension\"\'\n\x15GetTenantQuotaRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\"y\n\x16GetTenantQuotaResponse\x12\'\n\x05quota\x18\x01 \x01(\x0b\x32\x13.chroma.TenantQuotaH\x00\x88\x01\x01\x12,\n\x0f\x65\x66\x66\x65\x63tive_quota\x18\x02 \x01(\x0b\x32\x13.chroma.TenantQuotaB\x08\n\x06_quota\"K\n\x15SetTenantQuotaRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\"\n\x05quota\x18\x02 \x01(\x0b\x32\x13.chroma.TenantQuota\"F\n\x16SetTenantQuotaResponse\x12,\n\x0f\x65\x66\x66\x65\x63tive_quota\x18\x01 \x01(\x0b\x32\x13.chroma.TenantQuota\"8\n\x14\x43reateSegmentRequest\x12 \n\x07segment\x18\x01 \x01(\x0b\x32\x0f.chroma.Segment\"%\n\x15\x43reateSegmentResponseJ\x04\x08\x01\x10\x02R\x06status\"6\n\x14\x44\x65leteSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\ncollection\x18\x02 \x01(\t\"%\n\x15\x44\x65leteSegmentResponseJ\x04\x08\x01\x10\x02R\x06status\"\x90\x01\n\x12GetSegmentsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04type\x18\x02 \x01(\tH\x01\x88\x01\x01\x12(\n\x05scope\x18\x03 \x01(\x0e\x32\x14.chroma.SegmentScopeH\x02\x88\x01\x01\x12\x12\n\ncollection\x18\x04 \x01(\tB\x05\n\x03_idB\x07\n\x05_typeB\x08\n\x06_scope\"F\n\x13GetSegmentsResponse\x12!\n\x08segments\x18\x01 \x03(\x0b\x32\x0f.chroma.SegmentJ\x04\x08\x02\x10\x03R\x06status\"\xcb\x01\n\x14UpdateSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\ncollection\x18\x04 \x01(\t\x12*\n\x08metadata\x18\x06 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x07 \x01(\x08H\x00\x12!\n\x14\x65xpected_row_version\x18\x08 \x01(\x03H\x01\x88\x01\x01\x42\x11\n\x0fmetadata_updateB\x17\n\x15_expected_row_version\":\n\x15UpdateSegmentResponse\x12\x13\n\x0brow_version\x18\x02 \x01(\x03J\x04\x08\x01\x10\x02R\x06status\"\xa8\x02\n\x17\x43reateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x1e\n\x16\x63onfiguration_json_str\x18\x03 \x01(\t\x12-\n\x08metadata\x18\x04 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x88\x01\x01\x12\x16\n\tdimension\x18\x05 \x01(\x05H\x01\x88\x01\x01\x12\x1a\n\rget_or_create\x18\x06 \x01(\x08H\x02\x88\x01\x01\x12\x0e\n\x06tenant\x18\x07 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x08 \x01(\t\x12!\n\x08segments\x18\t \x03(\x0b\x32\x0f.chroma.SegmentB\x0b\n\t_metadataB\x0c\n\n_dimensionB\x10\n\x0e_get_or_create\"a\n\x18\x43reateCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\x12\x0f\n\x07\x63reated\x18\x02 \x01(\x08J\x04\x08\x03\x10\x04R\x06status\"\\\n\x17\x44\x65leteCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x03 \x01(\t\x12\x13\n\x0bsegment_ids\x18\x04 \x03(\t\"(\n\x18\x44\x65leteCollectionResponseJ\x04\x08\x01\x10\x02R\x06status\"\x80\x02\n\x15GetCollectionsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04name\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x0e\n\x06tenant\x18\x04 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x05 \x01(\t\x12\x12\n\x05limit\x18\x06 \x01(\x05H\x02\x88\x01\x01\x12\x13\n\x06offset\x18\x07 \x01(\x05H\x03\x88\x01\x01\x12\x17\n\npage_token\x18\x08 \x01(\tH\x04\x88\x01\x01\x12!\n\x05where\x18\t \x01(\x0b\x32\r.chroma.WhereH\x05\x88\x01\x01\x42\x05\n\x03_idB\x07\n\x05_nameB\x08\n\x06_limitB\t\n\x07_offsetB\r\n\x0b_page_tokenB\x08\n\x06_where\"h\n\x16GetCollectionsResponse\x12\'\n\x0b\x63ollections\x18\x01 \x03(\x0b\x32\x12.chroma.Collection\x12\x17\n\x0fnext_page_token\x18\x03 \x01(\tJ\x04\x08\x02\x10\x03R\x06status\"d\n\x18RestoreCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x03 \x01(\t\x12\x11\n\x04name\x18\x04 \x01(\tH\x00\x88\x01\x01\x42\x07\n\x05_name\"C\n\x19RestoreCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\"\x87\x01\n\x1dListDeletedCollectionsRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x02 \x01(\t\x12\x12\n\x05limit\x18\x03 \x01(\x05H\x00\x88\x01\x01\x12\x17\n\npage_token\x18\x04 \x01(\tH\x01\x88\x01\x01\x42\x08\n\x06_limitB\r\n\x0b_page_token\"O\n\x11\x44\x65letedCollection\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\x12\x12\n\ndeleted_at\x18\x02 \x01(\x03\"i\n\x1eListDeletedCollectionsResponse\x12.\n\x0b\x63ollections\x18\x01 \x03(\x0b\x32\x19.chroma.DeletedCollection\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\x95\x01\n\x15\x46orkCollectionRequest\x12\x1c\n\x14source_collection_id\x18\x01 \x01(\t\x12\x1c\n\x14target_collection_id\x18\x02 \x01(\t\x12\x1e\n\x16target_collection_name\x18\x03 \x01(\t\x12\x0e\n\x06tenant\x18\x04 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x05 \x01(\t\"@\n\x16\x46orkCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\"F\n\x19GetSharedFilePathsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nfile_paths\x18\x02 \x03(\t\"0\n\x1aGetSharedFilePathsResponse\x12\x12\n\nfile_paths\x18\x01 \x03(\t\"M\n\x17\x43ountCollectionsRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x15\n\x08\x64\x61tabase\x18\x02 \x01(\tH\x00\x88\x01\x01\x42\x0b\n\t_database\")\n\x18\x43ountCollectionsResponse\x12\r\n\x05\x63ount\x18\x01 \x01(\x04\"1\n\x17\x43heckCollectionsRequest\x12\x16\n\x0e\x63ollection_ids\x18\x01 \x03(\t\"2\n\x18\x43heckCollectionsResponse\x12\x16\n\x0e\x63ollection_ids\x18\x01 \x03(\t\"\x80\x03\n\x17UpdateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\x04name\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x16\n\tdimension\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12*\n\x08metadata\x18\x05 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x06 \x01(\x08H\x00\x12\x19\n\x0cnew_database\x18\x07 \x01(\tH\x03\x88\x01\x01\x12\x16\n\x0emerge_metadata\x18\x08 \x01(\x08\x12!\n\x14\x65xpected_row_version\x18\t \x01(\x03H\x04\x88\x01\x01\x12#\n\x16\x63onfiguration_json_str\x18\n \x01(\tH\x05\x88\x01\x01\x42\x11\n\x0fmetadata_updateB\x07\n\x05_nameB\x0c\n\n_dimensionB\x0f\n\r_new_databaseB\x17\n\x15_expected_row_versionB\x19\n\x17_configuration_json_str\"=\n\x18UpdateCollectionResponse\x12\x13\n\x0brow_version\x18\x02 \x01(\x03J\x04\x08\x01\x10\x02R\x06status\"\"\n\x12ResetStateResponseJ\x04\x08\x01\x10\x02R\x06status\":\n%GetLastCompactionTimeForTenantRequest\x12\x11\n\ttenant_id\x18\x01 \x03(\t\"K\n\x18TenantLastCompactionTime\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x1c\n\x14last_compaction_time\x18\x02 \x01(\x03\"o\n&GetLastCompactionTimeForTenantResponse\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x03(\x0b\x32 .chroma.TenantLastCompactionTime\"n\n%SetLastCompactionTimeForTenantRequest\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x01(\x0b\x32 .chroma.TenantLastCompactionTime\"\xbc\x01\n\x1a\x46lushSegmentCompactionInfo\x12\x12\n\nsegment_id\x18\x01 \x01(\t\x12\x45\n\nfile_paths\x18\x02 \x03(\x0b\x32\x31.chroma.FlushSegmentCompactionInfo.FilePathsEntry\x1a\x43\n\x0e\x46ilePathsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.chroma.FilePaths:\x02\x38\x01\"\xc3\x01\n FlushCollectionCompactionRequest\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x14\n\x0clog_position\x18\x03 \x01(\x03\x12\x1a\n\x12\x63ollection_version\x18\x04 \x01(\x05\x12\x43\n\x17segment_compaction_info\x18\x05 \x03(\x0b\x32\".chroma.FlushSegmentCompactionInfo\"t\n!FlushCollectionCompactionResponse\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x1a\n\x12\x63ollection_version\x18\x02 \x01(\x05\x12\x1c\n\x14last_compaction_time\x18\x03 \x01(\x03\"\xa8\x01\n\x10SegmentFilePaths\x12\x12\n\nsegment_id\x18\x01 \x01(\t\x12;\n\nfile_paths\x18\x02 \x03(\x0b\x32\'.chroma.SegmentFilePaths.FilePathsEntry\x1a\x43\n\x0e\x46ilePathsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.chroma.FilePaths:\x02\x38\x01\"~\n\x15\x43ollectionVersionInfo\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x14\n\x0clog_position\x18\x02 \x01(\x03\x12*\n\x08segments\x18\x03 \x03(\x0b\x32\x18.chroma.SegmentFilePaths\x12\x12\n\ncreated_at\x18\x04 \x01(\x03\"T\n\x1dListCollectionVersionsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\x05limit\x18\x02 \x01(\x05H\x00\x88\x01\x01\x42\x08\n\x06_limit\"Q\n\x1eListCollectionVersionsResponse\x12/\n\x08versions\x18\x01 \x03(\x0b\x32\x1d.chroma.CollectionVersionInfo\"E\n\x1bGetSegmentsAtVersionRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\x05\"A\n\x1cGetSegmentsAtVersionResponse\x12!\n\x08segments\x18\x01 \x03(\x0b\x32\x0f.chroma.Segment\"C\n\x19RollbackCollectionRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\x05\"L\n\x1aRollbackCollectionResponse\x12.\n\x07version\x18\x01 \x01(\x0b\x32\x1d.chroma.CollectionVersionInfo\"\x9b\x01\n\x0f\x43ollectionEvent\x12\x10\n\x08revision\x18\x01 \x01(\x03\x12)\n\x04type\x18\x02 \x01(\x0e\x32\x1b.chroma.CollectionEventType\x12\x15\n\rcollection_id\x18\x03 \x01(\t\x12\x0e\n\x06tenant\x18\x04 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x05 \x01(\t\x12\x12\n\ncreated_at\x18\x06 \x01(\x03\"\x8d\x01\n\x17WatchCollectionsRequest\x12\x13\n\x06tenant\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x15\n\x08\x64\x61tabase\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x1b\n\x0esince_revision\x18\x03 \x01(\x03H\x02\x88\x01\x01\x42\t\n\x07_tenantB\x0b\n\t_databaseB\x11\n\x0f_since_revision\"C\n\x18WatchCollectionsResponse\x12\'\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x17.chroma.CollectionEvent\"\xcb\x01\n\nAuditEvent\x12\n\n\x02id\x18\x01 \x01(\x03\x12\r\n\x05\x61\x63tor\x18\x02 \x01(\t\x12\x11\n\toperation\x18\x03 \x01(\t\x12\x11\n\ttenant_id\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x61tabase_id\x18\x05 \x01(\t\x12\x15\n\rcollection_id\x18\x06 \x01(\t\x12\x13\n\x06\x62\x65\x66ore\x18\x07 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05\x61\x66ter\x18\x08 \x01(\tH\x01\x88\x01\x01\x12\x12\n\ncreated_at\x18\t \x01(\x03\x42\t\n\x07_beforeB\x08\n\x06_after\"\xca\x01\n\x16ListAuditEventsRequest\x12\x13\n\x06tenant\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x17\n\nstart_time\x18\x02 \x01(\x03H\x01\x88\x01\x01\x12\x15\n\x08\x65nd_time\x18\x03 \x01(\x03H\x02\x88\x01\x01\x12\x12\n\x05limit\x18\x04 \x01(\x05H\x03\x88\x01\x01\x12\x17\n\npage_token\x18\x05 \x01(\tH\x04\x88\x01\x01\x42\t\n\x07_tenantB\r\n\x0b_start_timeB\x0b\n\t_end_timeB\x08\n\x06_limitB\r\n\x0b_page_token\"V\n\x17ListAuditEventsResponse\x12\"\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x12.chroma.AuditEvent\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t*\x8b\x01\n\x13\x43ollectionEventType\x12\x16\n\x12\x43OLLECTION_CREATED\x10\x00\x12\x16\n\x12\x43OLLECTION_UPDATED\x10\x01\x12\x16\n\x12\x43OLLECTION_DELETED\x10\x02\x12\x16\n\x12\x43OLLECTION_FLUSHED\x10\x03\x12\x14\n\x10\x43OLLECTION_RESET\x10\x04\x32\x9c\x19\n\x05SysDB\x12Q\n\x0e\x43reateDatabase\x12\x1d.chroma.CreateDatabaseRequest\x1a\x1e.chroma.CreateDatabaseResponse\"\x00\x12H\n\x0bGetDatabase\x12\x1a.chroma.GetDatabaseRequest\x1a\x1b.chroma.GetDatabaseResponse\"\x00\x12N\n\rListDatabases\x12\x1c.chroma.ListDatabasesRequest\x1a\x1d.chroma.ListDatabasesResponse\"\x00\x12Q\n\x0eUpdateDatabase\x12\x1d.chroma.UpdateDatabaseRequest\x1a\x1e.chroma.UpdateDatabaseResponse\"\x00\x12Q\n\x0e\x44\x65leteDatabase\x12\x1d.chroma.DeleteDatabaseRequest\x1a\x1e.chroma.DeleteDatabaseResponse\"\x00\x12K\n\x0c\x43reateTenant\x12\x1b.chroma.CreateTenantRequest\x1a\x1c.chroma.CreateTenantResponse\"\x00\x12\x42\n\tGetTenant\x12\x18.chroma.GetTenantRequest\x1a\x19.chroma.GetTenantResponse\"\x00\x12H\n\x0bListTenants\x12\x1a.chroma.ListTenantsRequest\x1a\x1b.chroma.ListTenantsResponse\"\x00\x12K\n\x0cUpdateTenant\x12\x1b.chroma.UpdateTenantRequest\x1a\x1c.chroma.UpdateTenantResponse\"\x00\x12K\n\x0c\x44\x65leteTenant\x12\x1b.chroma.DeleteTenantRequest\x1a\x1c.chroma.DeleteTenantResponse\"\x00\x12N\n\rSuspendTenant\x12\x1c.chroma.SuspendTenantRequest\x1a\x1d.chroma.SuspendTenantResponse\"\x00\x12K\n\x0cResumeTenant\x12\x1b.chroma.ResumeTenantRequest\x1a\x1c.chroma.ResumeTenantResponse\"\x00\x12Q\n\x0eGetTenantQuota\x12\x1d.chroma.GetTenantQuotaRequest\x1a\x1e.chroma.GetTenantQuotaResponse\"\x00\x12Q\n\x0eSetTenantQuota\x12\x1d.chroma.SetTenantQuotaRequest\x1a\x1e.chroma.SetTenantQuotaResponse\"\x00\x12N\n\rCreateSegment\x12\x1c.chroma.CreateSegmentRequest\x1a\x1d.chroma.CreateSegmentResponse\"\x00\x12N\n\rDeleteSegment\x12\x1c.chroma.DeleteSegmentRequest\x1a\x1d.chroma.DeleteSegmentResponse\"\x00\x12H\n\x0bGetSegments\x12\x1a.chroma.GetSegmentsRequest\x1a\x1b.chroma.GetSegmentsResponse\"\x00\x12N\n\rUpdateSegment\x12\x1c.chroma.UpdateSegmentRequest\x1a\x1d.chroma.UpdateSegmentResponse\"\x00\x12W\n\x10\x43reateCollection\x12\x1f.chroma.CreateCollectionRequest\x1a .chroma.CreateCollectionResponse\"\x00\x12W\n\x10\x44\x65leteCollection\x12\x1f.chroma.DeleteCollectionRequest\x1a .chroma.DeleteCollectionResponse\"\x00\x12Q\n\x0eGetCollections\x12\x1d.chroma.GetCollectionsRequest\x1a\x1e.chroma.GetCollectionsResponse\"\x00\x12W\n\x10\x43heckCollections\x12\x1f.chroma.CheckCollectionsRequest\x1a .chroma.CheckCollectionsResponse\"\x00\x12W\n\x10\x43ountCollections\x12\x1f.chroma.CountCollectionsRequest\x1a .chroma.CountCollectionsResponse\"\x00\x12W\n\x10UpdateCollection\x12\x1f.chroma.UpdateCollectionRequest\x1a .chroma.UpdateCollectionResponse\"\x00\x12Z\n\x11RestoreCollection\x12 .chroma.RestoreCollectionRequest\x1a!.chroma.RestoreCollectionResponse\"\x00\x12i\n\x16ListDeletedCollections\x12%.chroma.ListDeletedCollectionsRequest\x1a&.chroma.ListDeletedCollectionsResponse\"\x00\x12Q\n\x0e\x46orkCollection\x12\x1d.chroma.ForkCollectionRequest\x1a\x1e.chroma.ForkCollectionResponse\"\x00\x12]\n\x12GetSharedFilePaths\x12!.chroma.GetSharedFilePathsRequest\x1a\".chroma.GetSharedFilePathsResponse\"\x00\x12\x42\n\nResetState\x12\x16.google.protobuf.Empty\x1a\x1a.chroma.ResetStateResponse\"\x00\x12\x81\x01\n\x1eGetLastCompactionTimeForTenant\x12-.chroma.GetLastCompactionTimeForTenantRequest\x1a..chroma.GetLastCompactionTimeForTenantResponse\"\x00\x12i\n\x1eSetLastCompactionTimeForTenant\x12-.chroma.SetLastCompactionTimeForTenantRequest\x1a\x16.google.protobuf.Empty\"\x00\x12r\n\x19\x46lushCollectionCompaction\x12(.chroma.FlushCollectionCompactionRequest\x1a).chroma.FlushCollectionCompactionResponse\"\x00\x12i\n\x16ListCollectionVersions\x12%.chroma.ListCollectionVersionsRequest\x1a&.chroma.ListCollectionVersionsResponse\"\x00\x12\x63\n\x14GetSegmentsAtVersion\x12#.chroma.GetSegmentsAtVersionRequest\x1a$.chroma.GetSegmentsAtVersionResponse\"\x00\x12]\n\x12RollbackCollection\x12!.chroma.RollbackCollectionRequest\x1a\".chroma.RollbackCollectionResponse\"\x00\x12Y\n\x10WatchCollections\x12\x1f.chroma.WatchCollectionsRequest\x1a .chroma.WatchCollectionsResponse\"\x00\x30\x01\x12T\n\x0fListAuditEvents\x12\x1e.chroma.ListAuditEventsRequest\x1a\x1f.chroma.ListAuditEventsResponse\"\x00\x42:Z8github.com/chroma-core/chroma/go/pkg/proto/coordinatorpbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -26,72 +26,174 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._serialized_options = b'Z8github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb'
   _FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY._options = None
   _FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY._serialized_options = b'8\001'
+  _SEGMENTFILEPATHS_FILEPATHSENTRY._options = None
+  _SEGMENTFILEPATHS_FILEPATHSENTRY._serialized_options = b'8\001'
+  _globals['_COLLECTIONEVENTTYPE']._serialized_start=8076
+  _globals['_COLLECTIONEVENTTYPE']._serialized_end=8215
   _globals['_CREATEDATABASEREQUEST']._serialized_start=102
-  _globals['_CREATEDATABASEREQUEST']._serialized_end=167
-  _globals['_CREATEDATABASERESPONSE']._serialized_start=169
-  _globals['_CREATEDATABASERESPONSE']._serialized_end=207
-  _globals['_GETDATABASEREQUEST']._serialized_start=209
-  _globals['_GETDATABASEREQUEST']._serialized_end=259
-  _globals['_GETDATABASERESPONSE']._serialized_start=261
-  _globals['_GETDATABASERESPONSE']._serialized_end=332
-  _globals['_CREATETENANTREQUEST']._serialized_start=334
-  _globals['_CREATETENANTREQUEST']._serialized_end=369
-  _globals['_CREATETENANTRESPONSE']._serialized_start=371
-  _globals['_CREATETENANTRESPONSE']._serialized_end=407
-  _globals['_GETTENANTREQUEST']._serialized_start=409
-  _globals['_GETTENANTREQUEST']._serialized_end=441
-  _globals['_GETTENANTRESPONSE']._serialized_start=443
-  _globals['_GETTENANTRESPONSE']._serialized_end=508
-  _globals['_CREATESEGMENTREQUEST']._serialized_start=510
-  _globals['_CREATESEGMENTREQUEST']._serialized_end=566
-  _globals['_CREATESEGMENTRESPONSE']._serialized_start=568
-  _globals['_CREATESEGMENTRESPONSE']._serialized_end=605
-  _globals['_DELETESEGMENTREQUEST']._serialized_start=607
-  _globals['_DELETESEGMENTREQUEST']._serialized_end=661
-  _globals['_DELETESEGMENTRESPONSE']._serialized_start=663
-  _globals['_DELETESEGMENTRESPONSE']._serialized_end=700
-  _globals['_GETSEGMENTSREQUEST']._serialized_start=703
-  _globals['_GETSEGMENTSREQUEST']._serialized_end=847
-  _globals['_GETSEGMENTSRESPONSE']._serialized_start=849
-  _globals['_GETSEGMENTSRESPONSE']._serialized_end=919
-  _globals['_UPDATESEGMENTREQUEST']._serialized_start=922
-  _globals['_UPDATESEGMENTREQUEST']._serialized_end=1065
-  _globals['_UPDATESEGMENTRESPONSE']._serialized_start=1067
-  _globals['_UPDATESEGMENTRESPONSE']._serialized_end=1104
-  _globals['_CREATECOLLECTIONREQUEST']._serialized_start=1107
-  _globals['_CREATECOLLECTIONREQUEST']._serialized_end=1403
-  _globals['_CREATECOLLECTIONRESPONSE']._serialized_start=1405
-  _globals['_CREATECOLLECTIONRESPONSE']._serialized_end=1502
-  _globals['_DELETECOLLECTIONREQUEST']._serialized_start=1504
-  _globals['_DELETECOLLECTIONREQUEST']._serialized_end=1596
-  _globals['_DELETECOLLECTIONRESPONSE']._serialized_start=1598
-  _globals['_DELETECOLLECTIONRESPONSE']._serialized_end=1638
-  _globals['_GETCOLLECTIONSREQUEST']._serialized_start=1641
-  _globals['_GETCOLLECTIONSREQUEST']._serialized_end=1812
-  _globals['_GETCOLLECTIONSRESPONSE']._serialized_start=1814
-  _globals['_GETCOLLECTIONSRESPONSE']._serialized_end=1893
-  _globals['_UPDATECOLLECTIONREQUEST']._serialized_start=1896
-  _globals['_UPDATECOLLECTIONREQUEST']._serialized_end=2088
-  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_start=2090
-  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_end=2130
-  _globals['_RESETSTATERESPONSE']._serialized_start=2132
-  _globals['_RESETSTATERESPONSE']._serialized_end=2166
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=2168
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=2226
-  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_start=2228
-  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_end=2303
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_start=2305
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_end=2416
-  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=2418
-  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=2528
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_start=2531
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_end=2719
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_start=2652
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_end=2719
-  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_start=2722
-  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_end=2917
-  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_start=2919
-  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_end=3035
-  _globals['_SYSDB']._serialized_start=3038
-  _globals['_SYSDB']._serialized_end=4434
+  _globals['_CREATEDATABASEREQUEST']._serialized_end=227
+  _globals['_CREATEDATABASERESPONSE']._serialized_start=229
+  _globals['_CREATEDATABASERESPONSE']._serialized_end=267
+  _globals['_GETDATABASEREQUEST']._serialized_start=269
+  _globals['_GETDATABASEREQUEST']._serialized_end=319
+  _globals['_GETDATABASERESPONSE']._serialized_start=321
+  _globals['_GETDATABASERESPONSE']._serialized_end=392
+  _globals['_LISTDATABASESREQUEST']._serialized_start=395
+  _globals['_LISTDATABASESREQUEST']._serialized_end=545
+  _globals['_LISTDATABASESRESPONSE']._serialized_start=547
+  _globals['_LISTDATABASESRESPONSE']._serialized_end=632
+  _globals['_UPDATEDATABASEREQUEST']._serialized_start=635
+  _globals['_UPDATEDATABASEREQUEST']._serialized_end=801
+  _globals['_UPDATEDATABASERESPONSE']._serialized_start=803
+  _globals['_UPDATEDATABASERESPONSE']._serialized_end=863
+  _globals['_DELETEDATABASEREQUEST']._serialized_start=865
+  _globals['_DELETEDATABASEREQUEST']._serialized_end=918
+  _globals['_DELETEDATABASERESPONSE']._serialized_start=920
+  _globals['_DELETEDATABASERESPONSE']._serialized_end=944
+  _globals['_CREATETENANTREQUEST']._serialized_start=946
+  _globals['_CREATETENANTREQUEST']._serialized_end=1041
+  _globals['_CREATETENANTRESPONSE']._serialized_start=1043
+  _globals['_CREATETENANTRESPONSE']._serialized_end=1079
+  _globals['_GETTENANTREQUEST']._serialized_start=1081
+  _globals['_GETTENANTREQUEST']._serialized_end=1113
+  _globals['_GETTENANTRESPONSE']._serialized_start=1115
+  _globals['_GETTENANTRESPONSE']._serialized_end=1180
+  _globals['_LISTTENANTSREQUEST']._serialized_start=1183
+  _globals['_LISTTENANTSREQUEST']._serialized_end=1315
+  _globals['_LISTTENANTSRESPONSE']._serialized_start=1317
+  _globals['_LISTTENANTSRESPONSE']._serialized_end=1396
+  _globals['_UPDATETENANTREQUEST']._serialized_start=1399
+  _globals['_UPDATETENANTREQUEST']._serialized_end=1547
+  _globals['_UPDATETENANTRESPONSE']._serialized_start=1549
+  _globals['_UPDATETENANTRESPONSE']._serialized_end=1603
+  _globals['_DELETETENANTREQUEST']._serialized_start=1605
+  _globals['_DELETETENANTREQUEST']._serialized_end=1640
+  _globals['_DELETETENANTRESPONSE']._serialized_start=1642
+  _globals['_DELETETENANTRESPONSE']._serialized_end=1664
+  _globals['_SUSPENDTENANTREQUEST']._serialized_start=1666
+  _globals['_SUSPENDTENANTREQUEST']._serialized_end=1702
+  _globals['_SUSPENDTENANTRESPONSE']._serialized_start=1704
+  _globals['_SUSPENDTENANTRESPONSE']._serialized_end=1727
+  _globals['_RESUMETENANTREQUEST']._serialized_start=1729
+  _globals['_RESUMETENANTREQUEST']._serialized_end=1764
+  _globals['_RESUMETENANTRESPONSE']._serialized_start=1766
+  _globals['_RESUMETENANTRESPONSE']._serialized_end=1788
+  _globals['_TENANTQUOTA']._serialized_start=1791
+  _globals['_TENANTQUOTA']._serialized_end=2144
+  _globals['_GETTENANTQUOTAREQUEST']._serialized_start=2146
+  _globals['_GETTENANTQUOTAREQUEST']._serialized_end=2185
+  _globals['_GETTENANTQUOTARESPONSE']._serialized_start=2187
+  _globals['_GETTENANTQUOTARESPONSE']._serialized_end=2308
+  _globals['_SETTENANTQUOTAREQUEST']._serialized_start=2310
+  _globals['_SETTENANTQUOTAREQUEST']._serialized_end=2385
+  _globals['_SETTENANTQUOTARESPONSE']._serialized_start=2387
+  _globals['_SETTENANTQUOTARESPONSE']._serialized_end=2457
+  _globals['_CREATESEGMENTREQUEST']._serialized_start=2459
+  _globals['_CREATESEGMENTREQUEST']._serialized_end=2515
+  _globals['_CREATESEGMENTRESPONSE']._serialized_start=2517
+  _globals['_CREATESEGMENTRESPONSE']._serialized_end=2554
+  _globals['_DELETESEGMENTREQUEST']._serialized_start=2556
+  _globals['_DELETESEGMENTREQUEST']._serialized_end=2610
+  _globals['_DELETESEGMENTRESPONSE']._serialized_start=2612
+  _globals['_DELETESEGMENTRESPONSE']._serialized_end=2649
+  _globals['_GETSEGMENTSREQUEST']._serialized_start=2652
+  _globals['_GETSEGMENTSREQUEST']._serialized_end=2796
+  _globals['_GETSEGMENTSRESPONSE']._serialized_start=2798
+  _globals['_GETSEGMENTSRESPONSE']._serialized_end=2868
+  _globals['_UPDATESEGMENTREQUEST']._serialized_start=2871
+  _globals['_UPDATESEGMENTREQUEST']._serialized_end=3074
+  _globals['_UPDATESEGMENTRESPONSE']._serialized_start=3076
+  _globals['_UPDATESEGMENTRESPONSE']._serialized_end=3134
+  _globals['_CREATECOLLECTIONREQUEST']._serialized_start=3137
+  _globals['_CREATECOLLECTIONREQUEST']._serialized_end=3433
+  _globals['_CREATECOLLECTIONRESPONSE']._serialized_start=3435
+  _globals['_CREATECOLLECTIONRESPONSE']._serialized_end=3532
+  _globals['_DELETECOLLECTIONREQUEST']._serialized_start=3534
+  _globals['_DELETECOLLECTIONREQUEST']._serialized_end=3626
+  _globals['_DELETECOLLECTIONRESPONSE']._serialized_start=3628
+  _globals['_DELETECOLLECTIONRESPONSE']._serialized_end=3668
+  _globals['_GETCOLLECTIONSREQUEST']._serialized_start=3671
+  _globals['_GETCOLLECTIONSREQUEST']._serialized_end=3927
+  _globals['_GETCOLLECTIONSRESPONSE']._serialized_start=3929
+  _globals['_GETCOLLECTIONSRESPONSE']._serialized_end=4033
+  _globals['_RESTORECOLLECTIONREQUEST']._serialized_start=4035
+  _globals['_RESTORECOLLECTIONREQUEST']._serialized_end=4135
+  _globals['_RESTORECOLLECTIONRESPONSE']._serialized_start=4137
+  _globals['_RESTORECOLLECTIONRESPONSE']._serialized_end=4204
+  _globals['_LISTDELETEDCOLLECTIONSREQUEST']._serialized_start=4207
+  _globals['_LISTDELETEDCOLLECTIONSREQUEST']._serialized_end=4342
+  _globals['_DELETEDCOLLECTION']._serialized_start=4344
+  _globals['_DELETEDCOLLECTION']._serialized_end=4423
+  _globals['_LISTDELETEDCOLLECTIONSRESPONSE']._serialized_start=4425
+  _globals['_LISTDELETEDCOLLECTIONSRESPONSE']._serialized_end=4530
+  _globals['_FORKCOLLECTIONREQUEST']._serialized_start=4533
+  _globals['_FORKCOLLECTIONREQUEST']._serialized_end=4682
+  _globals['_FORKCOLLECTIONRESPONSE']._serialized_start=4684
+  _globals['_FORKCOLLECTIONRESPONSE']._serialized_end=4748
+  _globals['_GETSHAREDFILEPATHSREQUEST']._serialized_start=4750
+  _globals['_GETSHAREDFILEPATHSREQUEST']._serialized_end=4820
+  _globals['_GETSHAREDFILEPATHSRESPONSE']._serialized_start=4822
+  _globals['_GETSHAREDFILEPATHSRESPONSE']._serialized_end=4870
+  _globals['_COUNTCOLLECTIONSREQUEST']._serialized_start=4872
+  _globals['_COUNTCOLLECTIONSREQUEST']._serialized_end=4949
+  _globals['_COUNTCOLLECTIONSRESPONSE']._serialized_start=4951
+  _globals['_COUNTCOLLECTIONSRESPONSE']._serialized_end=4992
+  _globals['_CHECKCOLLECTIONSREQUEST']._serialized_start=4994
+  _globals['_CHECKCOLLECTIONSREQUEST']._serialized_end=5043
+  _globals['_CHECKCOLLECTIONSRESPONSE']._serialized_start=5045
+  _globals['_CHECKCOLLECTIONSRESPONSE']._serialized_end=5095
+  _globals['_UPDATECOLLECTIONREQUEST']._serialized_start=5098
+  _globals['_UPDATECOLLECTIONREQUEST']._serialized_end=5482
+  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_start=5484
+  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_end=5545
+  _globals['_RESETSTATERESPONSE']._serialized_start=5547
+  _globals['_RESETSTATERESPONSE']._serialized_end=5581
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=5583
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=5641
+  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_start=5643
+  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_end=5718
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_start=5720
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_end=5831
+  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=5833
+  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=5943
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_start=5946
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_end=6134
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_start=6067
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_end=6134
+  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_start=6137
+  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_end=6332
+  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_start=6334
+  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_end=6450
+  _globals['_SEGMENTFILEPATHS']._serialized_start=6453
+  _globals['_SEGMENTFILEPATHS']._serialized_end=6621
+  _globals['_SEGMENTFILEPATHS_FILEPATHSENTRY']._serialized_start=6067
+  _globals['_SEGMENTFILEPATHS_FILEPATHSENTRY']._serialized_end=6134
+  _globals['_COLLECTIONVERSIONINFO']._serialized_start=6623
+  _globals['_COLLECTIONVERSIONINFO']._serialized_end=6749
+  _globals['_LISTCOLLECTIONVERSIONSREQUEST']._serialized_start=6751
+  _globals['_LISTCOLLECTIONVERSIONSREQUEST']._serialized_end=6835
+  _globals['_LISTCOLLECTIONVERSIONSRESPONSE']._serialized_start=6837
+  _globals['_LISTCOLLECTIONVERSIONSRESPONSE']._serialized_end=6918
+  _globals['_GETSEGMENTSATVERSIONREQUEST']._serialized_start=6920
+  _globals['_GETSEGMENTSATVERSIONREQUEST']._serialized_end=6989
+  _globals['_GETSEGMENTSATVERSIONRESPONSE']._serialized_start=6991
+  _globals['_GETSEGMENTSATVERSIONRESPONSE']._serialized_end=7056
+  _globals['_ROLLBACKCOLLECTIONREQUEST']._serialized_start=7058
+  _globals['_ROLLBACKCOLLECTIONREQUEST']._serialized_end=7125
+  _globals['_ROLLBACKCOLLECTIONRESPONSE']._serialized_start=7127
+  _globals['_ROLLBACKCOLLECTIONRESPONSE']._serialized_end=7203
+  _globals['_COLLECTIONEVENT']._serialized_start=7206
+  _globals['_COLLECTIONEVENT']._serialized_end=7361
+  _globals['_WATCHCOLLECTIONSREQUEST']._serialized_start=7364
+  _globals['_WATCHCOLLECTIONSREQUEST']._serialized_end=7505
+  _globals['_WATCHCOLLECTIONSRESPONSE']._serialized_start=7507
+  _globals['_WATCHCOLLECTIONSRESPONSE']._serialized_end=7574
+  _globals['_AUDITEVENT']._serialized_start=7577
+  _globals['_AUDITEVENT']._serialized_end=7780
+  _globals['_LISTAUDITEVENTSREQUEST']._serialized_start=7783
+  _globals['_LISTAUDITEVENTSREQUEST']._serialized_end=7985
+  _globals['_LISTAUDITEVENTSRESPONSE']._serialized_start=7987
+  _globals['_LISTAUDITEVENTSRESPONSE']._serialized_end=8073
+  _globals['_SYSDB']._serialized_start=8218
+  _globals['_SYSDB']._serialized_end=11446
 # @@protoc_insertion_point(module_scope)
@@ -1,21 +1,37 @@
 from chromadb.proto import chroma_pb2 as _chroma_pb2
 from google.protobuf import empty_pb2 as _empty_pb2
 from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
+class CollectionEventType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = []
+    COLLECTION_CREATED: _ClassVar[CollectionEventType]
+    COLLECTION_UPDATED: _ClassVar[CollectionEventType]
+    COLLECTION_DELETED: _ClassVar[CollectionEventType]
+    COLLECTION_FLUSHED: _ClassVar[CollectionEventType]
+    COLLECTION_RESET: _ClassVar[CollectionEventType]
+COLLECTION_CREATED: CollectionEventType
+COLLECTION_UPDATED: CollectionEventType
+COLLECTION_DELETED: CollectionEventType
+COLLECTION_FLUSHED: CollectionEventType
+COLLECTION_RESET: CollectionEventType
+
 class CreateDatabaseRequest(_message.Message):
-    __slots__ = ["id", "name", "tenant"]
+    __slots__ = ["id", "name", "tenant", "metadata"]
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     tenant: str
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ...) -> None: ...
+    metadata: _chroma_pb2.UpdateMetadata
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ...) -> None: ...
 
 class CreateDatabaseResponse(_message.Message):
     __slots__ = []
@@ -35,11 +51,65 @@ class GetDatabaseResponse(_message.Message):
     database: _chroma_pb2.Database
     def __init__(self, database: _Optional[_Union[_chroma_pb2.Database, _Mapping]] = ...) -> None: ...
 
+class ListDatabasesRequest(_message.Message):
+    __slots__ = ["tenant", "name_prefix", "limit", "page_token"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    NAME_PREFIX_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    name_prefix: str
+    limit: int
+    page_token: str
+    def __init__(self, tenant: _Optional[str] = ..., name_prefix: _Optional[str] = ..., limit: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
+
+class ListDatabasesResponse(_message.Message):
+    __slots__ = ["databases", "next_page_token"]
+    DATABASES_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    databases: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Database]
+    next_page_token: str
+    def __init__(self, databases: _Optional[_Iterable[_Union[_chroma_pb2.Database, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
+class UpdateDatabaseRequest(_message.Message):
+    __slots__ = ["name", "tenant", "metadata", "reset_metadata", "merge_metadata"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    RESET_METADATA_FIELD_NUMBER: _ClassVar[int]
+    MERGE_METADATA_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    tenant: str
+    metadata: _chroma_pb2.UpdateMetadata
+    reset_metadata: bool
+    merge_metadata: bool
+    def __init__(self, name: _Optional[str] = ..., tenant: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ..., merge_metadata: bool = ...) -> None: ...
+
+class UpdateDatabaseResponse(_message.Message):
+    __slots__ = ["database"]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    database: _chroma_pb2.Database
+    def __init__(self, database: _Optional[_Union[_chroma_pb2.Database, _Mapping]] = ...) -> None: ...
+
+class DeleteDatabaseRequest(_message.Message):
+    __slots__ = ["name", "tenant"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    tenant: str
+    def __init__(self, name: _Optional[str] = ..., tenant: _Optional[str] = ...) -> None: ...
+
+class DeleteDatabaseResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
 class CreateTenantRequest(_message.Message):
-    __slots__ = ["name"]
+    __slots__ = ["name", "metadata"]
     NAME_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
     name: str
-    def __init__(self, name: _Optional[str] = ...) -> None: ...
+    metadata: _chroma_pb2.UpdateMetadata
+    def __init__(self, name: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ...) -> None: ...
 
 class CreateTenantResponse(_message.Message):
     __slots__ = []
@@ -57,6 +127,114 @@ class GetTenantResponse(_message.Message):
     tenant: _chroma_pb2.Tenant
     def __init__(self, tenant: _Optional[_Union[_chroma_pb2.Tenant, _Mapping]] = ...) -> None: ...
 
+class ListTenantsRequest(_message.Message):
+    __slots__ = ["name_prefix", "limit", "page_token"]
+    NAME_PREFIX_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    name_prefix: str
+    limit: int
+    page_token: str
+    def __init__(self, name_prefix: _Optional[str] = ..., limit: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
+
+class ListTenantsResponse(_message.Message):
+    __slots__ = ["tenants", "next_page_token"]
+    TENANTS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    tenants: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Tenant]
+    next_page_token: str
+    def __init__(self, tenants: _Optional[_Iterable[_Union[_chroma_pb2.Tenant, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
+class UpdateTenantRequest(_message.Message):
+    __slots__ = ["name", "metadata", "reset_metadata", "merge_metadata"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    RESET_METADATA_FIELD_NUMBER: _ClassVar[int]
+    MERGE_METADATA_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    metadata: _chroma_pb2.UpdateMetadata
+    reset_metadata: bool
+    merge_metadata: bool
+    def __init__(self, name: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ..., merge_metadata: bool = ...) -> None: ...
+
+class UpdateTenantResponse(_message.Message):
+    __slots__ = ["tenant"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    tenant: _chroma_pb2.Tenant
+    def __init__(self, tenant: _Optional[_Union[_chroma_pb2.Tenant, _Mapping]] = ...) -> None: ...
+
+class DeleteTenantRequest(_message.Message):
+    __slots__ = ["name"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class DeleteTenantResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
+class SuspendTenantRequest(_message.Message):
+    __slots__ = ["name"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class SuspendTenantResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
+class ResumeTenantRequest(_message.Message):
+    __slots__ = ["name"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class ResumeTenantResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
+class TenantQuota(_message.Message):
+    __slots__ = ["max_databases", "max_collections_per_database", "max_metadata_keys_per_collection", "max_metadata_value_size", "max_collection_dimension"]
+    MAX_DATABASES_FIELD_NUMBER: _ClassVar[int]
+    MAX_COLLECTIONS_PER_DATABASE_FIELD_NUMBER: _ClassVar[int]
+    MAX_METADATA_KEYS_PER_COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    MAX_METADATA_VALUE_SIZE_FIELD_NUMBER: _ClassVar[int]
+    MAX_COLLECTION_DIMENSION_FIELD_NUMBER: _ClassVar[int]
+    max_databases: int
+    max_collections_per_database: int
+    max_metadata_keys_per_collection: int
+    max_metadata_value_size: int
+    max_collection_dimension: int
+    def __init__(self, max_databases: _Optional[int] = ..., max_collections_per_database: _Optional[int] = ..., max_metadata_keys_per_collection: _Optional[int] = ..., max_metadata_value_size: _Optional[int] = ..., max_collection_dimension: _Optional[int] = ...) -> None: ...
+
+class GetTenantQuotaRequest(_message.Message):
+    __slots__ = ["tenant"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    def __init__(self, tenant: _Optional[str] = ...) -> None: ...
+
+class GetTenantQuotaResponse(_message.Message):
+    __slots__ = ["quota", "effective_quota"]
+    QUOTA_FIELD_NUMBER: _ClassVar[int]
+    EFFECTIVE_QUOTA_FIELD_NUMBER: _ClassVar[int]
+    quota: TenantQuota
+    effective_quota: TenantQuota
+    def __init__(self, quota: _Optional[_Union[TenantQuota, _Mapping]] = ..., effective_quota: _Optional[_Union[TenantQuota, _Mapping]] = ...) -> None: ...
+
+class SetTenantQuotaRequest(_message.Message):
+    __slots__ = ["tenant", "quota"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    QUOTA_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    quota: TenantQuota
+    def __init__(self, tenant: _Optional[str] = ..., quota: _Optional[_Union[TenantQuota, _Mapping]] = ...) -> None: ...
+
+class SetTenantQuotaResponse(_message.Message):
+    __slots__ = ["effective_quota"]
+    EFFECTIVE_QUOTA_FIELD_NUMBER: _ClassVar[int]
+    effective_quota: TenantQuota
+    def __init__(self, effective_quota: _Optional[_Union[TenantQuota, _Mapping]] = ...) -> None: ...
+
 class CreateSegmentRequest(_message.Message):
     __slots__ = ["segment"]
     SEGMENT_FIELD_NUMBER: _ClassVar[int]
@@ -98,20 +276,24 @@ class GetSegmentsResponse(_message.Message):
     def __init__(self, segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ...) -> None: ...
 
 class UpdateSegmentRequest(_message.Message):
-    __slots__ = ["id", "collection", "metadata", "reset_metadata"]
+    __slots__ = ["id", "collection", "metadata", "reset_metadata", "expected_row_version"]
     ID_FIELD_NUMBER: _ClassVar[int]
     COLLECTION_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
     RESET_METADATA_FIELD_NUMBER: _ClassVar[int]
+    EXPECTED_ROW_VERSION_FIELD_NUMBER: _ClassVar[int]
     id: str
     collection: str
     metadata: _chroma_pb2.UpdateMetadata
     reset_metadata: bool
-    def __init__(self, id: _Optional[str] = ..., collection: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ...) -> None: ...
+    expected_row_version: int
+    def __init__(self, id: _Optional[str] = ..., collection: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ..., expected_row_version: _Optional[int] = ...) -> None: ...
 
 class UpdateSegmentResponse(_message.Message):
-    __slots__ = []
-    def __init__(self) -> None: ...
+    __slots__ = ["row_version"]
+    ROW_VERSION_FIELD_NUMBER: _ClassVar[int]
+    row_version: int
+    def __init__(self, row_version: _Optional[int] = ...) -> None: ...
 
 class CreateCollectionRequest(_message.Message):
     __slots__ = ["id", "name", "configuration_json_str", "metadata", "dimension", "get_or_create", "tenant", "database", "segments"]
@@ -160,44 +342,166 @@ class DeleteCollectionResponse(_message.Message):
     def __init__(self) -> None: ...
 
 class GetCollectionsRequest(_message.Message):
-    __slots__ = ["id", "name", "tenant", "database", "limit", "offset"]
+    __slots__ = ["id", "name", "tenant", "database", "limit", "offset", "page_token", "where"]
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
     DATABASE_FIELD_NUMBER: _ClassVar[int]
     LIMIT_FIELD_NUMBER: _ClassVar[int]
     OFFSET_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    WHERE_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     tenant: str
     database: str
     limit: int
     offset: int
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., limit: _Optional[int] = ..., offset: _Optional[int] = ...) -> None: ...
+    page_token: str
+    where: _chroma_pb2.Where
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., limit: _Optional[int] = ..., offset: _Optional[int] = ..., page_token: _Optional[str] = ..., where: _Optional[_Union[_chroma_pb2.Where, _Mapping]] = ...) -> None: ...
 
 class GetCollectionsResponse(_message.Message):
-    __slots__ = ["collections"]
+    __slots__ = ["collections", "next_page_token"]
     COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
     collections: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Collection]
-    def __init__(self, collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ...) -> None: ...
+    next_page_token: str
+    def __init__(self, collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
+class RestoreCollectionRequest(_message.Message):
+    __slots__ = ["id", "tenant", "database", "name"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    tenant: str
+    database: str
+    name: str
+    def __init__(self, id: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., name: _Optional[str] = ...) -> None: ...
+
+class RestoreCollectionResponse(_message.Message):
+    __slots__ = ["collection"]
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    collection: _chroma_pb2.Collection
+    def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ...) -> None: ...
+
+class ListDeletedCollectionsRequest(_message.Message):
+    __slots__ = ["tenant", "database", "limit", "page_token"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    database: str
+    limit: int
+    page_token: str
+    def __init__(self, tenant: _Optional[str] = ..., database: _Optional[str] = ..., limit: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
+
+class DeletedCollection(_message.Message):
+    __slots__ = ["collection", "deleted_at"]
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    DELETED_AT_FIELD_NUMBER: _ClassVar[int]
+    collection: _chroma_pb2.Collection
+    deleted_at: int
+    def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ..., deleted_at: _Optional[int] = ...) -> None: ...
+
+class ListDeletedCollectionsResponse(_message.Message):
+    __slots__ = ["collections", "next_page_token"]
+    COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    collections: _containers.RepeatedCompositeFieldContainer[DeletedCollection]
+    next_page_token: str
+    def __init__(self, collections: _Optional[_Iterable[_Union[DeletedCollection, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
+class ForkCollectionRequest(_message.Message):
+    __slots__ = ["source_collection_id", "target_collection_id", "target_collection_name", "tenant", "database"]
+    SOURCE_COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    TARGET_COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    TARGET_COLLECTION_NAME_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    source_collection_id: str
+    target_collection_id: str
+    target_collection_name: str
+    tenant: str
+    database: str
+    def __init__(self, source_collection_id: _Optional[str] = ..., target_collection_id: _Optional[str] = ..., target_collection_name: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ...) -> None: ...
+
+class ForkCollectionResponse(_message.Message):
+    __slots__ = ["collection"]
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    collection: _chroma_pb2.Collection
+    def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ...) -> None: ...
+
+class GetSharedFilePathsRequest(_message.Message):
+    __slots__ = ["collection_id", "file_paths"]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    FILE_PATHS_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    file_paths: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collection_id: _Optional[str] = ..., file_paths: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class GetSharedFilePathsResponse(_message.Message):
+    __slots__ = ["file_paths"]
+    FILE_PATHS_FIELD_NUMBER: _ClassVar[int]
+    file_paths: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, file_paths: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class CountCollectionsRequest(_message.Message):
+    __slots__ = ["tenant", "database"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    database: str
+    def __init__(self, tenant: _Optional[str] = ..., database: _Optional[str] = ...) -> None: ...
+
+class CountCollectionsResponse(_message.Message):
+    __slots__ = ["count"]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    count: int
+    def __init__(self, count: _Optional[int] = ...) -> None: ...
+
+class CheckCollectionsRequest(_message.Message):
+    __slots__ = ["collection_ids"]
+    COLLECTION_IDS_FIELD_NUMBER: _ClassVar[int]
+    collection_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collection_ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class CheckCollectionsResponse(_message.Message):
+    __slots__ = ["collection_ids"]
+    COLLECTION_IDS_FIELD_NUMBER: _ClassVar[int]
+    collection_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collection_ids: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class UpdateCollectionRequest(_message.Message):
-    __slots__ = ["id", "name", "dimension", "metadata", "reset_metadata"]
+    __slots__ = ["id", "name", "dimension", "metadata", "reset_metadata", "new_database", "merge_metadata", "expected_row_version", "configuration_json_str"]
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     DIMENSION_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
     RESET_METADATA_FIELD_NUMBER: _ClassVar[int]
+    NEW_DATABASE_FIELD_NUMBER: _ClassVar[int]
+    MERGE_METADATA_FIELD_NUMBER: _ClassVar[int]
+    EXPECTED_ROW_VERSION_FIELD_NUMBER: _ClassVar[int]
+    CONFIGURATION_JSON_STR_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     dimension: int
     metadata: _chroma_pb2.UpdateMetadata
     reset_metadata: bool
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., dimension: _Optional[int] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ...) -> None: ...
+    new_database: str
+    merge_metadata: bool
+    expected_row_version: int
+    configuration_json_str: str
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., dimension: _Optional[int] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ..., new_database: _Optional[str] = ..., merge_metadata: bool = ..., expected_row_version: _Optional[int] = ..., configuration_json_str: _Optional[str] = ...) -> None: ...
 
 class UpdateCollectionResponse(_message.Message):
-    __slots__ = []
-    def __init__(self) -> None: ...
+    __slots__ = ["row_version"]
+    ROW_VERSION_FIELD_NUMBER: _ClassVar[int]
+    row_version: int
+    def __init__(self, row_version: _Optional[int] = ...) -> None: ...
 
 class ResetStateResponse(_message.Message):
     __slots__ = []
@@ -267,3 +571,148 @@ class FlushCollectionCompactionResponse(_message.Message):
     collection_version: int
     last_compaction_time: int
     def __init__(self, collection_id: _Optional[str] = ..., collection_version: _Optional[int] = ..., last_compaction_time: _Optional[int] = ...) -> None: ...
+
+class SegmentFilePaths(_message.Message):
+    __slots__ = ["segment_id", "file_paths"]
+    class FilePathsEntry(_message.Message):
+        __slots__ = ["key", "value"]
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: _chroma_pb2.FilePaths
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[_chroma_pb2.FilePaths, _Mapping]] = ...) -> None: ...
+    SEGMENT_ID_FIELD_NUMBER: _ClassVar[int]
+    FILE_PATHS_FIELD_NUMBER: _ClassVar[int]
+    segment_id: str
+    file_paths: _containers.MessageMap[str, _chroma_pb2.FilePaths]
+    def __init__(self, segment_id: _Optional[str] = ..., file_paths: _Optional[_Mapping[str, _chroma_pb2.FilePaths]] = ...) -> None: ...
+
+class CollectionVersionInfo(_message.Message):
+    __slots__ = ["version", "log_position", "segments", "created_at"]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    LOG_POSITION_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    version: int
+    log_position: int
+    segments: _containers.RepeatedCompositeFieldContainer[SegmentFilePaths]
+    created_at: int
+    def __init__(self, version: _Optional[int] = ..., log_position: _Optional[int] = ..., segments: _Optional[_Iterable[_Union[SegmentFilePaths, _Mapping]]] = ..., created_at: _Optional[int] = ...) -> None: ...
+
+class ListCollectionVersionsRequest(_message.Message):
+    __slots__ = ["collection_id", "limit"]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    limit: int
+    def __init__(self, collection_id: _Optional[str] = ..., limit: _Optional[int] = ...) -> None: ...
+
+class ListCollectionVersionsResponse(_message.Message):
+    __slots__ = ["versions"]
+    VERSIONS_FIELD_NUMBER: _ClassVar[int]
+    versions: _containers.RepeatedCompositeFieldContainer[CollectionVersionInfo]
+    def __init__(self, versions: _Optional[_Iterable[_Union[CollectionVersionInfo, _Mapping]]] = ...) -> None: ...
+
+class GetSegmentsAtVersionRequest(_message.Message):
+    __slots__ = ["collection_id", "version"]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    version: int
+    def __init__(self, collection_id: _Optional[str] = ..., version: _Optional[int] = ...) -> None: ...
+
+class GetSegmentsAtVersionResponse(_message.Message):
+    __slots__ = ["segments"]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    def __init__(self, segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ...) -> None: ...
+
+class RollbackCollectionRequest(_message.Message):
+    __slots__ = ["collection_id", "version"]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    version: int
+    def __init__(self, collection_id: _Optional[str] = ..., version: _Optional[int] = ...) -> None: ...
+
+class RollbackCollectionResponse(_message.Message):
+    __slots__ = ["version"]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    version: CollectionVersionInfo
+    def __init__(self, version: _Optional[_Union[CollectionVersionInfo, _Mapping]] = ...) -> None: ...
+
+class CollectionEvent(_message.Message):
+    __slots__ = ["revision", "type", "collection_id", "tenant", "database", "created_at"]
+    REVISION_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    revision: int
+    type: CollectionEventType
+    collection_id: str
+    tenant: str
+    database: str
+    created_at: int
+    def __init__(self, revision: _Optional[int] = ..., type: _Optional[_Union[CollectionEventType, str]] = ..., collection_id: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., created_at: _Optional[int] = ...) -> None: ...
+
+class WatchCollectionsRequest(_message.Message):
+    __slots__ = ["tenant", "database", "since_revision"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    SINCE_REVISION_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    database: str
+    since_revision: int
+    def __init__(self, tenant: _Optional[str] = ..., database: _Optional[str] = ..., since_revision: _Optional[int] = ...) -> None: ...
+
+class WatchCollectionsResponse(_message.Message):
+    __slots__ = ["events"]
+    EVENTS_FIELD_NUMBER: _ClassVar[int]
+    events: _containers.RepeatedCompositeFieldContainer[CollectionEvent]
+    def __init__(self, events: _Optional[_Iterable[_Union[CollectionEvent, _Mapping]]] = ...) -> None: ...
+
+class AuditEvent(_message.Message):
+    __slots__ = ["id", "actor", "operation", "tenant_id", "database_id", "collection_id", "before", "after", "created_at"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    ACTOR_FIELD_NUMBER: _ClassVar[int]
+    OPERATION_FIELD_NUMBER: _ClassVar[int]
+    TENANT_ID_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_ID_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    BEFORE_FIELD_NUMBER: _ClassVar[int]
+    AFTER_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    id: int
+    actor: str
+    operation: str
+    tenant_id: str
+    database_id: str
+    collection_id: str
+    before: str
+    after: str
+    created_at: int
+    def __init__(self, id: _Optional[int] = ..., actor: _Optional[str] = ..., operation: _Optional[str] = ..., tenant_id: _Optional[str] = ..., database_id: _Optional[str] = ..., collection_id: _Optional[str] = ..., before: _Optional[str] = ..., after: _Optional[str] = ..., created_at: _Optional[int] = ...) -> None: ...
+
+class ListAuditEventsRequest(_message.Message):
+    __slots__ = ["tenant", "start_time", "end_time", "limit", "page_token"]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    START_TIME_FIELD_NUMBER: _ClassVar[int]
+    END_TIME_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    start_time: int
+    end_time: int
+    limit: int
+    page_token: str
+    def __init__(self, tenant: _Optional[str] = ..., start_time: _Optional[int] = ..., end_time: _Optional[int] = ..., limit: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
+
+class ListAuditEventsResponse(_message.Message):
+    __slots__ = ["events", "next_page_token"]
+    EVENTS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    events: _containers.RepeatedCompositeFieldContainer[AuditEvent]
+    next_page_token: str
+    def __init__(self, events: _Optional[_Iterable[_Union[AuditEvent, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
@@ -25,6 +25,21 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseResponse.FromString,
                 )
+        self.ListDatabases = channel.unary_unary(
+                '/chroma.SysDB/ListDatabases',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListDatabasesRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListDatabasesResponse.FromString,
+                )
+        self.UpdateDatabase = channel.unary_unary(
+                '/chroma.SysDB/UpdateDatabase',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateDatabaseRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateDatabaseResponse.FromString,
+                )
+        self.DeleteDatabase = channel.unary_unary(
+                '/chroma.SysDB/DeleteDatabase',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseResponse.FromString,
+                )
         self.CreateTenant = channel.unary_unary(
                 '/chroma.SysDB/CreateTenant',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CreateTenantRequest.SerializeToString,
@@ -35,6 +50,41 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantResponse.FromString,
                 )
+        self.ListTenants = channel.unary_unary(
+                '/chroma.SysDB/ListTenants',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListTenantsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListTenantsResponse.FromString,
+                )
+        self.UpdateTenant = channel.unary_unary(
+                '/chroma.SysDB/UpdateTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateTenantResponse.FromString,
+                )
+        self.DeleteTenant = channel.unary_unary(
+                '/chroma.SysDB/DeleteTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteTenantResponse.FromString,
+                )
+        self.SuspendTenant = channel.unary_unary(
+                '/chroma.SysDB/SuspendTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SuspendTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SuspendTenantResponse.FromString,
+                )
+        self.ResumeTenant = channel.unary_unary(
+                '/chroma.SysDB/ResumeTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ResumeTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ResumeTenantResponse.FromString,
+                )
+        self.GetTenantQuota = channel.unary_unary(
+                '/chroma.SysDB/GetTenantQuota',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantQuotaRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantQuotaResponse.FromString,
+                )
+        self.SetTenantQuota = channel.unary_unary(
+                '/chroma.SysDB/SetTenantQuota',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantQuotaRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantQuotaResponse.FromString,
+                )
         self.CreateSegment = channel.unary_unary(
                 '/chroma.SysDB/CreateSegment',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentRequest.SerializeToString,
@@ -70,11 +120,41 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsResponse.FromString,
                 )
+        self.CheckCollections = channel.unary_unary(
+                '/chroma.SysDB/CheckCollections',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CheckCollectionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CheckCollectionsResponse.FromString,
+                )
+        self.CountCollections = channel.unary_unary(
+                '/chroma.SysDB/CountCollections',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CountCollectionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CountCollectionsResponse.FromString,
+                )
         self.UpdateCollection = channel.unary_unary(
                 '/chroma.SysDB/UpdateCollection',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionResponse.FromString,
                 )
+        self.RestoreCollection = channel.unary_unary(
+                '/chroma.SysDB/RestoreCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.RestoreCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.RestoreCollectionResponse.FromString,
+                )
+        self.ListDeletedCollections = channel.unary_unary(
+                '/chroma.SysDB/ListDeletedCollections',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeletedCollectionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeletedCollectionsResponse.FromString,
+                )
+        self.ForkCollection = channel.unary_unary(
+                '/chroma.SysDB/ForkCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ForkCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ForkCollectionResponse.FromString,
+                )
+        self.GetSharedFilePaths = channel.unary_unary(
+                '/chroma.SysDB/GetSharedFilePaths',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetSharedFilePathsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetSharedFilePathsResponse.FromString,
+                )
         self.ResetState = channel.unary_unary(
                 '/chroma.SysDB/ResetState',
                 request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -95,6 +175,31 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionResponse.FromString,
                 )
+        self.ListCollectionVersions = channel.unary_unary(
+                '/chroma.SysDB/ListCollectionVersions',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionVersionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionVersionsResponse.FromString,
+                )
+        self.GetSegmentsAtVersion = channel.unary_unary(
+                '/chroma.SysDB/GetSegmentsAtVersion',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsAtVersionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsAtVersionResponse.FromString,
+                )
+        self.RollbackCollection = channel.unary_unary(
+                '/chroma.SysDB/RollbackCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.RollbackCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.RollbackCollectionResponse.FromString,
+                )
+        self.WatchCollections = channel.unary_stream(
+                '/chroma.SysDB/WatchCollections',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsResponse.FromString,
+                )
+        self.ListAuditEvents = channel.unary_unary(
+                '/chroma.SysDB/ListAuditEvents',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListAuditEventsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListAuditEventsResponse.FromString,
+                )
 
 
 class SysDBServicer(object):
@@ -112,6 +217,24 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListDatabases(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateDatabase(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteDatabase(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateTenant(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -124,6 +247,48 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListTenants(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SuspendTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ResumeTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetTenantQuota(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetTenantQuota(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateSegment(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -166,12 +331,48 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def CheckCollections(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CountCollections(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def UpdateCollection(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def RestoreCollection(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDeletedCollections(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ForkCollection(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSharedFilePaths(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def ResetState(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -196,6 +397,36 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListCollectionVersions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSegmentsAtVersion(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RollbackCollection(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchCollections(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListAuditEvents(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_SysDBServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -209,6 +440,21 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseResponse.SerializeToString,
             ),
+            'ListDatabases': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDatabases,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListDatabasesRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListDatabasesResponse.SerializeToString,
+            ),
+            'UpdateDatabase': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateDatabase,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateDatabaseRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateDatabaseResponse.SerializeToString,
+            ),
+            'DeleteDatabase': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteDatabase,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseResponse.SerializeToString,
+            ),
             'CreateTenant': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateTenant,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CreateTenantRequest.FromString,
@@ -219,6 +465,41 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantResponse.SerializeToString,
             ),
+            'ListTenants': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListTenants,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListTenantsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListTenantsResponse.SerializeToString,
+            ),
+            'UpdateTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateTenantResponse.SerializeToString,
+            ),
+            'DeleteTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteTenantResponse.SerializeToString,
+            ),
+            'SuspendTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.SuspendTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SuspendTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SuspendTenantResponse.SerializeToString,
+            ),
+            'ResumeTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.ResumeTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ResumeTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ResumeTenantResponse.SerializeToString,
+            ),
+            'GetTenantQuota': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTenantQuota,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantQuotaRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantQuotaResponse.SerializeToString,
+            ),
+            'SetTenantQuota': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetTenantQuota,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantQuotaRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantQuotaResponse.SerializeToString,
+            ),
             'CreateSegment': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateSegment,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentRequest.FromString,
@@ -254,11 +535,41 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsResponse.SerializeToString,
             ),
+            'CheckCollections': grpc.unary_unary_rpc_method_handler(
+                    servicer.CheckCollections,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CheckCollectionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.CheckCollectionsResponse.SerializeToString,
+            ),
+            'CountCollections': grpc.unary_unary_rpc_method_handler(
+                    servicer.CountCollections,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CountCollectionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.CountCollectionsResponse.SerializeToString,
+            ),
             'UpdateCollection': grpc.unary_unary_rpc_method_handler(
                     servicer.UpdateCollection,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionResponse.SerializeToString,
             ),
+            'RestoreCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestoreCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.RestoreCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.RestoreCollectionResponse.SerializeToString,
+            ),
+            'ListDeletedCollections': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDeletedCollections,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeletedCollectionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeletedCollectionsResponse.SerializeToString,
+            ),
+            'ForkCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.ForkCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ForkCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ForkCollectionResponse.SerializeToString,
+            ),
+            'GetSharedFilePaths': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSharedFilePaths,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetSharedFilePathsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetSharedFilePathsResponse.SerializeToString,
+            ),
             'ResetState': grpc.unary_unary_rpc_method_handler(
                     servicer.ResetState,
                     request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
@@ -279,6 +590,31 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionResponse.SerializeToString,
             ),
+            'ListCollectionVersions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListCollectionVersions,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionVersionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionVersionsResponse.SerializeToString,
+            ),
+            'GetSegmentsAtVersion': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSegmentsAtVersion,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsAtVersionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsAtVersionResponse.SerializeToString,
+            ),
+            'RollbackCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.RollbackCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.RollbackCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.RollbackCollectionResponse.SerializeToString,
+            ),
+            'WatchCollections': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchCollections,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsResponse.SerializeToString,
+            ),
+            'ListAuditEvents': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListAuditEvents,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListAuditEventsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListAuditEventsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'chroma.SysDB', rpc_method_handlers)
@@ -323,6 +659,57 @@ class SysDB(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ListDatabases(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ListDatabases',
+            chromadb_dot_proto_dot_coordinator__pb2.ListDatabasesRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListDatabasesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateDatabase(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/UpdateDatabase',
+            chromadb_dot_proto_dot_coordinator__pb2.UpdateDatabaseRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.UpdateDatabaseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteDatabase(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/DeleteDatabase',
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def CreateTenant(request,
             target,
@@ -357,6 +744,125 @@ class SysDB(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ListTenants(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ListTenants',
+            chromadb_dot_proto_dot_coordinator__pb2.ListTenantsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListTenantsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateTenant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/UpdateTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.UpdateTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.UpdateTenantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteTenant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/DeleteTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteTenantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SuspendTenant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/SuspendTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.SuspendTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.SuspendTenantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ResumeTenant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ResumeTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.ResumeTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ResumeTenantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetTenantQuota(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/GetTenantQuota',
+            chromadb_dot_proto_dot_coordinator__pb2.GetTenantQuotaRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetTenantQuotaResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetTenantQuota(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/SetTenantQuota',
+            chromadb_dot_proto_dot_coordinator__pb2.SetTenantQuotaRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.SetTenantQuotaResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def CreateSegment(request,
             target,
@@ -476,6 +982,40 @@ class SysDB(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def CheckCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/CheckCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.CheckCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.CheckCollectionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CountCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/CountCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.CountCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.CountCollectionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def UpdateCollection(request,
             target,
@@ -493,6 +1033,74 @@ class SysDB(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def RestoreCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/RestoreCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.RestoreCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.RestoreCollectionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListDeletedCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ListDeletedCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.ListDeletedCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListDeletedCollectionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ForkCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ForkCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.ForkCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ForkCollectionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetSharedFilePaths(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/GetSharedFilePaths',
+            chromadb_dot_proto_dot_coordinator__pb2.GetSharedFilePathsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetSharedFilePathsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def ResetState(request,
             target,
@@ -560,3 +1168,88 @@ class SysDB(object):
             chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListCollectionVersions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ListCollectionVersions',
+            chromadb_dot_proto_dot_coordinator__pb2.ListCollectionVersionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListCollectionVersionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetSegmentsAtVersion(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/GetSegmentsAtVersion',
+            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsAtVersionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsAtVersionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RollbackCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/RollbackCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.RollbackCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.RollbackCollectionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def WatchCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/chroma.SysDB/WatchCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListAuditEvents(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.SysDB/ListAuditEvents',
+            chromadb_dot_proto_dot_coordinator__pb2.ListAuditEventsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListAuditEventsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 from chromadb.proto import chroma_pb2 as chromadb_dot_proto_dot_chroma__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1f\x63hromadb/proto/logservice.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\"R\n\x0fPushLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12(\n\x07records\x18\x02 \x03(\x0b\x32\x17.chroma.OperationRecord\"(\n\x10PushLogsResponse\x12\x14\n\x0crecord_count\x18\x01 \x01(\x05\"n\n\x0fPullLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x19\n\x11start_from_offset\x18\x02 \x01(\x03\x12\x12\n\nbatch_size\x18\x03 \x01(\x05\x12\x15\n\rend_timestamp\x18\x04 \x01(\x03\"H\n\tLogRecord\x12\x12\n\nlog_offset\x18\x01 \x01(\x03\x12\'\n\x06record\x18\x02 \x01(\x0b\x32\x17.chroma.OperationRecord\"6\n\x10PullLogsResponse\x12\"\n\x07records\x18\x01 \x03(\x0b\x32\x11.chroma.LogRecord\"W\n\x0e\x43ollectionInfo\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x18\n\x10\x66irst_log_offset\x18\x02 \x01(\x03\x12\x14\n\x0c\x66irst_log_ts\x18\x03 \x01(\x03\"C\n$GetAllCollectionInfoToCompactRequest\x12\x1b\n\x13min_compaction_size\x18\x01 \x01(\x04\"\\\n%GetAllCollectionInfoToCompactResponse\x12\x33\n\x13\x61ll_collection_info\x18\x01 \x03(\x0b\x32\x16.chroma.CollectionInfo\"M\n UpdateCollectionLogOffsetRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nlog_offset\x18\x02 \x01(\x03\"#\n!UpdateCollectionLogOffsetResponse2\x82\x03\n\nLogService\x12?\n\x08PushLogs\x12\x17.chroma.PushLogsRequest\x1a\x18.chroma.PushLogsResponse\"\x00\x12?\n\x08PullLogs\x12\x17.chroma.PullLogsRequest\x1a\x18.chroma.PullLogsResponse\"\x00\x12~\n\x1dGetAllCollectionInfoToCompact\x12,.chroma.GetAllCollectionInfoToCompactRequest\x1a-.chroma.GetAllCollectionInfoToCompactResponse\"\x00\x12r\n\x19UpdateCollectionLogOffset\x12(.chroma.UpdateCollectionLogOffsetRequest\x1a).chroma.UpdateCollectionLogOffsetResponse\"\x00\x42\x39Z7github.com/chroma-core/chroma/go/pkg/proto/logservicepbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1f\x63hromadb/proto/logservice.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\"R\n\x0fPushLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12(\n\x07records\x18\x02 \x03(\x0b\x32\x17.chroma.OperationRecord\"(\n\x10PushLogsResponse\x12\x14\n\x0crecord_count\x18\x01 \x01(\x05\"n\n\x0fPullLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x19\n\x11start_from_offset\x18\x02 \x01(\x03\x12\x12\n\nbatch_size\x18\x03 \x01(\x05\x12\x15\n\rend_timestamp\x18\x04 \x01(\x03\"H\n\tLogRecord\x12\x12\n\nlog_offset\x18\x01 \x01(\x03\x12\'\n\x06record\x18\x02 \x01(\x0b\x32\x17.chroma.OperationRecord\"6\n\x10PullLogsResponse\x12\"\n\x07records\x18\x01 \x03(\x0b\x32\x11.chroma.LogRecord\"W\n\x0e\x43ollectionInfo\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x18\n\x10\x66irst_log_offset\x18\x02 \x01(\x03\x12\x14\n\x0c\x66irst_log_ts\x18\x03 \x01(\x03\"C\n$GetAllCollectionInfoToCompactRequest\x12\x1b\n\x13min_compaction_size\x18\x01 \x01(\x04\"\\\n%GetAllCollectionInfoToCompactResponse\x12\x33\n\x13\x61ll_collection_info\x18\x01 \x03(\x0b\x32\x16.chroma.CollectionInfo\"M\n UpdateCollectionLogOffsetRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nlog_offset\x18\x02 \x01(\x03\"#\n!UpdateCollectionLogOffsetResponse\"L\n\x1fResetCollectionLogOffsetRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nlog_offset\x18\x02 \x01(\x03\"\"\n ResetCollectionLogOffsetResponse\"i\n\x0f\x46orkLogsRequest\x12\x1c\n\x14source_collection_id\x18\x01 \x01(\t\x12\x1c\n\x14target_collection_id\x18\x02 \x01(\t\x12\x1a\n\x12start_after_offset\x18\x03 \x01(\x03\"(\n\x10\x46orkLogsResponse\x12\x14\n\x0crecord_count\x18\x01 \x01(\x03\"*\n\x11\x44\x65leteLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\"\x14\n\x12\x44\x65leteLogsResponse2\xfb\x04\n\nLogService\x12?\n\x08PushLogs\x12\x17.chroma.PushLogsRequest\x1a\x18.chroma.PushLogsResponse\"\x00\x12?\n\x08PullLogs\x12\x17.chroma.PullLogsRequest\x1a\x18.chroma.PullLogsResponse\"\x00\x12~\n\x1dGetAllCollectionInfoToCompact\x12,.chroma.GetAllCollectionInfoToCompactRequest\x1a-.chroma.GetAllCollectionInfoToCompactResponse\"\x00\x12r\n\x19UpdateCollectionLogOffset\x12(.chroma.UpdateCollectionLogOffsetRequest\x1a).chroma.UpdateCollectionLogOffsetResponse\"\x00\x12?\n\x08\x46orkLogs\x12\x17.chroma.ForkLogsRequest\x1a\x18.chroma.ForkLogsResponse\"\x00\x12o\n\x18ResetCollectionLogOffset\x12\'.chroma.ResetCollectionLogOffsetRequest\x1a(.chroma.ResetCollectionLogOffsetResponse\"\x00\x12\x45\n\nDeleteLogs\x12\x19.chroma.DeleteLogsRequest\x1a\x1a.chroma.DeleteLogsResponse\"\x00\x42\x39Z7github.com/chroma-core/chroma/go/pkg/proto/logservicepbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -43,6 +43,18 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['_UPDATECOLLECTIONLOGOFFSETREQUEST']._serialized_end=769
   _globals['_UPDATECOLLECTIONLOGOFFSETRESPONSE']._serialized_start=771
   _globals['_UPDATECOLLECTIONLOGOFFSETRESPONSE']._serialized_end=806
-  _globals['_LOGSERVICE']._serialized_start=809
-  _globals['_LOGSERVICE']._serialized_end=1195
+  _globals['_RESETCOLLECTIONLOGOFFSETREQUEST']._serialized_start=808
+  _globals['_RESETCOLLECTIONLOGOFFSETREQUEST']._serialized_end=884
+  _globals['_RESETCOLLECTIONLOGOFFSETRESPONSE']._serialized_start=886
+  _globals['_RESETCOLLECTIONLOGOFFSETRESPONSE']._serialized_end=920
+  _globals['_FORKLOGSREQUEST']._serialized_start=922
+  _globals['_FORKLOGSREQUEST']._serialized_end=1027
+  _globals['_FORKLOGSRESPONSE']._serialized_start=1029
+  _globals['_FORKLOGSRESPONSE']._serialized_end=1069
+  _globals['_DELETELOGSREQUEST']._serialized_start=1071
+  _globals['_DELETELOGSREQUEST']._serialized_end=1113
+  _globals['_DELETELOGSRESPONSE']._serialized_start=1115
+  _globals['_DELETELOGSRESPONSE']._serialized_end=1135
+  _globals['_LOGSERVICE']._serialized_start=1138
+  _globals['_LOGSERVICE']._serialized_end=1773
 # @@protoc_insertion_point(module_scope)
@@ -79,3 +79,41 @@ class UpdateCollectionLogOffsetRequest(_message.Message):
 class UpdateCollectionLogOffsetResponse(_message.Message):
     __slots__ = []
     def __init__(self) -> None: ...
+
+class ResetCollectionLogOffsetRequest(_message.Message):
+    __slots__ = ["collection_id", "log_offset"]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    LOG_OFFSET_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    log_offset: int
+    def __init__(self, collection_id: _Optional[str] = ..., log_offset: _Optional[int] = ...) -> None: ...
+
+class ResetCollectionLogOffsetResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
+class ForkLogsRequest(_message.Message):
+    __slots__ = ["source_collection_id", "target_collection_id", "start_after_offset"]
+    SOURCE_COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    TARGET_COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    START_AFTER_OFFSET_FIELD_NUMBER: _ClassVar[int]
+    source_collection_id: str
+    target_collection_id: str
+    start_after_offset: int
+    def __init__(self, source_collection_id: _Optional[str] = ..., target_collection_id: _Optional[str] = ..., start_after_offset: _Optional[int] = ...) -> None: ...
+
+class ForkLogsResponse(_message.Message):
+    __slots__ = ["record_count"]
+    RECORD_COUNT_FIELD_NUMBER: _ClassVar[int]
+    record_count: int
+    def __init__(self, record_count: _Optional[int] = ...) -> None: ...
+
+class DeleteLogsRequest(_message.Message):
+    __slots__ = ["collection_id"]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    def __init__(self, collection_id: _Optional[str] = ...) -> None: ...
+
+class DeleteLogsResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
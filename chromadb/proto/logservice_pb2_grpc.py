@@ -34,6 +34,21 @@ class LogServiceStub(object):
                 request_serializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetResponse.FromString,
                 )
+        self.ForkLogs = channel.unary_unary(
+                '/chroma.LogService/ForkLogs',
+                request_serializer=chromadb_dot_proto_dot_logservice__pb2.ForkLogsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_logservice__pb2.ForkLogsResponse.FromString,
+                )
+        self.ResetCollectionLogOffset = channel.unary_unary(
+                '/chroma.LogService/ResetCollectionLogOffset',
+                request_serializer=chromadb_dot_proto_dot_logservice__pb2.ResetCollectionLogOffsetRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_logservice__pb2.ResetCollectionLogOffsetResponse.FromString,
+                )
+        self.DeleteLogs = channel.unary_unary(
+                '/chroma.LogService/DeleteLogs',
+                request_serializer=chromadb_dot_proto_dot_logservice__pb2.DeleteLogsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_logservice__pb2.DeleteLogsResponse.FromString,
+                )
 
 
 class LogServiceServicer(object):
@@ -63,6 +78,24 @@ class LogServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ForkLogs(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ResetCollectionLogOffset(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteLogs(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_LogServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -86,6 +119,21 @@ def add_LogServiceServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetResponse.SerializeToString,
             ),
+            'ForkLogs': grpc.unary_unary_rpc_method_handler(
+                    servicer.ForkLogs,
+                    request_deserializer=chromadb_dot_proto_dot_logservice__pb2.ForkLogsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_logservice__pb2.ForkLogsResponse.SerializeToString,
+            ),
+            'ResetCollectionLogOffset': grpc.unary_unary_rpc_method_handler(
+                    servicer.ResetCollectionLogOffset,
+                    request_deserializer=chromadb_dot_proto_dot_logservice__pb2.ResetCollectionLogOffsetRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_logservice__pb2.ResetCollectionLogOffsetResponse.SerializeToString,
+            ),
+            'DeleteLogs': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteLogs,
+                    request_deserializer=chromadb_dot_proto_dot_logservice__pb2.DeleteLogsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_logservice__pb2.DeleteLogsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'chroma.LogService', rpc_method_handlers)
@@ -163,3 +211,54 @@ class LogService(object):
             chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ForkLogs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.LogService/ForkLogs',
+            chromadb_dot_proto_dot_logservice__pb2.ForkLogsRequest.SerializeToString,
+            chromadb_dot_proto_dot_logservice__pb2.ForkLogsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ResetCollectionLogOffset(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.LogService/ResetCollectionLogOffset',
+            chromadb_dot_proto_dot_logservice__pb2.ResetCollectionLogOffsetRequest.SerializeToString,
+            chromadb_dot_proto_dot_logservice__pb2.ResetCollectionLogOffsetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteLogs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/chroma.LogService/DeleteLogs',
+            chromadb_dot_proto_dot_logservice__pb2.DeleteLogsRequest.SerializeToString,
+            chromadb_dot_proto_dot_logservice__pb2.DeleteLogsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
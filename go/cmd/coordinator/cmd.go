@@ -40,6 +40,12 @@ func init() {
 	Cmd.Flags().IntVar(&conf.DBConfig.MaxIdleConns, "max-idle-conns", 10, "MetaTable max idle connections")
 	Cmd.Flags().IntVar(&conf.DBConfig.MaxOpenConns, "max-open-conns", 10, "MetaTable max open connections")
 	Cmd.Flags().StringVar(&conf.DBConfig.SslMode, "ssl-mode", "disable", "SSL mode for database connection")
+	Cmd.Flags().StringVar(&conf.ReplicaDBConfig.Address, "replica-db-address", "", "MetaTable read replica address, reads are served by the primary if empty")
+	Cmd.Flags().IntVar(&conf.ReplicaDBConfig.Port, "replica-db-port", 0, "MetaTable read replica port, db-port if zero")
+	Cmd.Flags().StringVar(&conf.ReplicaDBConfig.Username, "replica-username", "", "MetaTable read replica username, username and password if empty")
+	Cmd.Flags().StringVar(&conf.ReplicaDBConfig.Password, "replica-password", "", "MetaTable read replica password")
+	Cmd.Flags().IntVar(&conf.ReplicaDBConfig.MaxOpenConns, "replica-max-open-conns", 0, "MetaTable read replica max open connections, max-open-conns if zero")
+	Cmd.Flags().StringVar(&conf.SqlitePath, "sqlite-path", "", "Embedded SQLite catalog path for the memory provider, in memory if empty")
 
 	// Soft deletes
 	Cmd.Flags().BoolVar(&conf.SoftDeleteEnabled, "soft-delete-enabled", false, "Enable soft deletes")
@@ -47,6 +53,27 @@ func init() {
 	Cmd.Flags().DurationVar(&conf.SoftDeleteMaxAge, "soft-delete-max-age", 72*time.Hour, "Soft delete max age")
 	Cmd.Flags().UintVar(&conf.SoftDeleteCleanupBatchSize, "soft-delete-cleanup-batch-size", 10, "Soft delete cleanup batch size")
 
+	// Collection watch
+	Cmd.Flags().DurationVar(&conf.CollectionWatchPollInterval, "collection-watch-poll-interval", 1*time.Second, "How often collection watches poll for changes")
+	Cmd.Flags().DurationVar(&conf.CollectionWatchRetention, "collection-watch-retention", 24*time.Hour, "How long changes are kept for collection watches, forever if zero")
+
+	// Idempotency keys
+	Cmd.Flags().DurationVar(&conf.IdempotencyWindow, "idempotency-window", 24*time.Hour, "How long responses to requests with an idempotency key are remembered, disabled if zero")
+
+	// Default tenant quota, zero is unlimited
+	Cmd.Flags().Int32Var(&conf.DefaultQuota.MaxDatabases, "quota-max-databases", 0, "Default maximum number of databases per tenant")
+	Cmd.Flags().Int32Var(&conf.DefaultQuota.MaxCollectionsPerDatabase, "quota-max-collections-per-database", 0, "Default maximum number of collections per database")
+	Cmd.Flags().Int32Var(&conf.DefaultQuota.MaxMetadataKeysPerCollection, "quota-max-metadata-keys-per-collection", 0, "Default maximum number of metadata keys per collection")
+	Cmd.Flags().Int32Var(&conf.DefaultQuota.MaxMetadataValueSize, "quota-max-metadata-value-size", 0, "Default maximum size in bytes of a collection metadata string value")
+	Cmd.Flags().Int32Var(&conf.DefaultQuota.MaxCollectionDimension, "quota-max-collection-dimension", 0, "Default maximum collection dimension")
+
+	// Catalog cache
+	Cmd.Flags().IntVar(&conf.CatalogCache.Size, "catalog-cache-size", 0, "Maximum number of collections and segment lists in the catalog cache, disabled if zero")
+	Cmd.Flags().DurationVar(&conf.CatalogCache.TTL, "catalog-cache-ttl", 10*time.Second, "How long the catalog cache keeps an entry, which bounds how long changes made through other coordinators go unseen")
+
+	// Log service
+	Cmd.Flags().StringVar(&conf.LogServiceAddress, "log-service-address", "", "Log service address the records of forked collections are copied through, forking is disabled if empty")
+
 	// Memberlist
 	Cmd.Flags().StringVar(&conf.KubernetesNamespace, "kubernetes-namespace", "chroma", "Kubernetes namespace")
 	Cmd.Flags().DurationVar(&conf.ReconcileInterval, "reconcile-interval", 100*time.Millisecond, "Reconcile interval")
@@ -2,12 +2,14 @@ package common
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	// Tenant errors
 	ErrTenantNotFound                  = errors.New("tenant not found")
 	ErrTenantUniqueConstraintViolation = errors.New("tenant unique constraint violation")
+	ErrTenantSuspended                 = errors.New("tenant is suspended")
 
 	// Database errors
 	ErrDatabaseNotFound                  = errors.New("database not found")
@@ -23,10 +25,17 @@ var (
 	ErrCollectionLogPositionStale            = errors.New("collection log position Stale")
 	ErrCollectionVersionStale                = errors.New("collection version stale")
 	ErrCollectionVersionInvalid              = errors.New("collection version invalid")
+	ErrCollectionVersionNotFound             = errors.New("collection version not found")
+	ErrCollectionVersionFilesCollected       = errors.New("collection version files may have been garbage collected")
+
+	// Log service errors
+	ErrLogServiceNotConfigured = errors.New("log service is not configured")
+	ErrCollectionLogPurged     = errors.New("collection log records were purged")
 
 	// Collection metadata errors
 	ErrUnknownCollectionMetadataType = errors.New("collection metadata value type not supported")
 	ErrInvalidMetadataUpdate         = errors.New("invalid metadata update, reest metadata true and metadata value not empty")
+	ErrInvalidMetadataFilter         = errors.New("invalid collection metadata filter")
 
 	// Segment errors
 	ErrSegmentIDFormat                  = errors.New("segment id format error")
@@ -38,4 +47,83 @@ var (
 
 	// Segment metadata errors
 	ErrUnknownSegmentMetadataType = errors.New("segment metadata value type not supported")
+
+	// Row version errors
+	ErrRowVersionMismatch = errors.New("row version mismatch")
+
+	// Collection watch errors
+	ErrRevisionTooOld = errors.New("revision too old, the changes after it were purged")
+
+	// Idempotency errors
+	ErrIdempotencyKeyReused  = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyKeyPending = errors.New("a request with the same idempotency key is in progress")
+
+	// Quota errors
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	// Collection configuration errors
+	ErrInvalidCollectionConfiguration = errors.New("invalid collection configuration")
+
+	// Collection invariant errors
+	ErrCollectionDimensionImmutable = errors.New("collection dimension can not be changed once set")
+	ErrCollectionSpaceImmutable     = errors.New("collection space can not be changed")
+	ErrCollectionSegmentScopes      = errors.New("collection must have exactly one segment of each scope: VECTOR, METADATA and RECORD")
+)
+
+// RowVersionMismatchError is returned when an update expected another row
+// version than the current one. It matches ErrRowVersionMismatch.
+type RowVersionMismatchError struct {
+	CurrentRowVersion int64
+}
+
+func (e *RowVersionMismatchError) Error() string {
+	return fmt.Sprintf("%s, current row version is %d", ErrRowVersionMismatch, e.CurrentRowVersion)
+}
+
+func (e *RowVersionMismatchError) Is(target error) bool {
+	return target == ErrRowVersionMismatch
+}
+
+// Quota names, as reported by QuotaExceededError.
+const (
+	QuotaMaxDatabases                 = "max_databases"
+	QuotaMaxCollectionsPerDatabase    = "max_collections_per_database"
+	QuotaMaxMetadataKeysPerCollection = "max_metadata_keys_per_collection"
+	QuotaMaxMetadataValueSize         = "max_metadata_value_size"
+	QuotaMaxCollectionDimension       = "max_collection_dimension"
 )
+
+// QuotaExceededError is returned when a change would take a tenant over one of
+// its limits. Requested is what the change asked for, a count or a size. It
+// matches ErrQuotaExceeded.
+type QuotaExceededError struct {
+	TenantID  string
+	Quota     string
+	Limit     int64
+	Requested int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s, %s of tenant %s is %d, %d requested", ErrQuotaExceeded, e.Quota, e.TenantID, e.Limit, e.Requested)
+}
+
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// InvalidCollectionConfigurationError is returned when a collection
+// configuration, or a change to it, is rejected. Field is the JSON path of the
+// offending field, like "hnsw_configuration.ef_search". It matches
+// ErrInvalidCollectionConfiguration.
+type InvalidCollectionConfigurationError struct {
+	Field       string
+	Description string
+}
+
+func (e *InvalidCollectionConfigurationError) Error() string {
+	return fmt.Sprintf("%s, %s: %s", ErrInvalidCollectionConfiguration, e.Field, e.Description)
+}
+
+func (e *InvalidCollectionConfigurationError) Is(target error) bool {
+	return target == ErrInvalidCollectionConfiguration
+}
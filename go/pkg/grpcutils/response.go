@@ -1,6 +1,8 @@
 package grpcutils
 
 import (
+	"strconv"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
@@ -39,6 +41,52 @@ func BuildNotFoundGrpcError(msg string) error {
 	return status.Error(codes.NotFound, msg)
 }
 
+func BuildFailedPreconditionGrpcError(msg string) error {
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
+// BuildAbortedGrpcError reports a failed compare-and-set. The current row
+// version is attached as ErrorInfo metadata so clients can retry against it.
+func BuildAbortedGrpcError(msg string, currentRowVersion int64) (error, error) {
+	st := status.New(codes.Aborted, msg)
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "ROW_VERSION_MISMATCH",
+		Domain:   "chroma",
+		Metadata: map[string]string{"current_row_version": strconv.FormatInt(currentRowVersion, 10)},
+	})
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return nil, err
+	}
+	return st.Err(), nil
+}
+
+// BuildResourceExhaustedGrpcError reports a quota violation. The violation is
+// attached as QuotaFailure, with its limit and the requested amount as
+// ErrorInfo metadata.
+func BuildResourceExhaustedGrpcError(msg string, subject string, quota string, limit int64, requested int64) (error, error) {
+	st := status.New(codes.ResourceExhausted, msg)
+	st, err := st.WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     subject,
+			Description: msg,
+		}},
+	}, &errdetails.ErrorInfo{
+		Reason: "QUOTA_EXCEEDED",
+		Domain: "chroma",
+		Metadata: map[string]string{
+			"quota":     quota,
+			"limit":     strconv.FormatInt(limit, 10),
+			"requested": strconv.FormatInt(requested, 10),
+		},
+	})
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return nil, err
+	}
+	return st.Err(), nil
+}
+
 func BuildErrorForUUID(ID types.UniqueID, name string, err error) error {
 	if err != nil || ID == types.NilUniqueID() {
 		log.Error(name+"id format error", zap.String(name+".id", ID.String()))
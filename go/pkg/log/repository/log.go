@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"math"
 	"time"
 
 	log "github.com/chroma-core/chroma/go/pkg/log/store/db"
@@ -13,6 +14,10 @@ import (
 	"go.uber.org/zap"
 )
 
+// ErrRecordsPurged is returned when records that are needed were already
+// purged from the log.
+var ErrRecordsPurged = errors.New("[internal error] some entries have been purged")
+
 type LogRepository struct {
 	conn    *pgxpool.Pool
 	queries *log.Queries
@@ -81,6 +86,91 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 	return
 }
 
+// ForkRecords copies the records of a collection after startAfterOffset to
+// the log of a new collection, numbering them from 1 so that the new
+// collection starts at log position 0.
+func (r *LogRepository) ForkRecords(ctx context.Context, sourceCollectionId string, targetCollectionId string, startAfterOffset int64) (forkCount int64, err error) {
+	var tx pgx.Tx
+	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		trace_log.Error("Error in begin transaction for forking records", zap.Error(err), zap.String("collectionId", sourceCollectionId))
+		return
+	}
+	queriesWithTx := r.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+	// Locking the source keeps records from being appended while they are copied.
+	source, err := queriesWithTx.GetCollectionForUpdate(ctx, sourceCollectionId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Nothing was ever written to the source.
+		source, err = log.Collection{ID: sourceCollectionId}, nil
+	}
+	if err != nil {
+		trace_log.Error("Error in fetching collection from collection table", zap.Error(err), zap.String("collectionId", sourceCollectionId))
+		return
+	}
+	if startAfterOffset < source.RecordCompactionOffsetPosition {
+		trace_log.Error("Error in forking records. Some entries have been purged.", zap.String("collectionId", sourceCollectionId), zap.Int64("startAfterOffset", startAfterOffset), zap.Int64("compactionOffset", source.RecordCompactionOffsetPosition))
+		err = ErrRecordsPurged
+		return
+	}
+	var records []log.RecordLog
+	if count := source.RecordEnumerationOffsetPosition - startAfterOffset; count > 0 {
+		if count > math.MaxInt32 {
+			err = errors.New("[internal error] too many entries to fork")
+			return
+		}
+		records, err = queriesWithTx.GetRecordsForCollection(ctx, log.GetRecordsForCollectionParams{
+			CollectionID: sourceCollectionId,
+			Offset:       startAfterOffset + 1,
+			Limit:        int32(count),
+			Timestamp:    math.MaxInt64,
+		})
+		if err != nil {
+			trace_log.Error("Error in pulling records to fork from record_log table", zap.Error(err), zap.String("collectionId", sourceCollectionId))
+			return
+		}
+		if int64(len(records)) != count || records[0].Offset != startAfterOffset+1 {
+			trace_log.Error("Error in forking records. Some entries have been purged.", zap.String("collectionId", sourceCollectionId), zap.Int64("startAfterOffset", startAfterOffset))
+			err = ErrRecordsPurged
+			return
+		}
+	}
+	_, err = queriesWithTx.InsertCollection(ctx, log.InsertCollectionParams{
+		ID:                              targetCollectionId,
+		RecordEnumerationOffsetPosition: int64(len(records)),
+		RecordCompactionOffsetPosition:  0,
+	})
+	if err != nil {
+		trace_log.Error("Error in creating a new entry in collection table", zap.Error(err), zap.String("collectionId", targetCollectionId))
+		return
+	}
+	if len(records) == 0 {
+		return
+	}
+	params := make([]log.InsertRecordParams, len(records))
+	for i, record := range records {
+		params[i] = log.InsertRecordParams{
+			CollectionID: targetCollectionId,
+			Record:       record.Record,
+			Offset:       int64(i) + 1,
+			Timestamp:    record.Timestamp,
+		}
+	}
+	forkCount, err = queriesWithTx.InsertRecord(ctx, params)
+	if err != nil {
+		trace_log.Error("Error in inserting forked records to record_log table", zap.Error(err), zap.String("collectionId", targetCollectionId))
+		return
+	}
+	trace_log.Info("Forked records to record_log table", zap.Int64("recordCount", forkCount), zap.String("sourceCollectionId", sourceCollectionId), zap.String("collectionId", targetCollectionId))
+	return
+}
+
 func (r *LogRepository) PullRecords(ctx context.Context, collectionId string, offset int64, batchSize int, timestamp int64) (records []log.RecordLog, err error) {
 	records, err = r.queries.GetRecordsForCollection(ctx, log.GetRecordsForCollectionParams{
 		CollectionID: collectionId,
@@ -95,7 +185,7 @@ func (r *LogRepository) PullRecords(ctx context.Context, collectionId string, of
 	// Relies on the fact that the records are ordered by offset.
 	if len(records) > 0 && records[0].Offset != offset {
 		trace_log.Error("Error in pulling records from record_log table. Some entries have been purged.", zap.String("collectionId", collectionId), zap.Int("requestedOffset", int(offset)), zap.Int("actualOffset", int(records[0].Offset)))
-		records, err = nil, errors.New("[internal error] some entries have been purged")
+		records, err = nil, ErrRecordsPurged
 		return
 	}
 	// This means that the log is empty i.e. compaction_offset = enumeration_offset
@@ -117,7 +207,7 @@ func (r *LogRepository) PullRecords(ctx context.Context, collectionId string, of
 		}
 		if offset <= compacted_offset {
 			trace_log.Error("Error in pulling records from record_log table. Some entries have been purged.", zap.String("collectionId", collectionId), zap.Int("requestedOffset", int(offset)), zap.Int("actualOffset", int(compacted_offset)))
-			records, err = nil, errors.New("[internal error] some entries have been purged")
+			records, err = nil, ErrRecordsPurged
 			return
 		}
 	}
@@ -148,6 +238,98 @@ func (r *LogRepository) UpdateCollectionCompactionOffsetPosition(ctx context.Con
 	return
 }
 
+// ResetCollectionCompactionOffsetPosition moves the compaction offset of a
+// collection back to offsetPosition so that the records after it are compacted
+// again. It fails with ErrRecordsPurged if some of them were already purged.
+func (r *LogRepository) ResetCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64) (err error) {
+	var tx pgx.Tx
+	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		trace_log.Error("Error in begin transaction for resetting record_compaction_offset_position", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	queriesWithTx := r.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+	collection, err := queriesWithTx.GetCollectionForUpdate(ctx, collectionId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The log is empty, there is nothing to compact again.
+		err = nil
+		if offsetPosition > 0 {
+			err = ErrRecordsPurged
+		}
+		return
+	}
+	if err != nil {
+		trace_log.Error("Error in fetching collection from collection table", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	if offsetPosition >= collection.RecordCompactionOffsetPosition {
+		return
+	}
+	var records []log.RecordLog
+	records, err = queriesWithTx.GetRecordsForCollection(ctx, log.GetRecordsForCollectionParams{
+		CollectionID: collectionId,
+		Offset:       offsetPosition + 1,
+		Limit:        1,
+		Timestamp:    math.MaxInt64,
+	})
+	if err != nil {
+		trace_log.Error("Error in pulling records from record_log table", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	if len(records) == 0 || records[0].Offset != offsetPosition+1 {
+		trace_log.Error("Error in resetting record_compaction_offset_position. Some entries have been purged.", zap.String("collectionId", collectionId), zap.Int64("offsetPosition", offsetPosition))
+		err = ErrRecordsPurged
+		return
+	}
+	err = queriesWithTx.UpdateCollectionCompactionOffsetPosition(ctx, log.UpdateCollectionCompactionOffsetPositionParams{
+		ID:                             collectionId,
+		RecordCompactionOffsetPosition: offsetPosition,
+	})
+	if err != nil {
+		trace_log.Error("Error in resetting record_compaction_offset_position in the collection table", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	trace_log.Info("Reset record_compaction_offset_position in the collection table", zap.Int64("offsetPosition", offsetPosition), zap.String("collectionId", collectionId))
+	return
+}
+
+// DeleteRecords deletes the log of a collection, records and offsets alike.
+func (r *LogRepository) DeleteRecords(ctx context.Context, collectionId string) (err error) {
+	var tx pgx.Tx
+	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		trace_log.Error("Error in begin transaction for deleting records", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	queriesWithTx := r.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+	err = queriesWithTx.DeleteRecords(ctx, []string{collectionId})
+	if err != nil {
+		trace_log.Error("Error in deleting records from record_log table", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	err = queriesWithTx.DeleteCollection(ctx, []string{collectionId})
+	if err != nil {
+		trace_log.Error("Error in deleting collection from collection table", zap.Error(err), zap.String("collectionId", collectionId))
+		return
+	}
+	trace_log.Info("Deleted the log of collection", zap.String("collectionId", collectionId))
+	return
+}
+
 func (r *LogRepository) PurgeRecords(ctx context.Context) (err error) {
 	trace_log.Info("Purging records from record_log table")
 	err = r.queries.PurgeRecords(ctx)
@@ -170,6 +352,10 @@ func (r *LogRepository) GetLastCompactedOffsetForCollection(ctx context.Context,
 	return
 }
 
+// checkCollectionsBatchSize is the number of collections garbage collection
+// checks against sysdb per request.
+const checkCollectionsBatchSize = 1000
+
 func (r *LogRepository) GarbageCollection(ctx context.Context) error {
 	collectionToCompact, err := r.queries.GetAllCollections(ctx)
 	if err != nil {
@@ -182,14 +368,22 @@ func (r *LogRepository) GarbageCollection(ctx context.Context) error {
 		return nil
 	}
 	collectionsToGC := make([]string, 0)
-	for _, collection := range collectionToCompact {
-		exist, err := r.sysDb.CheckCollection(ctx, collection)
+	for start := 0; start < len(collectionToCompact); start += checkCollectionsBatchSize {
+		end := min(start+checkCollectionsBatchSize, len(collectionToCompact))
+		batch := collectionToCompact[start:end]
+		existing, err := r.sysDb.CheckCollections(ctx, batch)
 		if err != nil {
-			trace_log.Error("Error in checking collection in sysdb", zap.Error(err), zap.String("collectionId", collection))
+			trace_log.Error("Error in checking collections in sysdb", zap.Error(err), zap.Int("collectionCount", len(batch)))
 			continue
 		}
-		if !exist {
-			collectionsToGC = append(collectionsToGC, collection)
+		exists := make(map[string]struct{}, len(existing))
+		for _, collection := range existing {
+			exists[collection] = struct{}{}
+		}
+		for _, collection := range batch {
+			if _, ok := exists[collection]; !ok {
+				collectionsToGC = append(collectionsToGC, collection)
+			}
 		}
 	}
 	if len(collectionsToGC) > 0 {
@@ -79,6 +79,89 @@ func (suite *LogTestSuite) TestGarbageCollection() {
 	assert.Equal(suite.t, int64(1), records[0].Offset, "Failed to run garbage collection")
 }
 
+func (suite *LogTestSuite) TestForkRecords() {
+	ctx := context.Background()
+	sourceID := types.NewUniqueID()
+	forkID := types.NewUniqueID()
+
+	count, err := suite.lr.InsertRecords(ctx, sourceID.String(), [][]byte{{1}, {2}, {3}, {4}})
+	assert.NoError(suite.t, err, "Failed to insert records")
+	assert.Equal(suite.t, int64(4), count, "Failed to insert records")
+	err = suite.lr.UpdateCollectionCompactionOffsetPosition(ctx, sourceID.String(), 2)
+	assert.NoError(suite.t, err, "Failed to update compaction offset")
+
+	// Records up to the compaction offset may be purged, they cannot be forked.
+	_, err = suite.lr.ForkRecords(ctx, sourceID.String(), forkID.String(), 1)
+	assert.Error(suite.t, err, "Forked purged records")
+
+	count, err = suite.lr.ForkRecords(ctx, sourceID.String(), forkID.String(), 2)
+	assert.NoError(suite.t, err, "Failed to fork records")
+	assert.Equal(suite.t, int64(2), count, "Failed to fork records")
+	records, err := suite.lr.PullRecords(ctx, forkID.String(), 1, 10, time.Now().UnixNano())
+	assert.NoError(suite.t, err, "Failed to pull records")
+	assert.Equal(suite.t, 2, len(records), "Failed to fork records")
+	assert.Equal(suite.t, int64(1), records[0].Offset, "Failed to fork records")
+	assert.Equal(suite.t, []byte{3}, records[0].Record, "Failed to fork records")
+	assert.Equal(suite.t, []byte{4}, records[1].Record, "Failed to fork records")
+
+	// Writes to the fork follow the forked records, the source is unaffected.
+	count, err = suite.lr.InsertRecords(ctx, forkID.String(), [][]byte{{5}})
+	assert.NoError(suite.t, err, "Failed to insert records")
+	assert.Equal(suite.t, int64(1), count, "Failed to insert records")
+	records, err = suite.lr.PullRecords(ctx, forkID.String(), 3, 10, time.Now().UnixNano())
+	assert.NoError(suite.t, err, "Failed to pull records")
+	assert.Equal(suite.t, 1, len(records), "Failed to insert records")
+	assert.Equal(suite.t, []byte{5}, records[0].Record, "Failed to insert records")
+	records, err = suite.lr.PullRecords(ctx, sourceID.String(), 3, 10, time.Now().UnixNano())
+	assert.NoError(suite.t, err, "Failed to pull records")
+	assert.Equal(suite.t, 2, len(records), "Failed to pull records")
+}
+
+func (suite *LogTestSuite) TestResetCollectionCompactionOffsetPosition() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+
+	_, err := suite.lr.InsertRecords(ctx, collectionID.String(), [][]byte{{1}, {2}, {3}, {4}})
+	assert.NoError(suite.t, err, "Failed to insert records")
+	err = suite.lr.UpdateCollectionCompactionOffsetPosition(ctx, collectionID.String(), 4)
+	assert.NoError(suite.t, err, "Failed to update compaction offset")
+
+	// The records are still in the log, they can be compacted again.
+	err = suite.lr.ResetCollectionCompactionOffsetPosition(ctx, collectionID.String(), 2)
+	assert.NoError(suite.t, err, "Failed to reset compaction offset")
+	compactedOffset, err := suite.lr.GetLastCompactedOffsetForCollection(ctx, collectionID.String())
+	assert.NoError(suite.t, err, "Failed to get compaction offset")
+	assert.Equal(suite.t, int64(2), compactedOffset, "Failed to reset compaction offset")
+
+	// Once purged, they can not.
+	err = suite.lr.PurgeRecords(ctx)
+	assert.NoError(suite.t, err, "Failed to purge records")
+	err = suite.lr.ResetCollectionCompactionOffsetPosition(ctx, collectionID.String(), 1)
+	assert.ErrorIs(suite.t, err, ErrRecordsPurged, "Reset compaction offset past purged records")
+}
+
+func (suite *LogTestSuite) TestDeleteRecords() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+
+	_, err := suite.lr.InsertRecords(ctx, collectionID.String(), [][]byte{{1}, {2}})
+	assert.NoError(suite.t, err, "Failed to insert records")
+	err = suite.lr.DeleteRecords(ctx, collectionID.String())
+	assert.NoError(suite.t, err, "Failed to delete records")
+	records, err := suite.lr.PullRecords(ctx, collectionID.String(), 1, 10, time.Now().UnixNano())
+	assert.NoError(suite.t, err, "Failed to pull records")
+	assert.Equal(suite.t, 0, len(records), "Failed to delete records")
+
+	// A deleted log starts over.
+	count, err := suite.lr.InsertRecords(ctx, collectionID.String(), [][]byte{{3}})
+	assert.NoError(suite.t, err, "Failed to insert records")
+	assert.Equal(suite.t, int64(1), count, "Failed to insert records")
+	records, err = suite.lr.PullRecords(ctx, collectionID.String(), 1, 10, time.Now().UnixNano())
+	assert.NoError(suite.t, err, "Failed to pull records")
+	assert.Equal(suite.t, 1, len(records), "Failed to insert records")
+	assert.Equal(suite.t, int64(1), records[0].Offset, "Failed to insert records")
+}
+
 func TestLogTestSuite(t *testing.T) {
 	testSuite := new(LogTestSuite)
 	testSuite.t = t
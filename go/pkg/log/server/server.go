@@ -2,12 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
 	log "github.com/chroma-core/chroma/go/pkg/log/store/db"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -105,6 +108,66 @@ func (s *logServer) UpdateCollectionLogOffset(ctx context.Context, req *logservi
 	return
 }
 
+func (s *logServer) ForkLogs(ctx context.Context, req *logservicepb.ForkLogsRequest) (res *logservicepb.ForkLogsResponse, err error) {
+	var sourceCollectionID, targetCollectionID types.UniqueID
+	sourceCollectionID, err = types.ToUniqueID(&req.SourceCollectionId)
+	if err != nil {
+		return
+	}
+	targetCollectionID, err = types.ToUniqueID(&req.TargetCollectionId)
+	if err != nil {
+		return
+	}
+	var recordCount int64
+	recordCount, err = s.lr.ForkRecords(ctx, sourceCollectionID.String(), targetCollectionID.String(), req.StartAfterOffset)
+	if err != nil {
+		err = purgedRecordsError(err)
+		return
+	}
+	res = &logservicepb.ForkLogsResponse{
+		RecordCount: recordCount,
+	}
+	return
+}
+
+func (s *logServer) ResetCollectionLogOffset(ctx context.Context, req *logservicepb.ResetCollectionLogOffsetRequest) (res *logservicepb.ResetCollectionLogOffsetResponse, err error) {
+	var collectionID types.UniqueID
+	collectionID, err = types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		return
+	}
+	err = s.lr.ResetCollectionCompactionOffsetPosition(ctx, collectionID.String(), req.LogOffset)
+	if err != nil {
+		err = purgedRecordsError(err)
+		return
+	}
+	res = &logservicepb.ResetCollectionLogOffsetResponse{}
+	return
+}
+
+func (s *logServer) DeleteLogs(ctx context.Context, req *logservicepb.DeleteLogsRequest) (res *logservicepb.DeleteLogsResponse, err error) {
+	var collectionID types.UniqueID
+	collectionID, err = types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		return
+	}
+	err = s.lr.DeleteRecords(ctx, collectionID.String())
+	if err != nil {
+		return
+	}
+	res = &logservicepb.DeleteLogsResponse{}
+	return
+}
+
+// purgedRecordsError reports purged records as a failed precondition, so that
+// clients can tell them apart from transient errors.
+func purgedRecordsError(err error) error {
+	if errors.Is(err, repository.ErrRecordsPurged) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
 func NewLogServer(lr *repository.LogRepository) logservicepb.LogServiceServer {
 	return &logServer{
 		lr: lr,
@@ -17,6 +17,16 @@ func (s *MockSysDB) CheckCollection(ctx context.Context, collectionId string) (b
 	return ok, nil
 }
 
+func (s *MockSysDB) CheckCollections(ctx context.Context, collectionIds []string) ([]string, error) {
+	existing := make([]string, 0, len(collectionIds))
+	for _, collectionId := range collectionIds {
+		if _, ok := s.collections[collectionId]; ok {
+			existing = append(existing, collectionId)
+		}
+	}
+	return existing, nil
+}
+
 func (s *MockSysDB) AddCollection(ctx context.Context, collectionId string) error {
 	s.collections[collectionId] = true
 	return nil
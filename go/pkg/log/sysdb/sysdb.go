@@ -14,6 +14,9 @@ import (
 
 type ISysDB interface {
 	CheckCollection(ctx context.Context, collectionId string) (bool, error)
+	// CheckCollections returns the collections, among collectionIds, that
+	// exist. Soft deleted collections exist until they are hard deleted.
+	CheckCollections(ctx context.Context, collectionIds []string) ([]string, error)
 	AddCollection(ctx context.Context, collectionId string) error
 }
 
@@ -47,20 +50,22 @@ func NewSysDB(conn string) *SysDB {
 }
 
 func (s *SysDB) CheckCollection(ctx context.Context, collectionId string) (bool, error) {
-	// TODO: make this check a batch API
-	request := &coordinatorpb.GetCollectionsRequest{
-		Id: &collectionId,
-	}
-	response, err := s.client.GetCollections(ctx, request)
+	existing, err := s.CheckCollections(ctx, []string{collectionId})
 	if err != nil {
 		return false, err
 	}
-	for _, collection := range response.Collections {
-		if collection.Id == collectionId {
-			return true, nil
-		}
+	return len(existing) == 1 && existing[0] == collectionId, nil
+}
+
+func (s *SysDB) CheckCollections(ctx context.Context, collectionIds []string) ([]string, error) {
+	request := &coordinatorpb.CheckCollectionsRequest{
+		CollectionIds: collectionIds,
+	}
+	response, err := s.client.CheckCollections(ctx, request)
+	if err != nil {
+		return nil, err
 	}
-	return false, nil
+	return response.CollectionIds, nil
 }
 
 func (s *SysDB) AddCollection(ctx context.Context, collectionId string) error {
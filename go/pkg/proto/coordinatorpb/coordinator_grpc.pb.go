@@ -22,8 +22,18 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	SysDB_CreateDatabase_FullMethodName                 = "/chroma.SysDB/CreateDatabase"
 	SysDB_GetDatabase_FullMethodName                    = "/chroma.SysDB/GetDatabase"
+	SysDB_ListDatabases_FullMethodName                  = "/chroma.SysDB/ListDatabases"
+	SysDB_UpdateDatabase_FullMethodName                 = "/chroma.SysDB/UpdateDatabase"
+	SysDB_DeleteDatabase_FullMethodName                 = "/chroma.SysDB/DeleteDatabase"
 	SysDB_CreateTenant_FullMethodName                   = "/chroma.SysDB/CreateTenant"
 	SysDB_GetTenant_FullMethodName                      = "/chroma.SysDB/GetTenant"
+	SysDB_ListTenants_FullMethodName                    = "/chroma.SysDB/ListTenants"
+	SysDB_UpdateTenant_FullMethodName                   = "/chroma.SysDB/UpdateTenant"
+	SysDB_DeleteTenant_FullMethodName                   = "/chroma.SysDB/DeleteTenant"
+	SysDB_SuspendTenant_FullMethodName                  = "/chroma.SysDB/SuspendTenant"
+	SysDB_ResumeTenant_FullMethodName                   = "/chroma.SysDB/ResumeTenant"
+	SysDB_GetTenantQuota_FullMethodName                 = "/chroma.SysDB/GetTenantQuota"
+	SysDB_SetTenantQuota_FullMethodName                 = "/chroma.SysDB/SetTenantQuota"
 	SysDB_CreateSegment_FullMethodName                  = "/chroma.SysDB/CreateSegment"
 	SysDB_DeleteSegment_FullMethodName                  = "/chroma.SysDB/DeleteSegment"
 	SysDB_GetSegments_FullMethodName                    = "/chroma.SysDB/GetSegments"
@@ -31,11 +41,22 @@ const (
 	SysDB_CreateCollection_FullMethodName               = "/chroma.SysDB/CreateCollection"
 	SysDB_DeleteCollection_FullMethodName               = "/chroma.SysDB/DeleteCollection"
 	SysDB_GetCollections_FullMethodName                 = "/chroma.SysDB/GetCollections"
+	SysDB_CheckCollections_FullMethodName               = "/chroma.SysDB/CheckCollections"
+	SysDB_CountCollections_FullMethodName               = "/chroma.SysDB/CountCollections"
 	SysDB_UpdateCollection_FullMethodName               = "/chroma.SysDB/UpdateCollection"
+	SysDB_RestoreCollection_FullMethodName              = "/chroma.SysDB/RestoreCollection"
+	SysDB_ListDeletedCollections_FullMethodName         = "/chroma.SysDB/ListDeletedCollections"
+	SysDB_ForkCollection_FullMethodName                 = "/chroma.SysDB/ForkCollection"
+	SysDB_GetSharedFilePaths_FullMethodName             = "/chroma.SysDB/GetSharedFilePaths"
 	SysDB_ResetState_FullMethodName                     = "/chroma.SysDB/ResetState"
 	SysDB_GetLastCompactionTimeForTenant_FullMethodName = "/chroma.SysDB/GetLastCompactionTimeForTenant"
 	SysDB_SetLastCompactionTimeForTenant_FullMethodName = "/chroma.SysDB/SetLastCompactionTimeForTenant"
 	SysDB_FlushCollectionCompaction_FullMethodName      = "/chroma.SysDB/FlushCollectionCompaction"
+	SysDB_ListCollectionVersions_FullMethodName         = "/chroma.SysDB/ListCollectionVersions"
+	SysDB_GetSegmentsAtVersion_FullMethodName           = "/chroma.SysDB/GetSegmentsAtVersion"
+	SysDB_RollbackCollection_FullMethodName             = "/chroma.SysDB/RollbackCollection"
+	SysDB_WatchCollections_FullMethodName               = "/chroma.SysDB/WatchCollections"
+	SysDB_ListAuditEvents_FullMethodName                = "/chroma.SysDB/ListAuditEvents"
 )
 
 // SysDBClient is the client API for SysDB service.
@@ -44,8 +65,18 @@ const (
 type SysDBClient interface {
 	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*CreateDatabaseResponse, error)
 	GetDatabase(ctx context.Context, in *GetDatabaseRequest, opts ...grpc.CallOption) (*GetDatabaseResponse, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	UpdateDatabase(ctx context.Context, in *UpdateDatabaseRequest, opts ...grpc.CallOption) (*UpdateDatabaseResponse, error)
+	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error)
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*SuspendTenantResponse, error)
+	ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...grpc.CallOption) (*ResumeTenantResponse, error)
+	GetTenantQuota(ctx context.Context, in *GetTenantQuotaRequest, opts ...grpc.CallOption) (*GetTenantQuotaResponse, error)
+	SetTenantQuota(ctx context.Context, in *SetTenantQuotaRequest, opts ...grpc.CallOption) (*SetTenantQuotaResponse, error)
 	CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error)
 	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error)
 	GetSegments(ctx context.Context, in *GetSegmentsRequest, opts ...grpc.CallOption) (*GetSegmentsResponse, error)
@@ -53,11 +84,22 @@ type SysDBClient interface {
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
+	CheckCollections(ctx context.Context, in *CheckCollectionsRequest, opts ...grpc.CallOption) (*CheckCollectionsResponse, error)
+	CountCollections(ctx context.Context, in *CountCollectionsRequest, opts ...grpc.CallOption) (*CountCollectionsResponse, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error)
+	ListDeletedCollections(ctx context.Context, in *ListDeletedCollectionsRequest, opts ...grpc.CallOption) (*ListDeletedCollectionsResponse, error)
+	ForkCollection(ctx context.Context, in *ForkCollectionRequest, opts ...grpc.CallOption) (*ForkCollectionResponse, error)
+	GetSharedFilePaths(ctx context.Context, in *GetSharedFilePathsRequest, opts ...grpc.CallOption) (*GetSharedFilePathsResponse, error)
 	ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error)
 	GetLastCompactionTimeForTenant(ctx context.Context, in *GetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*GetLastCompactionTimeForTenantResponse, error)
 	SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	FlushCollectionCompaction(ctx context.Context, in *FlushCollectionCompactionRequest, opts ...grpc.CallOption) (*FlushCollectionCompactionResponse, error)
+	ListCollectionVersions(ctx context.Context, in *ListCollectionVersionsRequest, opts ...grpc.CallOption) (*ListCollectionVersionsResponse, error)
+	GetSegmentsAtVersion(ctx context.Context, in *GetSegmentsAtVersionRequest, opts ...grpc.CallOption) (*GetSegmentsAtVersionResponse, error)
+	RollbackCollection(ctx context.Context, in *RollbackCollectionRequest, opts ...grpc.CallOption) (*RollbackCollectionResponse, error)
+	WatchCollections(ctx context.Context, in *WatchCollectionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCollectionsResponse], error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type sysDBClient struct {
@@ -88,6 +130,36 @@ func (c *sysDBClient) GetDatabase(ctx context.Context, in *GetDatabaseRequest, o
 	return out, nil
 }
 
+func (c *sysDBClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListDatabases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) UpdateDatabase(ctx context.Context, in *UpdateDatabaseRequest, opts ...grpc.CallOption) (*UpdateDatabaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDatabaseResponse)
+	err := c.cc.Invoke(ctx, SysDB_UpdateDatabase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDatabaseResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteDatabase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTenantResponse)
@@ -108,6 +180,76 @@ func (c *sysDBClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts
 	return out, nil
 }
 
+func (c *sysDBClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*SuspendTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_SuspendTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...grpc.CallOption) (*ResumeTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_ResumeTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetTenantQuota(ctx context.Context, in *GetTenantQuotaRequest, opts ...grpc.CallOption) (*GetTenantQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetTenantQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetTenantQuota(ctx context.Context, in *SetTenantQuotaRequest, opts ...grpc.CallOption) (*SetTenantQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTenantQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetTenantQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSegmentResponse)
@@ -178,6 +320,26 @@ func (c *sysDBClient) GetCollections(ctx context.Context, in *GetCollectionsRequ
 	return out, nil
 }
 
+func (c *sysDBClient) CheckCollections(ctx context.Context, in *CheckCollectionsRequest, opts ...grpc.CallOption) (*CheckCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_CheckCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CountCollections(ctx context.Context, in *CountCollectionsRequest, opts ...grpc.CallOption) (*CountCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_CountCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCollectionResponse)
@@ -188,6 +350,46 @@ func (c *sysDBClient) UpdateCollection(ctx context.Context, in *UpdateCollection
 	return out, nil
 }
 
+func (c *sysDBClient) RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_RestoreCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListDeletedCollections(ctx context.Context, in *ListDeletedCollectionsRequest, opts ...grpc.CallOption) (*ListDeletedCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListDeletedCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ForkCollection(ctx context.Context, in *ForkCollectionRequest, opts ...grpc.CallOption) (*ForkCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForkCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_ForkCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetSharedFilePaths(ctx context.Context, in *GetSharedFilePathsRequest, opts ...grpc.CallOption) (*GetSharedFilePathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedFilePathsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetSharedFilePaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetStateResponse)
@@ -228,14 +430,83 @@ func (c *sysDBClient) FlushCollectionCompaction(ctx context.Context, in *FlushCo
 	return out, nil
 }
 
+func (c *sysDBClient) ListCollectionVersions(ctx context.Context, in *ListCollectionVersionsRequest, opts ...grpc.CallOption) (*ListCollectionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionVersionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListCollectionVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetSegmentsAtVersion(ctx context.Context, in *GetSegmentsAtVersionRequest, opts ...grpc.CallOption) (*GetSegmentsAtVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSegmentsAtVersionResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetSegmentsAtVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) RollbackCollection(ctx context.Context, in *RollbackCollectionRequest, opts ...grpc.CallOption) (*RollbackCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_RollbackCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) WatchCollections(ctx context.Context, in *WatchCollectionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCollectionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[0], SysDB_WatchCollections_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCollectionsRequest, WatchCollectionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysDB_WatchCollectionsClient = grpc.ServerStreamingClient[WatchCollectionsResponse]
+
+func (c *sysDBClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysDBServer is the server API for SysDB service.
 // All implementations must embed UnimplementedSysDBServer
 // for forward compatibility.
 type SysDBServer interface {
 	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
 	GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	UpdateDatabase(context.Context, *UpdateDatabaseRequest) (*UpdateDatabaseResponse, error)
+	DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error)
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	SuspendTenant(context.Context, *SuspendTenantRequest) (*SuspendTenantResponse, error)
+	ResumeTenant(context.Context, *ResumeTenantRequest) (*ResumeTenantResponse, error)
+	GetTenantQuota(context.Context, *GetTenantQuotaRequest) (*GetTenantQuotaResponse, error)
+	SetTenantQuota(context.Context, *SetTenantQuotaRequest) (*SetTenantQuotaResponse, error)
 	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
 	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
 	GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error)
@@ -243,11 +514,22 @@ type SysDBServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
+	CheckCollections(context.Context, *CheckCollectionsRequest) (*CheckCollectionsResponse, error)
+	CountCollections(context.Context, *CountCollectionsRequest) (*CountCollectionsResponse, error)
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error)
+	ListDeletedCollections(context.Context, *ListDeletedCollectionsRequest) (*ListDeletedCollectionsResponse, error)
+	ForkCollection(context.Context, *ForkCollectionRequest) (*ForkCollectionResponse, error)
+	GetSharedFilePaths(context.Context, *GetSharedFilePathsRequest) (*GetSharedFilePathsResponse, error)
 	ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error)
 	GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error)
 	SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error)
 	FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error)
+	ListCollectionVersions(context.Context, *ListCollectionVersionsRequest) (*ListCollectionVersionsResponse, error)
+	GetSegmentsAtVersion(context.Context, *GetSegmentsAtVersionRequest) (*GetSegmentsAtVersionResponse, error)
+	RollbackCollection(context.Context, *RollbackCollectionRequest) (*RollbackCollectionResponse, error)
+	WatchCollections(*WatchCollectionsRequest, grpc.ServerStreamingServer[WatchCollectionsResponse]) error
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedSysDBServer()
 }
 
@@ -264,12 +546,42 @@ func (UnimplementedSysDBServer) CreateDatabase(context.Context, *CreateDatabaseR
 func (UnimplementedSysDBServer) GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabase not implemented")
 }
+func (UnimplementedSysDBServer) ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (UnimplementedSysDBServer) UpdateDatabase(context.Context, *UpdateDatabaseRequest) (*UpdateDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDatabase not implemented")
+}
+func (UnimplementedSysDBServer) DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatabase not implemented")
+}
 func (UnimplementedSysDBServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
 func (UnimplementedSysDBServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
 }
+func (UnimplementedSysDBServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedSysDBServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedSysDBServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedSysDBServer) SuspendTenant(context.Context, *SuspendTenantRequest) (*SuspendTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendTenant not implemented")
+}
+func (UnimplementedSysDBServer) ResumeTenant(context.Context, *ResumeTenantRequest) (*ResumeTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTenant not implemented")
+}
+func (UnimplementedSysDBServer) GetTenantQuota(context.Context, *GetTenantQuotaRequest) (*GetTenantQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantQuota not implemented")
+}
+func (UnimplementedSysDBServer) SetTenantQuota(context.Context, *SetTenantQuotaRequest) (*SetTenantQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantQuota not implemented")
+}
 func (UnimplementedSysDBServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
 }
@@ -291,9 +603,27 @@ func (UnimplementedSysDBServer) DeleteCollection(context.Context, *DeleteCollect
 func (UnimplementedSysDBServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
 }
+func (UnimplementedSysDBServer) CheckCollections(context.Context, *CheckCollectionsRequest) (*CheckCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCollections not implemented")
+}
+func (UnimplementedSysDBServer) CountCollections(context.Context, *CountCollectionsRequest) (*CountCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountCollections not implemented")
+}
 func (UnimplementedSysDBServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
 }
+func (UnimplementedSysDBServer) RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
+func (UnimplementedSysDBServer) ListDeletedCollections(context.Context, *ListDeletedCollectionsRequest) (*ListDeletedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedCollections not implemented")
+}
+func (UnimplementedSysDBServer) ForkCollection(context.Context, *ForkCollectionRequest) (*ForkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkCollection not implemented")
+}
+func (UnimplementedSysDBServer) GetSharedFilePaths(context.Context, *GetSharedFilePathsRequest) (*GetSharedFilePathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedFilePaths not implemented")
+}
 func (UnimplementedSysDBServer) ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
 }
@@ -306,6 +636,21 @@ func (UnimplementedSysDBServer) SetLastCompactionTimeForTenant(context.Context,
 func (UnimplementedSysDBServer) FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlushCollectionCompaction not implemented")
 }
+func (UnimplementedSysDBServer) ListCollectionVersions(context.Context, *ListCollectionVersionsRequest) (*ListCollectionVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionVersions not implemented")
+}
+func (UnimplementedSysDBServer) GetSegmentsAtVersion(context.Context, *GetSegmentsAtVersionRequest) (*GetSegmentsAtVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsAtVersion not implemented")
+}
+func (UnimplementedSysDBServer) RollbackCollection(context.Context, *RollbackCollectionRequest) (*RollbackCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCollection not implemented")
+}
+func (UnimplementedSysDBServer) WatchCollections(*WatchCollectionsRequest, grpc.ServerStreamingServer[WatchCollectionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCollections not implemented")
+}
+func (UnimplementedSysDBServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedSysDBServer) mustEmbedUnimplementedSysDBServer() {}
 func (UnimplementedSysDBServer) testEmbeddedByValue()               {}
 
@@ -363,6 +708,60 @@ func _SysDB_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_UpdateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).UpdateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_UpdateDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).UpdateDatabase(ctx, req.(*UpdateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteDatabase(ctx, req.(*DeleteDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTenantRequest)
 	if err := dec(in); err != nil {
@@ -399,6 +798,132 @@ func _SysDB_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SuspendTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SuspendTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SuspendTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SuspendTenant(ctx, req.(*SuspendTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ResumeTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ResumeTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ResumeTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ResumeTenant(ctx, req.(*ResumeTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenantQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenantQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenantQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenantQuota(ctx, req.(*GetTenantQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetTenantQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTenantQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetTenantQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetTenantQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetTenantQuota(ctx, req.(*SetTenantQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSegmentRequest)
 	if err := dec(in); err != nil {
@@ -525,6 +1050,42 @@ func _SysDB_GetCollections_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_CheckCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CheckCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CheckCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CheckCollections(ctx, req.(*CheckCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CountCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CountCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CountCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CountCollections(ctx, req.(*CountCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCollectionRequest)
 	if err := dec(in); err != nil {
@@ -543,6 +1104,78 @@ func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RestoreCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RestoreCollection(ctx, req.(*RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListDeletedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListDeletedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListDeletedCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListDeletedCollections(ctx, req.(*ListDeletedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ForkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ForkCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ForkCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ForkCollection(ctx, req.(*ForkCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetSharedFilePaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedFilePathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetSharedFilePaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetSharedFilePaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetSharedFilePaths(ctx, req.(*GetSharedFilePathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_ResetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -615,6 +1248,89 @@ func _SysDB_FlushCollectionCompaction_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_ListCollectionVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListCollectionVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListCollectionVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListCollectionVersions(ctx, req.(*ListCollectionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetSegmentsAtVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsAtVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetSegmentsAtVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetSegmentsAtVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetSegmentsAtVersion(ctx, req.(*GetSegmentsAtVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RollbackCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RollbackCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RollbackCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RollbackCollection(ctx, req.(*RollbackCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_WatchCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).WatchCollections(m, &grpc.GenericServerStream[WatchCollectionsRequest, WatchCollectionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysDB_WatchCollectionsServer = grpc.ServerStreamingServer[WatchCollectionsResponse]
+
+func _SysDB_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysDB_ServiceDesc is the grpc.ServiceDesc for SysDB service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -630,6 +1346,18 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabase",
 			Handler:    _SysDB_GetDatabase_Handler,
 		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _SysDB_ListDatabases_Handler,
+		},
+		{
+			MethodName: "UpdateDatabase",
+			Handler:    _SysDB_UpdateDatabase_Handler,
+		},
+		{
+			MethodName: "DeleteDatabase",
+			Handler:    _SysDB_DeleteDatabase_Handler,
+		},
 		{
 			MethodName: "CreateTenant",
 			Handler:    _SysDB_CreateTenant_Handler,
@@ -638,6 +1366,34 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenant",
 			Handler:    _SysDB_GetTenant_Handler,
 		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _SysDB_ListTenants_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _SysDB_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _SysDB_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "SuspendTenant",
+			Handler:    _SysDB_SuspendTenant_Handler,
+		},
+		{
+			MethodName: "ResumeTenant",
+			Handler:    _SysDB_ResumeTenant_Handler,
+		},
+		{
+			MethodName: "GetTenantQuota",
+			Handler:    _SysDB_GetTenantQuota_Handler,
+		},
+		{
+			MethodName: "SetTenantQuota",
+			Handler:    _SysDB_SetTenantQuota_Handler,
+		},
 		{
 			MethodName: "CreateSegment",
 			Handler:    _SysDB_CreateSegment_Handler,
@@ -666,10 +1422,34 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCollections",
 			Handler:    _SysDB_GetCollections_Handler,
 		},
+		{
+			MethodName: "CheckCollections",
+			Handler:    _SysDB_CheckCollections_Handler,
+		},
+		{
+			MethodName: "CountCollections",
+			Handler:    _SysDB_CountCollections_Handler,
+		},
 		{
 			MethodName: "UpdateCollection",
 			Handler:    _SysDB_UpdateCollection_Handler,
 		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _SysDB_RestoreCollection_Handler,
+		},
+		{
+			MethodName: "ListDeletedCollections",
+			Handler:    _SysDB_ListDeletedCollections_Handler,
+		},
+		{
+			MethodName: "ForkCollection",
+			Handler:    _SysDB_ForkCollection_Handler,
+		},
+		{
+			MethodName: "GetSharedFilePaths",
+			Handler:    _SysDB_GetSharedFilePaths_Handler,
+		},
 		{
 			MethodName: "ResetState",
 			Handler:    _SysDB_ResetState_Handler,
@@ -686,7 +1466,29 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FlushCollectionCompaction",
 			Handler:    _SysDB_FlushCollectionCompaction_Handler,
 		},
+		{
+			MethodName: "ListCollectionVersions",
+			Handler:    _SysDB_ListCollectionVersions_Handler,
+		},
+		{
+			MethodName: "GetSegmentsAtVersion",
+			Handler:    _SysDB_GetSegmentsAtVersion_Handler,
+		},
+		{
+			MethodName: "RollbackCollection",
+			Handler:    _SysDB_RollbackCollection_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _SysDB_ListAuditEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCollections",
+			Handler:       _SysDB_WatchCollections_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/coordinator.proto",
 }
@@ -23,6 +23,9 @@ const (
 	LogService_PullLogs_FullMethodName                      = "/chroma.LogService/PullLogs"
 	LogService_GetAllCollectionInfoToCompact_FullMethodName = "/chroma.LogService/GetAllCollectionInfoToCompact"
 	LogService_UpdateCollectionLogOffset_FullMethodName     = "/chroma.LogService/UpdateCollectionLogOffset"
+	LogService_ForkLogs_FullMethodName                      = "/chroma.LogService/ForkLogs"
+	LogService_ResetCollectionLogOffset_FullMethodName      = "/chroma.LogService/ResetCollectionLogOffset"
+	LogService_DeleteLogs_FullMethodName                    = "/chroma.LogService/DeleteLogs"
 )
 
 // LogServiceClient is the client API for LogService service.
@@ -33,6 +36,9 @@ type LogServiceClient interface {
 	PullLogs(ctx context.Context, in *PullLogsRequest, opts ...grpc.CallOption) (*PullLogsResponse, error)
 	GetAllCollectionInfoToCompact(ctx context.Context, in *GetAllCollectionInfoToCompactRequest, opts ...grpc.CallOption) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(ctx context.Context, in *UpdateCollectionLogOffsetRequest, opts ...grpc.CallOption) (*UpdateCollectionLogOffsetResponse, error)
+	ForkLogs(ctx context.Context, in *ForkLogsRequest, opts ...grpc.CallOption) (*ForkLogsResponse, error)
+	ResetCollectionLogOffset(ctx context.Context, in *ResetCollectionLogOffsetRequest, opts ...grpc.CallOption) (*ResetCollectionLogOffsetResponse, error)
+	DeleteLogs(ctx context.Context, in *DeleteLogsRequest, opts ...grpc.CallOption) (*DeleteLogsResponse, error)
 }
 
 type logServiceClient struct {
@@ -83,6 +89,36 @@ func (c *logServiceClient) UpdateCollectionLogOffset(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *logServiceClient) ForkLogs(ctx context.Context, in *ForkLogsRequest, opts ...grpc.CallOption) (*ForkLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForkLogsResponse)
+	err := c.cc.Invoke(ctx, LogService_ForkLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) ResetCollectionLogOffset(ctx context.Context, in *ResetCollectionLogOffsetRequest, opts ...grpc.CallOption) (*ResetCollectionLogOffsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetCollectionLogOffsetResponse)
+	err := c.cc.Invoke(ctx, LogService_ResetCollectionLogOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) DeleteLogs(ctx context.Context, in *DeleteLogsRequest, opts ...grpc.CallOption) (*DeleteLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLogsResponse)
+	err := c.cc.Invoke(ctx, LogService_DeleteLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility.
@@ -91,6 +127,9 @@ type LogServiceServer interface {
 	PullLogs(context.Context, *PullLogsRequest) (*PullLogsResponse, error)
 	GetAllCollectionInfoToCompact(context.Context, *GetAllCollectionInfoToCompactRequest) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error)
+	ForkLogs(context.Context, *ForkLogsRequest) (*ForkLogsResponse, error)
+	ResetCollectionLogOffset(context.Context, *ResetCollectionLogOffsetRequest) (*ResetCollectionLogOffsetResponse, error)
+	DeleteLogs(context.Context, *DeleteLogsRequest) (*DeleteLogsResponse, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -113,6 +152,15 @@ func (UnimplementedLogServiceServer) GetAllCollectionInfoToCompact(context.Conte
 func (UnimplementedLogServiceServer) UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionLogOffset not implemented")
 }
+func (UnimplementedLogServiceServer) ForkLogs(context.Context, *ForkLogsRequest) (*ForkLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkLogs not implemented")
+}
+func (UnimplementedLogServiceServer) ResetCollectionLogOffset(context.Context, *ResetCollectionLogOffsetRequest) (*ResetCollectionLogOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCollectionLogOffset not implemented")
+}
+func (UnimplementedLogServiceServer) DeleteLogs(context.Context, *DeleteLogsRequest) (*DeleteLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogs not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 func (UnimplementedLogServiceServer) testEmbeddedByValue()                    {}
 
@@ -206,6 +254,60 @@ func _LogService_UpdateCollectionLogOffset_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_ForkLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).ForkLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_ForkLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).ForkLogs(ctx, req.(*ForkLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_ResetCollectionLogOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCollectionLogOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).ResetCollectionLogOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_ResetCollectionLogOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).ResetCollectionLogOffset(ctx, req.(*ResetCollectionLogOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_DeleteLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).DeleteLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_DeleteLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).DeleteLogs(ctx, req.(*DeleteLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +331,18 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollectionLogOffset",
 			Handler:    _LogService_UpdateCollectionLogOffset_Handler,
 		},
+		{
+			MethodName: "ForkLogs",
+			Handler:    _LogService_ForkLogs_Handler,
+		},
+		{
+			MethodName: "ResetCollectionLogOffset",
+			Handler:    _LogService_ResetCollectionLogOffset_Handler,
+		},
+		{
+			MethodName: "DeleteLogs",
+			Handler:    _LogService_DeleteLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/logservice.proto",
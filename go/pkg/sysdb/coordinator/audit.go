@@ -0,0 +1,142 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// AuditActorHeader is the gRPC metadata key a caller that is not
+	// authenticated by a client certificate names itself with.
+	AuditActorHeader  = "x-chroma-actor"
+	unknownAuditActor = "unknown"
+)
+
+// tenantSuspension is the audited state of SetTenantSuspended, which
+// model.Tenant does not carry.
+type tenantSuspension struct {
+	IsSuspended bool
+}
+
+// databaseAuditEvent starts the audit event of a change to a database.
+func databaseAuditEvent(operation string, database *dbmodel.Database) *dbmodel.AuditEvent {
+	return &dbmodel.AuditEvent{
+		Operation:  operation,
+		TenantID:   database.TenantID,
+		DatabaseID: database.ID,
+	}
+}
+
+// collectionAuditEvent starts the audit event of a change to a collection.
+func collectionAuditEvent(operation string, collection *dbmodel.CollectionAndMetadata) *dbmodel.AuditEvent {
+	return &dbmodel.AuditEvent{
+		Operation:    operation,
+		TenantID:     collection.TenantID,
+		DatabaseID:   collection.Collection.DatabaseID,
+		CollectionID: collection.Collection.ID,
+	}
+}
+
+// collectionAuditEventByID starts the audit event of a change to a collection,
+// or to one of its segments, looking the collection up. A soft deleted
+// collection is only identified by its id.
+func (tc *Catalog) collectionAuditEventByID(txCtx context.Context, operation string, collectionID string) (*dbmodel.AuditEvent, error) {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&collectionID, nil, "", "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return &dbmodel.AuditEvent{Operation: operation, CollectionID: collectionID}, nil
+	}
+	return collectionAuditEvent(operation, collections[0]), nil
+}
+
+// auditActor returns who is changing the catalog: the principal of the
+// verified client certificate when mTLS is enabled, otherwise the actor named
+// in the gRPC metadata.
+func auditActor(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			chains := tlsInfo.State.VerifiedChains
+			if len(chains) > 0 && len(chains[0]) > 0 && chains[0][0].Subject.CommonName != "" {
+				return chains[0][0].Subject.CommonName
+			}
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(AuditActorHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return unknownAuditActor
+}
+
+// auditJSON encodes the state of an object for the audit log, nil stands for
+// an object that does not exist.
+func auditJSON(v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if string(b) == "null" {
+		return nil, nil
+	}
+	s := string(b)
+	return &s, nil
+}
+
+// recordAudit writes an audit event for a change to the catalog. It must run
+// inside the transaction of the change, so that the event is committed if and
+// only if the change is.
+func (tc *Catalog) recordAudit(txCtx context.Context, auditEvent *dbmodel.AuditEvent, before interface{}, after interface{}) error {
+	var err error
+	auditEvent.Actor = auditActor(txCtx)
+	if auditEvent.Before, err = auditJSON(before); err != nil {
+		return err
+	}
+	if auditEvent.After, err = auditJSON(after); err != nil {
+		return err
+	}
+	err = tc.metaDomain.AuditEventDb(txCtx).Insert(auditEvent)
+	if err != nil {
+		log.Error("error recording audit event", zap.String("operation", auditEvent.Operation), zap.Error(err))
+	}
+	return err
+}
+
+// ListAuditEvents returns the audit events matching listAuditEvents, in the
+// order they were recorded.
+func (tc *Catalog) ListAuditEvents(ctx context.Context, listAuditEvents *model.ListAuditEvents) ([]*model.AuditEvent, error) {
+	query := &dbmodel.ListAuditEventsQuery{
+		TenantID: listAuditEvents.TenantID,
+		AfterID:  listAuditEvents.AfterID,
+		Limit:    listAuditEvents.Limit,
+	}
+	if listAuditEvents.Start > 0 {
+		query.Start = time.Unix(listAuditEvents.Start, 0)
+	}
+	if listAuditEvents.End > 0 {
+		query.End = time.Unix(listAuditEvents.End, 0)
+	}
+	auditEvents, err := tc.metaDomain.AuditEventDb(ctx).ListAuditEvents(query)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.AuditEvent, 0, len(auditEvents))
+	for _, auditEvent := range auditEvents {
+		result = append(result, convertAuditEventToModel(auditEvent))
+	}
+	return result, nil
+}
@@ -0,0 +1,272 @@
+package coordinator
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// CatalogCacheConfig configures the read-through cache of the catalog for
+// lookups of collections and segments by collection id. The cache is disabled
+// when Size is zero.
+//
+// The cache is local to a coordinator process: its own changes invalidate an
+// entry right away, but the changes made through another coordinator are only
+// seen once the entry expires. Keep TTL to a few seconds when several
+// coordinators serve the catalog.
+type CatalogCacheConfig struct {
+	// Size is the maximum number of cached collections and segment lists.
+	Size int
+	// TTL bounds how stale an entry can be when another coordinator changed
+	// the collection, entries do not expire when it is zero.
+	TTL time.Duration
+}
+
+const (
+	catalogCacheCollection = "collection"
+	catalogCacheSegments   = "segments"
+)
+
+var catalogCacheMeter = otel.Meter("github.com/chroma-core/chroma/go/pkg/sysdb/coordinator")
+
+type catalogCacheKey struct {
+	kind         string
+	collectionID string
+}
+
+type catalogCacheEntry struct {
+	key       catalogCacheKey
+	value     interface{}
+	expiresAt time.Time
+}
+
+// catalogCacheStats counts the lookups of the cache.
+type catalogCacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// catalogCache is a size bounded LRU cache with a TTL. Every invalidation bumps
+// the revision of the cache, and an entry is only filled when the revision did
+// not change since the read of its value started, so that a read racing with a
+// change can not cache the state from before the change.
+//
+// All methods are no-ops on a nil cache, which is how a disabled cache is
+// represented.
+type catalogCache struct {
+	mu       sync.Mutex
+	size     int
+	ttl      time.Duration
+	now      func() time.Time
+	revision uint64
+	entries  map[catalogCacheKey]*list.Element
+	lru      *list.List
+	stats    catalogCacheStats
+	hits     metric.Int64Counter
+	misses   metric.Int64Counter
+}
+
+func newCatalogCache(config CatalogCacheConfig) *catalogCache {
+	if config.Size <= 0 {
+		return nil
+	}
+	c := &catalogCache{
+		size:    config.Size,
+		ttl:     config.TTL,
+		now:     time.Now,
+		entries: make(map[catalogCacheKey]*list.Element),
+		lru:     list.New(),
+	}
+	var err error
+	c.hits, err = catalogCacheMeter.Int64Counter("catalog_cache_hits", metric.WithDescription("Number of catalog lookups served from the cache"), metric.WithUnit("{lookups}"))
+	if err != nil {
+		log.Error("failed to create metric", zap.Error(err))
+	}
+	c.misses, err = catalogCacheMeter.Int64Counter("catalog_cache_misses", metric.WithDescription("Number of catalog lookups that went to the database"), metric.WithUnit("{lookups}"))
+	if err != nil {
+		log.Error("failed to create metric", zap.Error(err))
+	}
+	return c
+}
+
+// SetCache enables the cache, or disables it when the size of config is zero.
+func (tc *Catalog) SetCache(config CatalogCacheConfig) {
+	tc.cache = newCatalogCache(config)
+}
+
+// currentRevision is taken before reading a value to fill the cache with.
+func (c *catalogCache) currentRevision() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.revision
+}
+
+func (c *catalogCache) get(ctx context.Context, kind string, collectionID string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := catalogCacheKey{kind: kind, collectionID: collectionID}
+	element, ok := c.entries[key]
+	if ok && c.ttl > 0 && c.now().After(element.Value.(*catalogCacheEntry).expiresAt) {
+		c.remove(element)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		if c.misses != nil {
+			c.misses.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", kind)))
+		}
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	c.stats.Hits++
+	if c.hits != nil {
+		c.hits.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", kind)))
+	}
+	return element.Value.(*catalogCacheEntry).value, true
+}
+
+// put caches value unless the cache was invalidated since revision.
+func (c *catalogCache) put(kind string, collectionID string, value interface{}, revision uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if revision != c.revision {
+		return
+	}
+	key := catalogCacheKey{kind: kind, collectionID: collectionID}
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.lru.PushFront(&catalogCacheEntry{
+		key:       key,
+		value:     value,
+		expiresAt: c.now().Add(c.ttl),
+	})
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *catalogCache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*catalogCacheEntry).key)
+}
+
+// invalidateCollection drops the cached collection and segments of a
+// collection.
+func (c *catalogCache) invalidateCollection(collectionID string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.revision++
+	for _, kind := range []string{catalogCacheCollection, catalogCacheSegments} {
+		if element, ok := c.entries[catalogCacheKey{kind: kind, collectionID: collectionID}]; ok {
+			c.remove(element)
+		}
+	}
+}
+
+// invalidateAll drops every entry, for changes that affect many collections
+// like deleting a database.
+func (c *catalogCache) invalidateAll() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.revision++
+	c.entries = make(map[catalogCacheKey]*list.Element)
+	c.lru.Init()
+}
+
+func (c *catalogCache) getStats() catalogCacheStats {
+	if c == nil {
+		return catalogCacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// primaryReadContext makes the reads that fill the cache go to the primary, as
+// a lagging read replica could otherwise fill it with the state from before a
+// change that already invalidated the entry.
+func primaryReadContext(ctx context.Context) context.Context {
+	return dbcore.CtxWithReplicaRead(ctx, false)
+}
+
+// getCollectionCached looks a collection up by id. The tenant and database,
+// when given, must match as they do for a database lookup.
+func (tc *Catalog) getCollectionCached(ctx context.Context, collectionID string, tenantID string, databaseName string) ([]*model.Collection, error) {
+	value, ok := tc.cache.get(ctx, catalogCacheCollection, collectionID)
+	if !ok {
+		revision := tc.cache.currentRevision()
+		collectionAndMetadataList, err := tc.metaDomain.CollectionDb(primaryReadContext(ctx)).GetCollections(&collectionID, nil, "", "", nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		collections := convertCollectionToModel(collectionAndMetadataList)
+		if len(collections) == 0 {
+			return collections, nil
+		}
+		value = collections[0]
+		tc.cache.put(catalogCacheCollection, collectionID, value, revision)
+	}
+	collection := *value.(*model.Collection)
+	if (tenantID != "" && collection.TenantID != tenantID) || (databaseName != "" && collection.DatabaseName != databaseName) {
+		return []*model.Collection{}, nil
+	}
+	return []*model.Collection{&collection}, nil
+}
+
+// isCachedCollectionList returns whether listCollections looks a collection up
+// by id, filtering only on its tenant and database, which the cache can serve.
+func isCachedCollectionList(listCollections *model.ListCollections) bool {
+	return listCollections.ID != types.NilUniqueID() && listCollections.Name == nil && listCollections.Where == nil && listCollections.After == nil &&
+		(listCollections.Limit == nil || *listCollections.Limit > 0) &&
+		(listCollections.Offset == nil || *listCollections.Offset == 0)
+}
+
+// getSegmentsCached looks the segments of a collection up, filtering them like
+// a database lookup does.
+func (tc *Catalog) getSegmentsCached(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
+	value, ok := tc.cache.get(ctx, catalogCacheSegments, collectionID.String())
+	if !ok {
+		revision := tc.cache.currentRevision()
+		segments, err := tc.getSegments(primaryReadContext(ctx), types.NilUniqueID(), nil, nil, collectionID)
+		if err != nil {
+			return nil, err
+		}
+		value = segments
+		tc.cache.put(catalogCacheSegments, collectionID.String(), value, revision)
+	}
+	segments := make([]*model.Segment, 0)
+	for _, cached := range value.([]*model.Segment) {
+		if (segmentID != types.NilUniqueID() && cached.ID != segmentID) || (segmentType != nil && cached.Type != *segmentType) || (scope != nil && cached.Scope != *scope) {
+			continue
+		}
+		segment := *cached
+		segments = append(segments, &segment)
+	}
+	return segments, nil
+}
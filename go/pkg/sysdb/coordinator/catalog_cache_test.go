@@ -0,0 +1,65 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogCache_SizeAndTTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(0, 0)
+	cache := newCatalogCache(CatalogCacheConfig{Size: 2, TTL: time.Minute})
+	cache.now = func() time.Time { return now }
+
+	cache.put(catalogCacheCollection, "a", 1, cache.currentRevision())
+	cache.put(catalogCacheCollection, "b", 2, cache.currentRevision())
+	_, ok := cache.get(ctx, catalogCacheCollection, "a")
+	assert.True(t, ok)
+	// "b" is the least recently used entry and makes room for "c".
+	cache.put(catalogCacheCollection, "c", 3, cache.currentRevision())
+	_, ok = cache.get(ctx, catalogCacheCollection, "b")
+	assert.False(t, ok)
+	value, ok := cache.get(ctx, catalogCacheCollection, "c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.get(ctx, catalogCacheCollection, "a")
+	assert.False(t, ok)
+	assert.Equal(t, catalogCacheStats{Hits: 2, Misses: 2}, cache.getStats())
+}
+
+func TestCatalogCache_Invalidation(t *testing.T) {
+	ctx := context.Background()
+	cache := newCatalogCache(CatalogCacheConfig{Size: 10})
+
+	cache.put(catalogCacheCollection, "a", 1, cache.currentRevision())
+	cache.put(catalogCacheSegments, "a", 2, cache.currentRevision())
+	cache.put(catalogCacheCollection, "b", 3, cache.currentRevision())
+	cache.invalidateCollection("a")
+	_, ok := cache.get(ctx, catalogCacheCollection, "a")
+	assert.False(t, ok)
+	_, ok = cache.get(ctx, catalogCacheSegments, "a")
+	assert.False(t, ok)
+	_, ok = cache.get(ctx, catalogCacheCollection, "b")
+	assert.True(t, ok)
+
+	// A value read before an invalidation is not cached.
+	revision := cache.currentRevision()
+	cache.invalidateAll()
+	cache.put(catalogCacheCollection, "a", 1, revision)
+	_, ok = cache.get(ctx, catalogCacheCollection, "a")
+	assert.False(t, ok)
+	_, ok = cache.get(ctx, catalogCacheCollection, "b")
+	assert.False(t, ok)
+
+	// A disabled cache is nil and never hits.
+	disabled := newCatalogCache(CatalogCacheConfig{})
+	assert.Nil(t, disabled)
+	disabled.put(catalogCacheCollection, "a", 1, disabled.currentRevision())
+	_, ok = disabled.get(ctx, catalogCacheCollection, "a")
+	assert.False(t, ok)
+}
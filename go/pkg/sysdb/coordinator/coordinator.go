@@ -2,9 +2,11 @@ package coordinator
 
 import (
 	"context"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/logservice"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
@@ -66,6 +68,29 @@ func (s *Coordinator) GetDatabase(ctx context.Context, getDatabase *model.GetDat
 	return database, nil
 }
 
+func (s *Coordinator) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	return s.catalog.ListDatabases(ctx, listDatabases)
+}
+
+func (s *Coordinator) UpdateDatabase(ctx context.Context, updateDatabase *model.UpdateDatabase) (*model.Database, error) {
+	return s.catalog.UpdateDatabase(ctx, updateDatabase)
+}
+
+func (s *Coordinator) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	if s.deleteMode == SoftDelete {
+		return s.catalog.DeleteDatabase(ctx, deleteDatabase, true)
+	}
+	return s.catalog.DeleteDatabase(ctx, deleteDatabase, false)
+}
+
+func (s *Coordinator) GetSoftDeletedDatabases(ctx context.Context, limit int32) ([]*model.Database, error) {
+	return s.catalog.GetSoftDeletedDatabases(ctx, limit)
+}
+
+func (s *Coordinator) CleanupSoftDeletedDatabase(ctx context.Context, databaseID string) error {
+	return s.catalog.CleanupSoftDeletedDatabase(ctx, databaseID)
+}
+
 func (s *Coordinator) CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error) {
 	tenant, err := s.catalog.CreateTenant(ctx, createTenant, createTenant.Ts)
 	if err != nil {
@@ -82,7 +107,41 @@ func (s *Coordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant)
 	return tenant, nil
 }
 
+func (s *Coordinator) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	return s.catalog.ListTenants(ctx, listTenants)
+}
+
+func (s *Coordinator) UpdateTenant(ctx context.Context, updateTenant *model.UpdateTenant) (*model.Tenant, error) {
+	return s.catalog.UpdateTenant(ctx, updateTenant)
+}
+
+func (s *Coordinator) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	return s.catalog.DeleteTenant(ctx, deleteTenant)
+}
+
+func (s *Coordinator) GetSoftDeletedTenants(ctx context.Context, limit int32) ([]*model.Tenant, error) {
+	return s.catalog.GetSoftDeletedTenants(ctx, limit)
+}
+
+func (s *Coordinator) CleanupSoftDeletedTenant(ctx context.Context, tenantID string) error {
+	return s.catalog.CleanupSoftDeletedTenant(ctx, tenantID)
+}
+
+func (s *Coordinator) SuspendTenant(ctx context.Context, tenantID string) error {
+	return s.catalog.SetTenantSuspended(ctx, tenantID, true)
+}
+
+func (s *Coordinator) ResumeTenant(ctx context.Context, tenantID string) error {
+	return s.catalog.SetTenantSuspended(ctx, tenantID, false)
+}
+
 func (s *Coordinator) CreateCollectionAndSegments(ctx context.Context, createCollection *model.CreateCollection, createSegments []*model.CreateSegment) (*model.Collection, bool, error) {
+	if err := verifyCollectionConfiguration(createCollection.ConfigurationJsonStr); err != nil {
+		return nil, false, err
+	}
+	if err := verifyCollectionSegments(createSegments); err != nil {
+		return nil, false, err
+	}
 	collection, created, err := s.catalog.CreateCollectionAndSegments(ctx, createCollection, createSegments, createCollection.Ts)
 	if err != nil {
 		return nil, false, err
@@ -90,8 +149,100 @@ func (s *Coordinator) CreateCollectionAndSegments(ctx context.Context, createCol
 	return collection, created, nil
 }
 
+func (s *Coordinator) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection) (*model.Collection, error) {
+	return s.catalog.ForkCollection(ctx, forkCollection)
+}
+
+func (s *Coordinator) CountCollections(ctx context.Context, tenantID string, databaseName *string) (uint64, error) {
+	return s.catalog.CountCollections(ctx, tenantID, databaseName)
+}
+
+func (s *Coordinator) CheckCollections(ctx context.Context, collectionIDs []string) ([]string, error) {
+	return s.catalog.CheckCollections(ctx, collectionIDs)
+}
+
+func (s *Coordinator) ListNotifications(ctx context.Context, listNotifications *model.ListNotifications) ([]*model.Notification, error) {
+	return s.catalog.ListNotifications(ctx, listNotifications)
+}
+
+func (s *Coordinator) ListNotificationRevisions(ctx context.Context, afterRevision int64, limit int32) ([]int64, error) {
+	return s.catalog.ListNotificationRevisions(ctx, afterRevision, limit)
+}
+
+func (s *Coordinator) GetNotificationRevisionRange(ctx context.Context) (int64, int64, error) {
+	return s.catalog.GetNotificationRevisionRange(ctx)
+}
+
+func (s *Coordinator) PurgeNotifications(ctx context.Context, notBefore time.Time) (int, error) {
+	return s.catalog.PurgeNotifications(ctx, notBefore)
+}
+
+// SetDefaultQuota sets the quota of the tenants that have none of their own.
+func (s *Coordinator) SetDefaultQuota(quota model.Quota) {
+	s.catalog.SetDefaultQuota(quota)
+}
+
+// SetCatalogCache enables the catalog cache, or disables it when the size of
+// config is zero. It must be called before the coordinator serves requests.
+func (s *Coordinator) SetCatalogCache(config CatalogCacheConfig) {
+	s.catalog.SetCache(config)
+}
+
+// SetLogService sets the log service the records of forked collections are
+// copied through. Forking fails until it is set.
+func (s *Coordinator) SetLogService(logService logservice.ILogService) {
+	s.catalog.SetLogService(logService)
+}
+
+func (s *Coordinator) GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, model.Quota, error) {
+	return s.catalog.GetTenantQuota(ctx, tenantID)
+}
+
+func (s *Coordinator) SetTenantQuota(ctx context.Context, tenantQuota *model.TenantQuota) (model.Quota, error) {
+	return s.catalog.SetTenantQuota(ctx, tenantQuota)
+}
+
+func (s *Coordinator) ListAuditEvents(ctx context.Context, listAuditEvents *model.ListAuditEvents) ([]*model.AuditEvent, error) {
+	return s.catalog.ListAuditEvents(ctx, listAuditEvents)
+}
+
+func (s *Coordinator) ReserveIdempotencyKey(ctx context.Context, record *model.IdempotencyRecord, reservedBefore time.Time) (*model.IdempotencyRecord, error) {
+	return s.catalog.ReserveIdempotencyKey(ctx, record, reservedBefore)
+}
+
+func (s *Coordinator) CompleteIdempotencyKey(ctx context.Context, method string, key string, response []byte) error {
+	return s.catalog.CompleteIdempotencyKey(ctx, method, key, response)
+}
+
+func (s *Coordinator) ReleaseIdempotencyKey(ctx context.Context, method string, key string) error {
+	return s.catalog.ReleaseIdempotencyKey(ctx, method, key)
+}
+
+func (s *Coordinator) PurgeIdempotencyKeys(ctx context.Context, notBefore time.Time) (int, error) {
+	return s.catalog.PurgeIdempotencyKeys(ctx, notBefore)
+}
+
+func (s *Coordinator) GetSharedFilePaths(ctx context.Context, collectionID types.UniqueID, filePaths []string) ([]string, error) {
+	return s.catalog.GetSharedFilePaths(ctx, collectionID, filePaths)
+}
+
+func (s *Coordinator) ListCollectionVersions(ctx context.Context, collectionID types.UniqueID, limit int32) ([]*model.CollectionVersion, error) {
+	return s.catalog.ListCollectionVersions(ctx, collectionID, limit)
+}
+
+func (s *Coordinator) GetSegmentsAtVersion(ctx context.Context, collectionID types.UniqueID, version int32) ([]*model.Segment, error) {
+	return s.catalog.GetSegmentsAtVersion(ctx, collectionID, version)
+}
+
+func (s *Coordinator) RollbackCollection(ctx context.Context, collectionID types.UniqueID, version int32) (*model.CollectionVersion, error) {
+	return s.catalog.RollbackCollection(ctx, collectionID, version)
+}
+
 func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, bool, error) {
 	log.Info("create collection", zap.Any("createCollection", createCollection))
+	if err := verifyCollectionConfiguration(createCollection.ConfigurationJsonStr); err != nil {
+		return nil, false, err
+	}
 	collection, created, err := s.catalog.CreateCollection(ctx, createCollection, createCollection.Ts)
 	if err != nil {
 		return nil, false, err
@@ -103,6 +254,14 @@ func (s *Coordinator) GetCollections(ctx context.Context, collectionID types.Uni
 	return s.catalog.GetCollections(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
 }
 
+func (s *Coordinator) ListCollections(ctx context.Context, listCollections *model.ListCollections) ([]*model.Collection, *model.CollectionCursor, error) {
+	return s.catalog.ListCollections(ctx, listCollections)
+}
+
+func (s *Coordinator) ListSoftDeletedCollections(ctx context.Context, listCollections *model.ListCollections) ([]*model.Collection, *model.CollectionCursor, error) {
+	return s.catalog.ListSoftDeletedCollections(ctx, listCollections)
+}
+
 func (s *Coordinator) GetSoftDeletedCollections(ctx context.Context, collectionID *string, tenantID string, databaseName string, limit int32) ([]*model.Collection, error) {
 	return s.catalog.GetSoftDeletedCollections(ctx, collectionID, tenantID, databaseName, limit)
 }
@@ -118,7 +277,18 @@ func (s *Coordinator) CleanupSoftDeletedCollection(ctx context.Context, deleteCo
 	return s.catalog.DeleteCollection(ctx, deleteCollection, false)
 }
 
+func (s *Coordinator) RestoreCollection(ctx context.Context, restoreCollection *model.RestoreCollection) (*model.Collection, error) {
+	return s.catalog.RestoreCollection(ctx, restoreCollection)
+}
+
 func (s *Coordinator) UpdateCollection(ctx context.Context, collection *model.UpdateCollection) (*model.Collection, error) {
+	if collection.ConfigurationJsonStr != nil {
+		// The update is validated against the current configuration by the
+		// catalog, only its syntax can be checked here.
+		if _, err := model.ParseCollectionConfiguration(*collection.ConfigurationJsonStr); err != nil {
+			return nil, err
+		}
+	}
 	return s.catalog.UpdateCollection(ctx, collection, collection.Ts)
 }
 
@@ -169,6 +339,30 @@ func verifyCollectionMetadata(metadata *model.CollectionMetadata[model.Collectio
 	return nil
 }
 
+func verifyCollectionConfiguration(configurationJsonStr string) error {
+	configuration, err := model.ParseCollectionConfiguration(configurationJsonStr)
+	if err != nil {
+		return err
+	}
+	return configuration.Validate()
+}
+
+// requiredSegmentScopes are the scopes a collection has exactly one segment of.
+var requiredSegmentScopes = []string{"VECTOR", "METADATA", "RECORD"}
+
+func verifyCollectionSegments(segments []*model.CreateSegment) error {
+	scopeCounts := make(map[string]int, len(requiredSegmentScopes))
+	for _, segment := range segments {
+		scopeCounts[segment.Scope]++
+	}
+	for _, scope := range requiredSegmentScopes {
+		if scopeCounts[scope] != 1 {
+			return common.ErrCollectionSegmentScopes
+		}
+	}
+	return nil
+}
+
 func verifyCreateSegment(segment *model.CreateSegment) error {
 	if err := verifySegmentMetadata(segment.Metadata); err != nil {
 		return err
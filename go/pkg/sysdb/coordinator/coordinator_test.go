@@ -2,28 +2,39 @@ package coordinator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dao"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
-	"gorm.io/gorm"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/logservice"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/memdb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
 	"pgregory.net/rapid"
 )
 
 type APIsTestSuite struct {
 	suite.Suite
-	db                *gorm.DB
+	// memdb runs the suite against the in-memory catalog instead of the SQL
+	// one.
+	memdb bool
+	// db is the catalog the fixtures are written to.
+	db                dbmodel.IMetaDomain
+	logService        *logservice.MockLogService
 	collectionId1     types.UniqueID
 	collectionId2     types.UniqueID
 	records           [][]byte
@@ -36,13 +47,30 @@ type APIsTestSuite struct {
 
 func (suite *APIsTestSuite) SetupSuite() {
 	log.Info("setup suite")
-	suite.db = dbcore.ConfigDatabaseForTesting()
+	if !suite.memdb {
+		dbcore.ConfigDatabaseForTesting()
+	}
 }
 
 func (suite *APIsTestSuite) SetupTest() {
 	log.Info("setup test")
 	suite.tenantName = "tenant_" + suite.T().Name()
 	suite.databaseName = "database_" + suite.T().Name()
+	ctx := context.Background()
+	c, err := NewCoordinator(ctx, nil, SoftDelete)
+	if err != nil {
+		suite.T().Fatalf("error creating coordinator: %v", err)
+	}
+	suite.db = dao.NewMetaDomain()
+	if suite.memdb {
+		metaDomain := memdb.NewMetaDomain()
+		c.catalog = *NewTableCatalog(metaDomain, metaDomain)
+		suite.NoError(c.ResetState(ctx))
+		suite.db = metaDomain
+	}
+	suite.logService = logservice.NewMockLogService()
+	c.SetLogService(suite.logService)
+	suite.coordinator = c
 	DbId, err := dao.CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
 	suite.NoError(err)
 	suite.databaseId = DbId
@@ -51,12 +79,6 @@ func (suite *APIsTestSuite) SetupTest() {
 		collection.ID = types.NewUniqueID()
 		collection.Name = "collection_" + suite.T().Name() + strconv.Itoa(index)
 	}
-	ctx := context.Background()
-	c, err := NewCoordinator(ctx, suite.db, SoftDelete)
-	if err != nil {
-		suite.T().Fatalf("error creating coordinator: %v", err)
-	}
-	suite.coordinator = c
 	for _, collection := range suite.sampleCollections {
 		_, _, errCollectionCreation := c.CreateCollection(ctx, &model.CreateCollection{
 			ID:           collection.ID,
@@ -277,11 +299,24 @@ func (suite *APIsTestSuite) TestCreateCollectionAndSegments() {
 		{
 			ID:           types.NewUniqueID(),
 			Type:         "test_type",
-			Scope:        "VECTOR",
+			Scope:        "METADATA",
+			CollectionID: newCollection.ID,
+		},
+		{
+			ID:           types.NewUniqueID(),
+			Type:         "test_type",
+			Scope:        "RECORD",
 			CollectionID: newCollection.ID,
 		},
 	}
 
+	// A collection needs exactly one segment per scope.
+	_, _, err := suite.coordinator.CreateCollectionAndSegments(ctx, newCollection, segments[:2])
+	suite.ErrorIs(err, common.ErrCollectionSegmentScopes)
+	duplicateScope := &model.CreateSegment{ID: types.NewUniqueID(), Type: "test_type", Scope: "VECTOR", CollectionID: newCollection.ID}
+	_, _, err = suite.coordinator.CreateCollectionAndSegments(ctx, newCollection, append([]*model.CreateSegment{duplicateScope}, segments...))
+	suite.ErrorIs(err, common.ErrCollectionSegmentScopes)
+
 	// Create collection and segments
 	createdCollection, created, err := suite.coordinator.CreateCollectionAndSegments(ctx, newCollection, segments)
 	suite.NoError(err)
@@ -290,6 +325,10 @@ func (suite *APIsTestSuite) TestCreateCollectionAndSegments() {
 	suite.Equal(newCollection.Name, createdCollection.Name)
 	// suite.Equal(len(segments), len(createdSegments))
 
+	// Adding a second segment of a scope afterwards is refused as well.
+	err = suite.coordinator.CreateSegment(ctx, duplicateScope)
+	suite.ErrorIs(err, common.ErrCollectionSegmentScopes)
+
 	// Verify the collection was created
 	result, err := suite.coordinator.GetCollections(ctx, newCollection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
 	suite.NoError(err)
@@ -488,8 +527,9 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 		DatabaseName: suite.sampleCollections[0].DatabaseName,
 	}
 
-	// Update name
+	// Update name. Every update bumps the row version.
 	coll.Name = "new_name"
+	coll.RowVersion = 1
 	result, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name})
 	suite.NoError(err)
 	suite.Equal(coll, result)
@@ -497,9 +537,13 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	suite.NoError(err)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
-	// Update dimension
+	// Update dimension. Once set it can only be repeated.
+	otherDimension := int32(256)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: &otherDimension})
+	suite.ErrorIs(err, common.ErrCollectionDimensionImmutable)
 	newDimension := int32(128)
 	coll.Dimension = &newDimension
+	coll.RowVersion = 2
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: coll.Dimension})
 	suite.NoError(err)
 	suite.Equal(coll, result)
@@ -511,6 +555,7 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	newMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
 	newMetadata.Add("test_str2", &model.CollectionMetadataValueStringType{Value: "str2"})
 	coll.Metadata = newMetadata
+	coll.RowVersion = 3
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata})
 	suite.NoError(err)
 	suite.Equal(coll, result)
@@ -518,8 +563,28 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	suite.NoError(err)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
+	// Merge the metadata: upsert a key, delete a key and keep the others
+	newMetadata.Add("test_int", &model.CollectionMetadataValueInt64Type{Value: 1})
+	newMetadata.Add("test_float", &model.CollectionMetadataValueFloat64Type{Value: 1.5})
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: newMetadata})
+	suite.NoError(err)
+	mergeMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	mergeMetadata.Add("test_int", &model.CollectionMetadataValueInt64Type{Value: 2})
+	mergeMetadata.Add("test_float", nil)
+	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: mergeMetadata, MergeMetadata: true})
+	suite.NoError(err)
+	expectedMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	expectedMetadata.Add("test_str2", &model.CollectionMetadataValueStringType{Value: "str2"})
+	expectedMetadata.Add("test_int", &model.CollectionMetadataValueInt64Type{Value: 2})
+	coll.Metadata = expectedMetadata
+	coll.RowVersion = 5
+	suite.Equal(coll, result)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, ResetMetadata: true, MergeMetadata: true})
+	suite.ErrorIs(err, common.ErrInvalidMetadataUpdate)
+
 	// Delete all metadata keys
 	coll.Metadata = nil
+	coll.RowVersion = 6
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata, ResetMetadata: true})
 	suite.NoError(err)
 	suite.Equal(coll, result)
@@ -620,6 +685,80 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 	suite.NoError(err)
 }
 
+func (suite *APIsTestSuite) TestMoveCollectionAcrossDatabases() {
+	ctx := context.Background()
+	targetDatabaseName := "test_apis_MoveCollectionAcrossDatabases"
+	_, err := suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+		ID:     uuid.New().String(),
+		Name:   targetDatabaseName,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+
+	collection := suite.sampleCollections[0]
+	segment := &model.CreateSegment{
+		ID:           types.NewUniqueID(),
+		Type:         "test_type_a",
+		Scope:        "VECTOR",
+		CollectionID: collection.ID,
+	}
+	err = suite.coordinator.CreateSegment(ctx, segment)
+	suite.NoError(err)
+
+	// Moving to a database that does not exist fails.
+	missingDatabaseName := "test_apis_MoveCollectionAcrossDatabases_missing"
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:              collection.ID,
+		NewDatabaseName: &missingDatabaseName,
+	})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	// Moving onto a name that is taken in the target database fails.
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         collection.Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: targetDatabaseName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:              collection.ID,
+		NewDatabaseName: &targetDatabaseName,
+	})
+	suite.ErrorIs(err, common.ErrCollectionUniqueConstraintViolation)
+	result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(result, 1)
+
+	// Move and rename at once.
+	newName := collection.Name + "_moved"
+	moved, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:              collection.ID,
+		Name:            &newName,
+		NewDatabaseName: &targetDatabaseName,
+	})
+	suite.NoError(err)
+	suite.Equal(newName, moved.Name)
+	suite.Equal(targetDatabaseName, moved.DatabaseName)
+	suite.Equal(result[0].LogPosition, moved.LogPosition)
+	suite.Equal(result[0].Version, moved.Version)
+	suite.Equal(result[0].Metadata, moved.Metadata)
+
+	result, err = suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Empty(result)
+	result, err = suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, targetDatabaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(result, 1)
+	segments, err := suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, collection.ID)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+
+	// clean up
+	err = dao.CleanUpTestDatabase(suite.db, suite.tenantName, targetDatabaseName)
+	suite.NoError(err)
+}
+
 func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 	newDatabaseName := "test_apis_GetMultipleWithDatabase"
 	ctx := context.Background()
@@ -1022,8 +1161,9 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 	//assert.NoError(t, err)
 	//assert.Equal(t, []*model.Segment{segment}, result)
 
-	// Add a new metadata key
+	// Add a new metadata key. Every update bumps the row version.
 	segment.Metadata.Set("test_str2", &model.SegmentMetadataValueStringType{Value: "str2"})
+	segment.RowVersion = 1
 	_, err := suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{
 		Collection: &collectionID,
 		ID:         segment.ID,
@@ -1035,6 +1175,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 
 	// Update a metadata key
 	segment.Metadata.Set("test_str", &model.SegmentMetadataValueStringType{Value: "str3"})
+	segment.RowVersion = 2
 	_, err = suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{
 		Collection: &collectionID,
 		ID:         segment.ID,
@@ -1046,6 +1187,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 
 	// Delete a metadata key
 	segment.Metadata.Remove("test_str")
+	segment.RowVersion = 3
 	newMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
 	newMetadata.Set("test_str", nil)
 	_, err = suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{
@@ -1059,6 +1201,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 
 	// Delete all metadata keys
 	segment.Metadata = nil
+	segment.RowVersion = 4
 	_, err = suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{
 		Collection:    &collectionID,
 		ID:            segment.ID,
@@ -1071,6 +1214,555 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 	suite.Equal([]*model.Segment{segment}, result)
 }
 
+func (suite *APIsTestSuite) TestUpdateWithExpectedRowVersion() {
+	ctx := context.Background()
+	coll := suite.sampleCollections[0]
+
+	// A stale expected row version is rejected and reports the current one.
+	stale := int64(1)
+	_, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name, ExpectedRowVersion: &stale})
+	suite.ErrorIs(err, common.ErrRowVersionMismatch)
+	var mismatch *common.RowVersionMismatchError
+	suite.ErrorAs(err, &mismatch)
+	suite.Equal(int64(0), mismatch.CurrentRowVersion)
+
+	// A matching expected row version is applied and bumps the row version.
+	expected := int64(0)
+	result, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name, ExpectedRowVersion: &expected})
+	suite.NoError(err)
+	suite.Equal(int64(1), result.RowVersion)
+
+	segmentID := types.NewUniqueID()
+	err = suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type_a",
+		Scope:        "VECTOR",
+		CollectionID: coll.ID,
+	})
+	suite.NoError(err)
+	collectionID := coll.ID.String()
+
+	segment, err := suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{ID: segmentID, Collection: &collectionID, ExpectedRowVersion: &expected})
+	suite.NoError(err)
+	suite.Equal(int64(1), segment.RowVersion)
+
+	_, err = suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{ID: segmentID, Collection: &collectionID, ExpectedRowVersion: &expected})
+	suite.ErrorAs(err, &mismatch)
+	suite.Equal(int64(1), mismatch.CurrentRowVersion)
+}
+
+func (suite *APIsTestSuite) TestCollectionNotifications() {
+	ctx := context.Background()
+	suite.coordinator.deleteMode = SoftDelete
+	listNotifications := &model.ListNotifications{TenantID: suite.tenantName, UpToRevision: math.MaxInt64, Limit: 100}
+
+	// SetupTest created the sample collections.
+	notifications, err := suite.coordinator.ListNotifications(ctx, listNotifications)
+	suite.NoError(err)
+	suite.Len(notifications, len(suite.sampleCollections))
+	for _, notification := range notifications {
+		suite.Equal(model.NotificationTypeCreateCollection, notification.Type)
+		suite.Equal(suite.databaseName, notification.DatabaseName)
+	}
+	listNotifications.AfterRevision = notifications[len(notifications)-1].ID
+
+	coll := suite.sampleCollections[0]
+	newName := "notified_name"
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &newName})
+	suite.NoError(err)
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       coll.ID,
+		TenantID:                 suite.tenantName,
+		LogPosition:              10,
+		CurrentCollectionVersion: 0,
+	})
+	suite.NoError(err)
+	err = suite.coordinator.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           coll.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.RestoreCollection(ctx, &model.RestoreCollection{
+		ID:           coll.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+
+	// A failed change is not notified.
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, ResetMetadata: true, MergeMetadata: true})
+	suite.ErrorIs(err, common.ErrInvalidMetadataUpdate)
+
+	notifications, err = suite.coordinator.ListNotifications(ctx, listNotifications)
+	suite.NoError(err)
+	notificationTypes := make([]string, 0, len(notifications))
+	for i, notification := range notifications {
+		suite.Equal(coll.ID.String(), notification.CollectionID)
+		suite.Equal(suite.tenantName, notification.TenantID)
+		if i > 0 {
+			suite.Greater(notification.ID, notifications[i-1].ID)
+		}
+		notificationTypes = append(notificationTypes, notification.Type)
+	}
+	suite.Equal([]string{
+		model.NotificationTypeUpdateCollection,
+		model.NotificationTypeFlushCollection,
+		model.NotificationTypeDeleteCollection,
+		model.NotificationTypeCreateCollection,
+	}, notificationTypes)
+
+	// Deleting the database notifies the deletion of its collections.
+	database, err := suite.coordinator.GetDatabase(ctx, &model.GetDatabase{Name: suite.databaseName, Tenant: suite.tenantName})
+	suite.NoError(err)
+	listNotifications.AfterRevision = notifications[len(notifications)-1].ID
+	listNotifications.DatabaseID = database.ID
+	err = suite.coordinator.DeleteDatabase(ctx, &model.DeleteDatabase{Name: suite.databaseName, Tenant: suite.tenantName})
+	suite.NoError(err)
+	notifications, err = suite.coordinator.ListNotifications(ctx, listNotifications)
+	suite.NoError(err)
+	suite.Len(notifications, len(suite.sampleCollections))
+	for _, notification := range notifications {
+		suite.Equal(model.NotificationTypeDeleteCollection, notification.Type)
+	}
+}
+
+func (suite *APIsTestSuite) TestAuditEvents() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuditActorHeader, "auditor"))
+	suite.coordinator.deleteMode = SoftDelete
+	listAuditEvents := &model.ListAuditEvents{TenantID: suite.tenantName, Limit: 100}
+
+	// SetupTest created the sample collections without naming an actor.
+	auditEvents, err := suite.coordinator.ListAuditEvents(ctx, listAuditEvents)
+	suite.NoError(err)
+	suite.Len(auditEvents, len(suite.sampleCollections))
+	for _, auditEvent := range auditEvents {
+		suite.Equal(model.AuditOperationCreateCollection, auditEvent.Operation)
+		suite.Equal(unknownAuditActor, auditEvent.Actor)
+		suite.Nil(auditEvent.Before)
+		suite.NotNil(auditEvent.After)
+	}
+	createdCount := len(auditEvents)
+	listAuditEvents.AfterID = auditEvents[len(auditEvents)-1].ID
+
+	coll := suite.sampleCollections[0]
+	newName := "audited_name"
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &newName})
+	suite.NoError(err)
+	err = suite.coordinator.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           coll.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+
+	// A failed change is not audited.
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: suite.sampleCollections[1].ID, ResetMetadata: true, MergeMetadata: true})
+	suite.ErrorIs(err, common.ErrInvalidMetadataUpdate)
+
+	auditEvents, err = suite.coordinator.ListAuditEvents(ctx, listAuditEvents)
+	suite.NoError(err)
+	suite.Len(auditEvents, 2)
+	for _, auditEvent := range auditEvents {
+		suite.Equal("auditor", auditEvent.Actor)
+		suite.Equal(suite.tenantName, auditEvent.TenantID)
+		suite.NotEmpty(auditEvent.DatabaseID)
+		suite.Equal(coll.ID.String(), auditEvent.CollectionID)
+	}
+	update := auditEvents[0]
+	suite.Equal(model.AuditOperationUpdateCollection, update.Operation)
+	suite.Contains(*update.Before, fmt.Sprintf(`"Name":%q`, coll.Name))
+	suite.Contains(*update.After, fmt.Sprintf(`"Name":%q`, newName))
+	suite.Contains(*update.After, fmt.Sprintf(`"ID":%q`, coll.ID.String()))
+	deletion := auditEvents[1]
+	suite.Equal(model.AuditOperationDeleteCollection, deletion.Operation)
+	suite.Contains(*deletion.Before, fmt.Sprintf(`"Name":%q`, newName))
+	suite.Nil(deletion.After)
+
+	// Time ranges are half open.
+	now := time.Now()
+	auditEvents, err = suite.coordinator.ListAuditEvents(ctx, &model.ListAuditEvents{
+		TenantID: suite.tenantName,
+		Start:    now.Add(-time.Hour).Unix(),
+		End:      now.Add(time.Hour).Unix(),
+		Limit:    100,
+	})
+	suite.NoError(err)
+	suite.Len(auditEvents, createdCount+2)
+	auditEvents, err = suite.coordinator.ListAuditEvents(ctx, &model.ListAuditEvents{
+		TenantID: suite.tenantName,
+		Start:    now.Add(time.Hour).Unix(),
+		Limit:    100,
+	})
+	suite.NoError(err)
+	suite.Empty(auditEvents)
+	auditEvents, err = suite.coordinator.ListAuditEvents(ctx, &model.ListAuditEvents{
+		TenantID: "other_tenant",
+		Limit:    100,
+	})
+	suite.NoError(err)
+	suite.Empty(auditEvents)
+}
+
+func (suite *APIsTestSuite) TestTenantQuotas() {
+	ctx := context.Background()
+	int32Ptr := func(i int32) *int32 { return &i }
+	requireQuotaExceeded := func(err error, quota string, limit int64, requested int64) {
+		suite.ErrorIs(err, common.ErrQuotaExceeded)
+		var quotaExceeded *common.QuotaExceededError
+		suite.Require().True(errors.As(err, &quotaExceeded))
+		suite.Equal(suite.tenantName, quotaExceeded.TenantID)
+		suite.Equal(quota, quotaExceeded.Quota)
+		suite.Equal(limit, quotaExceeded.Limit)
+		suite.Equal(requested, quotaExceeded.Requested)
+	}
+
+	// Without a quota of its own a tenant gets the default quota.
+	suite.coordinator.SetDefaultQuota(model.Quota{MaxCollectionDimension: 256})
+	tenantQuota, effectiveQuota, err := suite.coordinator.GetTenantQuota(ctx, suite.tenantName)
+	suite.NoError(err)
+	suite.Nil(tenantQuota)
+	suite.Equal(model.Quota{MaxCollectionDimension: 256}, effectiveQuota)
+	_, _, err = suite.coordinator.GetTenantQuota(ctx, "missing_tenant")
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+	_, err = suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{TenantID: "missing_tenant"})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+
+	effectiveQuota, err = suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{
+		TenantID:                     suite.tenantName,
+		MaxDatabases:                 int32Ptr(2),
+		MaxCollectionsPerDatabase:    int32Ptr(3),
+		MaxMetadataKeysPerCollection: int32Ptr(3),
+		MaxMetadataValueSize:         int32Ptr(8),
+	})
+	suite.NoError(err)
+	suite.Equal(model.Quota{
+		MaxDatabases:                 2,
+		MaxCollectionsPerDatabase:    3,
+		MaxMetadataKeysPerCollection: 3,
+		MaxMetadataValueSize:         8,
+		MaxCollectionDimension:       256,
+	}, effectiveQuota)
+	tenantQuota, _, err = suite.coordinator.GetTenantQuota(ctx, suite.tenantName)
+	suite.NoError(err)
+	suite.Equal(int32(2), *tenantQuota.MaxDatabases)
+	suite.Nil(tenantQuota.MaxCollectionDimension)
+
+	// Databases
+	otherDatabaseName := "quota_database_" + suite.T().Name()
+	_, err = suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+		ID:     types.NewUniqueID().String(),
+		Name:   otherDatabaseName,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+		ID:     types.NewUniqueID().String(),
+		Name:   "over_quota_database",
+		Tenant: suite.tenantName,
+	})
+	requireQuotaExceeded(err, common.QuotaMaxDatabases, 2, 3)
+
+	// Collections per database, getting an existing collection is not limited.
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "over_quota_collection",
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	requireQuotaExceeded(err, common.QuotaMaxCollectionsPerDatabase, 3, 4)
+	_, created, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         suite.sampleCollections[0].Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+		GetOrCreate:  true,
+	})
+	suite.NoError(err)
+	suite.False(created)
+
+	// Dimension, metadata keys and metadata values of new collections.
+	newCollection := func(dimension int32, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) *model.CreateCollection {
+		return &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "quota_collection",
+			Dimension:    &dimension,
+			Metadata:     metadata,
+			TenantID:     suite.tenantName,
+			DatabaseName: otherDatabaseName,
+		}
+	}
+	_, _, err = suite.coordinator.CreateCollection(ctx, newCollection(512, nil))
+	requireQuotaExceeded(err, common.QuotaMaxCollectionDimension, 256, 512)
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		metadata.Add(key, &model.CollectionMetadataValueInt64Type{Value: 1})
+	}
+	_, _, err = suite.coordinator.CreateCollection(ctx, newCollection(256, metadata))
+	requireQuotaExceeded(err, common.QuotaMaxMetadataKeysPerCollection, 3, 4)
+	metadata = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("a", &model.CollectionMetadataValueStringType{Value: "too long value"})
+	_, _, err = suite.coordinator.CreateCollection(ctx, newCollection(256, metadata))
+	requireQuotaExceeded(err, common.QuotaMaxMetadataValueSize, 8, 14)
+	metadata = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("a", &model.CollectionMetadataValueStringType{Value: "short"})
+	_, _, err = suite.coordinator.CreateCollection(ctx, newCollection(256, metadata))
+	suite.NoError(err)
+
+	// Updates count the keys the collection ends up with.
+	coll := suite.sampleCollections[0]
+	metadata = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("test_new", &model.CollectionMetadataValueInt64Type{Value: 1})
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: metadata, MergeMetadata: true})
+	requireQuotaExceeded(err, common.QuotaMaxMetadataKeysPerCollection, 3, 4)
+	metadata.Add("test_int", nil)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: metadata, MergeMetadata: true})
+	suite.NoError(err)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: int32Ptr(1024)})
+	requireQuotaExceeded(err, common.QuotaMaxCollectionDimension, 256, 1024)
+
+	// Lowering a limit keeps what exists but refuses moving a collection in.
+	_, err = suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{
+		TenantID:                  suite.tenantName,
+		MaxCollectionsPerDatabase: int32Ptr(1),
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, NewDatabaseName: &otherDatabaseName})
+	requireQuotaExceeded(err, common.QuotaMaxCollectionsPerDatabase, 1, 2)
+	collections, err := suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+
+	// Clearing the quota of the tenant falls back to the default.
+	effectiveQuota, err = suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{TenantID: suite.tenantName})
+	suite.NoError(err)
+	suite.Equal(model.Quota{MaxCollectionDimension: 256}, effectiveQuota)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, NewDatabaseName: &otherDatabaseName})
+	suite.NoError(err)
+}
+
+func (suite *APIsTestSuite) TestConcurrentCreateDatabaseQuota() {
+	ctx := context.Background()
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	// The tenant has a database, there is room for one more.
+	_, err := suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{
+		TenantID:     suite.tenantName,
+		MaxDatabases: int32Ptr(2),
+	})
+	suite.NoError(err)
+
+	// Concurrent creations are counted one after the other, only one fits.
+	const creators = 8
+	var wg sync.WaitGroup
+	errs := make([]error, creators)
+	for i := 0; i < creators; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+				ID:     types.NewUniqueID().String(),
+				Name:   fmt.Sprintf("concurrent_database_%d", i),
+				Tenant: suite.tenantName,
+			})
+		}(i)
+	}
+	wg.Wait()
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		suite.ErrorIs(err, common.ErrQuotaExceeded)
+	}
+	suite.Equal(1, created)
+	databases, err := suite.coordinator.ListDatabases(ctx, &model.ListDatabases{Tenant: suite.tenantName, Limit: creators})
+	suite.NoError(err)
+	suite.Len(databases, 2)
+}
+
+func (suite *APIsTestSuite) TestTenantAndDatabaseMetadata() {
+	ctx := context.Background()
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("plan", &model.CollectionMetadataValueStringType{Value: "pro"})
+	metadata.Add("seats", &model.CollectionMetadataValueInt64Type{Value: 10})
+
+	tenant, err := suite.coordinator.UpdateTenant(ctx, &model.UpdateTenant{Name: suite.tenantName, Metadata: metadata})
+	suite.NoError(err)
+	suite.True(metadata.Equals(tenant.Metadata))
+	tenant, err = suite.coordinator.GetTenant(ctx, &model.GetTenant{Name: suite.tenantName})
+	suite.NoError(err)
+	suite.True(metadata.Equals(tenant.Metadata))
+	_, err = suite.coordinator.UpdateTenant(ctx, &model.UpdateTenant{Name: suite.tenantName, ResetMetadata: true, MergeMetadata: true})
+	suite.ErrorIs(err, common.ErrInvalidMetadataUpdate)
+
+	auditEvents, err := suite.coordinator.ListAuditEvents(ctx, &model.ListAuditEvents{TenantID: suite.tenantName, Limit: 100})
+	suite.NoError(err)
+	last := auditEvents[len(auditEvents)-1]
+	suite.Equal(model.AuditOperationUpdateTenant, last.Operation)
+	suite.Contains(*last.After, `"plan"`)
+
+	// Hard deleting a database deletes its metadata.
+	suite.coordinator.deleteMode = HardDelete
+	databaseName := "metadata_database_" + suite.T().Name()
+	createDatabase := &model.CreateDatabase{
+		ID:       types.NewUniqueID().String(),
+		Name:     databaseName,
+		Tenant:   suite.tenantName,
+		Metadata: metadata,
+	}
+	database, err := suite.coordinator.CreateDatabase(ctx, createDatabase)
+	suite.NoError(err)
+	suite.True(metadata.Equals(database.Metadata))
+	err = suite.coordinator.DeleteDatabase(ctx, &model.DeleteDatabase{Name: databaseName, Tenant: suite.tenantName})
+	suite.NoError(err)
+	createDatabase.ID = types.NewUniqueID().String()
+	createDatabase.Metadata = nil
+	_, err = suite.coordinator.CreateDatabase(ctx, createDatabase)
+	suite.NoError(err)
+	database, err = suite.coordinator.GetDatabase(ctx, &model.GetDatabase{Name: databaseName, Tenant: suite.tenantName})
+	suite.NoError(err)
+	suite.Nil(database.Metadata)
+}
+
+func (suite *APIsTestSuite) TestCollectionConfiguration() {
+	ctx := context.Background()
+	requireInvalidConfiguration := func(err error, field string) {
+		suite.ErrorIs(err, common.ErrInvalidCollectionConfiguration)
+		var invalidConfiguration *common.InvalidCollectionConfigurationError
+		suite.Require().True(errors.As(err, &invalidConfiguration))
+		suite.Equal(field, invalidConfiguration.Field)
+	}
+	createCollection := func(configurationJsonStr string) (*model.Collection, error) {
+		collection, _, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+			ID:                   types.NewUniqueID(),
+			Name:                 "configured_collection_" + types.NewUniqueID().String(),
+			ConfigurationJsonStr: configurationJsonStr,
+			TenantID:             suite.tenantName,
+			DatabaseName:         suite.databaseName,
+		})
+		return collection, err
+	}
+	updateConfiguration := func(collectionID types.UniqueID, configurationJsonStr string) (*model.Collection, error) {
+		return suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+			ID:                   collectionID,
+			ConfigurationJsonStr: &configurationJsonStr,
+			TenantID:             suite.tenantName,
+			DatabaseName:         suite.databaseName,
+		})
+	}
+
+	_, err := createCollection(`{"hnsw_configuration": {"space": "hamming"}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.space")
+	_, err = createCollection(`{"hnsw_configuration": {"ef_search": 0}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.ef_search")
+	_, err = createCollection(`{"hnsw_configuration": {"ef_serch": 10}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.ef_serch")
+	_, err = createCollection(`{"hnsw_configuration": {"M": "16"}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.M")
+	_, err = createCollection(`{"hnsw_configuration": {"batch_size": 200, "sync_threshold": 100}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.batch_size")
+	_, err = createCollection(`{"hnsw_configuration": {}, "spann_configuration": {}}`)
+	requireInvalidConfiguration(err, "spann_configuration")
+	_, err = createCollection(`{"embedding_function": {"type": "known"}}`)
+	requireInvalidConfiguration(err, "embedding_function.name")
+
+	collection, err := createCollection(`{"hnsw_configuration": {"space": "cosine", "ef_search": 10, "_type": "HNSWConfigurationInternal"}, "_type": "CollectionConfigurationInternal"}`)
+	suite.NoError(err)
+
+	// The search parameters and the embedding function can change, the space
+	// can only be repeated.
+	collection, err = updateConfiguration(collection.ID, `{"hnsw_configuration": {"space": "cosine", "ef_search": 20}, "embedding_function": {"type": "known", "name": "openai", "config": {"model_name": "text-embedding-3-small"}}}`)
+	suite.NoError(err)
+	configuration, err := model.ParseCollectionConfiguration(collection.ConfigurationJsonStr)
+	suite.NoError(err)
+	suite.Equal("cosine", *configuration.HNSW.Space)
+	suite.Equal(20, *configuration.HNSW.EFSearch)
+	suite.Equal("openai", configuration.EmbeddingFunction.Name)
+	suite.Equal("CollectionConfigurationInternal", configuration.Type)
+
+	_, err = updateConfiguration(collection.ID, `{"hnsw_configuration": {"space": "l2"}}`)
+	suite.ErrorIs(err, common.ErrCollectionSpaceImmutable)
+	_, err = updateConfiguration(collection.ID, `{"hnsw_configuration": {"M": 32}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.M")
+	_, err = updateConfiguration(collection.ID, `{"hnsw_configuration": {"ef_search": -1}}`)
+	requireInvalidConfiguration(err, "hnsw_configuration.ef_search")
+	_, err = updateConfiguration(collection.ID, `{"spann_configuration": {"ef_search": 10}}`)
+	requireInvalidConfiguration(err, "spann_configuration")
+	_, err = updateConfiguration(types.NewUniqueID(), `{"hnsw_configuration": {"ef_search": 30}}`)
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+
+	collections, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Equal(collection.ConfigurationJsonStr, collections[0].ConfigurationJsonStr)
+}
+
+func (suite *APIsTestSuite) TestCatalogCache() {
+	ctx := context.Background()
+	suite.coordinator.SetCatalogCache(CatalogCacheConfig{Size: 10, TTL: time.Minute})
+	cache := suite.coordinator.catalog.cache
+	coll := suite.sampleCollections[0]
+
+	// Lookups by id are served from the cache after the first one.
+	for i := 0; i < 2; i++ {
+		results, err := suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+		suite.NoError(err)
+		suite.Len(results, 1)
+		suite.Equal(coll.Name, results[0].Name)
+	}
+	suite.Equal(catalogCacheStats{Hits: 1, Misses: 1}, cache.getStats())
+	results, err := suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, "other_database", nil, nil)
+	suite.NoError(err)
+	suite.Empty(results)
+
+	// An update invalidates the collection.
+	newName := "cached_collection_renamed"
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &newName})
+	suite.NoError(err)
+	results, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Equal(newName, results[0].Name)
+
+	// Segments are cached per collection and filtered on lookup, a flush
+	// invalidates them.
+	segmentID := types.NewUniqueID()
+	err = suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type_a",
+		Scope:        "VECTOR",
+		CollectionID: coll.ID,
+	})
+	suite.NoError(err)
+	scope := "VECTOR"
+	segments, err := suite.coordinator.GetSegments(ctx, types.NilUniqueID(), nil, &scope, coll.ID)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	otherScope := "METADATA"
+	segments, err = suite.coordinator.GetSegments(ctx, types.NilUniqueID(), nil, &otherScope, coll.ID)
+	suite.NoError(err)
+	suite.Empty(segments)
+	stats := cache.getStats()
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       coll.ID,
+		TenantID:                 suite.tenantName,
+		LogPosition:              10,
+		CurrentCollectionVersion: 0,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segmentID, FilePaths: map[string][]string{"hnsw": {"cache_test/a"}}},
+		},
+	})
+	suite.NoError(err)
+	segments, err = suite.coordinator.GetSegments(ctx, segmentID, nil, nil, coll.ID)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	suite.Equal([]string{"cache_test/a"}, segments[0].FilePaths["hnsw"])
+	suite.Equal(stats.Misses+1, cache.getStats().Misses)
+	results, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Equal(int64(10), results[0].LogPosition)
+}
+
 // TestSoftAndHardDeleteCollection tests the soft and hard delete scenarios for collections.
 func (suite *APIsTestSuite) TestSoftAndHardDeleteCollection() {
 	ctx := context.Background()
@@ -1169,7 +1861,604 @@ func (suite *APIsTestSuite) TestSoftAndHardDeleteCollection() {
 	suite.Contains(softDeletedResults[0].Name, renamedCollectionNamePrefix)
 }
 
+func (suite *APIsTestSuite) TestRestoreCollection() {
+	ctx := context.Background()
+	suite.coordinator.deleteMode = SoftDelete
+
+	testCollection := &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "test_restore_collection",
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	}
+	_, _, err := suite.coordinator.CreateCollection(ctx, testCollection)
+	suite.NoError(err)
+
+	// Restoring a live collection fails.
+	_, err = suite.coordinator.RestoreCollection(ctx, &model.RestoreCollection{
+		ID:           testCollection.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+
+	deleteCollection := &model.DeleteCollection{
+		ID:           testCollection.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	}
+	err = suite.coordinator.DeleteCollection(ctx, deleteCollection)
+	suite.NoError(err)
+
+	// Restore under the original name.
+	restored, err := suite.coordinator.RestoreCollection(ctx, &model.RestoreCollection{
+		ID:           testCollection.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Equal(testCollection.ID, restored.ID)
+	suite.Equal(testCollection.Name, restored.Name)
+	results, err := suite.coordinator.GetCollections(ctx, testCollection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(results, 1)
+	id := testCollection.ID.String()
+	softDeletedResults, err := suite.coordinator.GetSoftDeletedCollections(ctx, &id, suite.tenantName, suite.databaseName, 10)
+	suite.NoError(err)
+	suite.Empty(softDeletedResults)
+
+	// Delete again and take the name with a new collection, restoring under
+	// that name now conflicts.
+	err = suite.coordinator.DeleteCollection(ctx, deleteCollection)
+	suite.NoError(err)
+	newTestCollection := &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         testCollection.Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	}
+	_, _, err = suite.coordinator.CreateCollection(ctx, newTestCollection)
+	suite.NoError(err)
+	_, err = suite.coordinator.RestoreCollection(ctx, &model.RestoreCollection{
+		ID:           testCollection.ID,
+		Name:         &testCollection.Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.ErrorIs(err, common.ErrCollectionUniqueConstraintViolation)
+
+	// Restoring counts against the collection quota of the database.
+	newName := "test_restore_collection_renamed"
+	count, err := suite.coordinator.CountCollections(ctx, suite.tenantName, &suite.databaseName)
+	suite.NoError(err)
+	maxCollections := int32(count)
+	_, err = suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{
+		TenantID:                  suite.tenantName,
+		MaxCollectionsPerDatabase: &maxCollections,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.RestoreCollection(ctx, &model.RestoreCollection{
+		ID:           testCollection.ID,
+		Name:         &newName,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	var quotaExceeded *common.QuotaExceededError
+	suite.Require().True(errors.As(err, &quotaExceeded))
+	suite.Equal(common.QuotaMaxCollectionsPerDatabase, quotaExceeded.Quota)
+	suite.Equal(int64(count)+1, quotaExceeded.Requested)
+	_, err = suite.coordinator.SetTenantQuota(ctx, &model.TenantQuota{TenantID: suite.tenantName})
+	suite.NoError(err)
+
+	// Restore under a new name.
+	restored, err = suite.coordinator.RestoreCollection(ctx, &model.RestoreCollection{
+		ID:           testCollection.ID,
+		Name:         &newName,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Equal(newName, restored.Name)
+	results, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &newName, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(results, 1)
+	suite.Equal(testCollection.ID, results[0].ID)
+}
+
+func (suite *APIsTestSuite) TestForkCollection() {
+	ctx := context.Background()
+	suite.coordinator.deleteMode = HardDelete
+
+	source := suite.sampleCollections[0]
+	segmentID := types.NewUniqueID()
+	err := suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type_a",
+		Scope:        "VECTOR",
+		CollectionID: source.ID,
+	})
+	suite.NoError(err)
+	oldFilePaths := []string{"fork_test/a", "fork_test/b"}
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       source.ID,
+		TenantID:                 suite.tenantName,
+		LogPosition:              10,
+		CurrentCollectionVersion: 0,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segmentID, FilePaths: map[string][]string{"hnsw": oldFilePaths}},
+		},
+	})
+	suite.NoError(err)
+
+	// Forking onto an existing name fails, and the forked log is deleted.
+	failedForkID := types.NewUniqueID()
+	_, err = suite.coordinator.ForkCollection(ctx, &model.ForkCollection{
+		SourceCollectionID:   source.ID,
+		TargetCollectionID:   failedForkID,
+		TargetCollectionName: suite.sampleCollections[1].Name,
+		TenantID:             suite.tenantName,
+		DatabaseName:         suite.databaseName,
+	})
+	suite.ErrorIs(err, common.ErrCollectionUniqueConstraintViolation)
+	suite.False(suite.logService.HasLog(failedForkID.String()))
+
+	// Records the source has not compacted yet can not be dropped from a fork.
+	suite.coordinator.SetLogService(nil)
+	_, err = suite.coordinator.ForkCollection(ctx, &model.ForkCollection{
+		SourceCollectionID:   source.ID,
+		TargetCollectionID:   types.NewUniqueID(),
+		TargetCollectionName: source.Name + "_fork",
+		TenantID:             suite.tenantName,
+		DatabaseName:         suite.databaseName,
+	})
+	suite.ErrorIs(err, common.ErrLogServiceNotConfigured)
+	suite.coordinator.SetLogService(suite.logService)
+
+	// The source has 5 records that are not compacted yet.
+	suite.logService.PushLogs(source.ID.String(), 15)
+	err = suite.logService.UpdateCollectionLogOffset(ctx, source.ID.String(), 10)
+	suite.NoError(err)
+	fork, err := suite.coordinator.ForkCollection(ctx, &model.ForkCollection{
+		SourceCollectionID:   source.ID,
+		TargetCollectionID:   types.NewUniqueID(),
+		TargetCollectionName: source.Name + "_fork",
+		TenantID:             suite.tenantName,
+		DatabaseName:         suite.databaseName,
+	})
+	suite.NoError(err)
+	// The fork has its own log, which starts with the records of the source
+	// after its log position.
+	suite.Equal(int64(0), fork.LogPosition)
+	suite.Equal(int64(5), suite.logService.EnumerationOffset(fork.ID.String()))
+	suite.Equal(source.Metadata, fork.Metadata)
+	suite.Equal(source.Dimension, fork.Dimension)
+	forkSegments, err := suite.coordinator.GetSegments(ctx, types.NilUniqueID(), nil, nil, fork.ID)
+	suite.NoError(err)
+	suite.Len(forkSegments, 1)
+	suite.NotEqual(segmentID, forkSegments[0].ID)
+	suite.Equal(map[string][]string{"hnsw": oldFilePaths}, forkSegments[0].FilePaths)
+
+	// Both collections reference the shared files.
+	shared, err := suite.coordinator.GetSharedFilePaths(ctx, source.ID, oldFilePaths)
+	suite.NoError(err)
+	suite.ElementsMatch(oldFilePaths, shared)
+	shared, err = suite.coordinator.GetSharedFilePaths(ctx, fork.ID, oldFilePaths)
+	suite.NoError(err)
+	suite.ElementsMatch(oldFilePaths, shared)
+
+	// The source may be forked again, its references already exist.
+	secondFork, err := suite.coordinator.ForkCollection(ctx, &model.ForkCollection{
+		SourceCollectionID:   source.ID,
+		TargetCollectionID:   types.NewUniqueID(),
+		TargetCollectionName: source.Name + "_fork_2",
+		TenantID:             suite.tenantName,
+		DatabaseName:         suite.databaseName,
+	})
+	suite.NoError(err)
+	shared, err = suite.coordinator.GetSharedFilePaths(ctx, secondFork.ID, oldFilePaths)
+	suite.NoError(err)
+	suite.ElementsMatch(oldFilePaths, shared)
+	err = suite.coordinator.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           secondFork.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+
+	// Writes to the fork follow the forked records and compact from position
+	// 0, independently of the log position of the source.
+	suite.logService.PushLogs(fork.ID.String(), 1)
+	forkSegmentID := forkSegments[0].ID
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       fork.ID,
+		TenantID:                 suite.tenantName,
+		LogPosition:              suite.logService.EnumerationOffset(fork.ID.String()),
+		CurrentCollectionVersion: 0,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: forkSegmentID, FilePaths: map[string][]string{"hnsw": append(oldFilePaths, "fork_test/d")}},
+		},
+	})
+	suite.NoError(err)
+	forkResults, err := suite.coordinator.GetCollections(ctx, fork.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(forkResults, 1)
+	suite.Equal(int64(6), forkResults[0].LogPosition)
+
+	// Once the source compacts to new files, only the fork keeps the old ones.
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       source.ID,
+		TenantID:                 suite.tenantName,
+		LogPosition:              20,
+		CurrentCollectionVersion: 1,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segmentID, FilePaths: map[string][]string{"hnsw": {"fork_test/c"}}},
+		},
+	})
+	suite.NoError(err)
+	shared, err = suite.coordinator.GetSharedFilePaths(ctx, source.ID, oldFilePaths)
+	suite.NoError(err)
+	suite.ElementsMatch(oldFilePaths, shared)
+	shared, err = suite.coordinator.GetSharedFilePaths(ctx, fork.ID, oldFilePaths)
+	suite.NoError(err)
+	suite.Empty(shared)
+
+	// Deleting the fork releases its references.
+	err = suite.coordinator.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           fork.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	shared, err = suite.coordinator.GetSharedFilePaths(ctx, source.ID, oldFilePaths)
+	suite.NoError(err)
+	suite.Empty(shared)
+}
+
+func (suite *APIsTestSuite) TestCollectionVersionHistoryAndRollback() {
+	ctx := context.Background()
+	collection := suite.sampleCollections[0]
+	segmentID := types.NewUniqueID()
+	err := suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type_a",
+		Scope:        "VECTOR",
+		CollectionID: collection.ID,
+	})
+	suite.NoError(err)
+
+	flush := func(logPosition int64, currentVersion int32, filePaths []string) {
+		_, err := suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+			ID:                       collection.ID,
+			TenantID:                 suite.tenantName,
+			LogPosition:              logPosition,
+			CurrentCollectionVersion: currentVersion,
+			FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+				{ID: segmentID, FilePaths: map[string][]string{"hnsw": filePaths}},
+			},
+		})
+		suite.NoError(err)
+		err = suite.logService.UpdateCollectionLogOffset(ctx, collection.ID.String(), logPosition)
+		suite.NoError(err)
+	}
+	suite.logService.PushLogs(collection.ID.String(), 60)
+	flush(10, 0, []string{"v1"})
+	flush(20, 1, []string{"v1", "v2"})
+	flush(30, 2, []string{"v3"})
+
+	versions, err := suite.coordinator.ListCollectionVersions(ctx, collection.ID, 10)
+	suite.NoError(err)
+	suite.Len(versions, 3)
+	suite.Equal(int32(3), versions[0].Version)
+	suite.Equal(int64(30), versions[0].LogPosition)
+	suite.Equal(int32(2), versions[1].Version)
+	suite.Equal(map[string][]string{"hnsw": {"v1", "v2"}}, versions[1].SegmentFilePaths[segmentID.String()])
+
+	segments, err := suite.coordinator.GetSegmentsAtVersion(ctx, collection.ID, 2)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	suite.Equal(segmentID, segments[0].ID)
+	suite.Equal(map[string][]string{"hnsw": {"v1", "v2"}}, segments[0].FilePaths)
+
+	_, err = suite.coordinator.GetSegmentsAtVersion(ctx, collection.ID, 42)
+	suite.ErrorIs(err, common.ErrCollectionVersionNotFound)
+	_, err = suite.coordinator.RollbackCollection(ctx, collection.ID, 42)
+	suite.ErrorIs(err, common.ErrCollectionVersionNotFound)
+
+	// No collection references the files of version 2 any more, they may have
+	// been garbage collected.
+	_, err = suite.coordinator.RollbackCollection(ctx, collection.ID, 2)
+	suite.ErrorIs(err, common.ErrCollectionVersionFilesCollected)
+	// The log is moved forward again.
+	suite.Equal(int64(30), suite.logService.CompactionOffset(collection.ID.String()))
+	flush(40, 3, []string{"v1", "v2", "v3"})
+
+	// Neither can a collection roll back to records the log purged.
+	suite.logService.PurgeLogs(collection.ID.String())
+	_, err = suite.coordinator.RollbackCollection(ctx, collection.ID, 2)
+	suite.ErrorIs(err, common.ErrCollectionLogPurged)
+	result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Equal(int32(4), result[0].Version)
+	suite.Equal(int64(40), result[0].LogPosition)
+
+	// Rolling back to version 4, whose later records are still in the log, is
+	// recorded as version 6 and rewinds the log.
+	flush(50, 4, []string{"v1", "v2", "v3"})
+	rolledBack, err := suite.coordinator.RollbackCollection(ctx, collection.ID, 4)
+	suite.NoError(err)
+	suite.Equal(int32(6), rolledBack.Version)
+	suite.Equal(int64(40), rolledBack.LogPosition)
+	suite.Equal(int64(40), suite.logService.CompactionOffset(collection.ID.String()))
+	result, err = suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.NoError(err)
+	suite.Len(result, 1)
+	suite.Equal(int32(6), result[0].Version)
+	suite.Equal(int64(40), result[0].LogPosition)
+	segments, err = suite.coordinator.GetSegments(ctx, segmentID, nil, nil, collection.ID)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	suite.Equal(map[string][]string{"hnsw": {"v1", "v2", "v3"}}, segments[0].FilePaths)
+
+	// A compactor still holding version 5 can no longer flush.
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       collection.ID,
+		TenantID:                 suite.tenantName,
+		LogPosition:              60,
+		CurrentCollectionVersion: 5,
+	})
+	suite.ErrorIs(err, common.ErrCollectionVersionStale)
+	flush(50, 6, []string{"v4"})
+
+	// Suspended tenants and soft deleted collections can not roll back.
+	err = suite.coordinator.SuspendTenant(ctx, suite.tenantName)
+	suite.NoError(err)
+	_, err = suite.coordinator.RollbackCollection(ctx, collection.ID, 7)
+	suite.ErrorIs(err, common.ErrTenantSuspended)
+	err = suite.coordinator.ResumeTenant(ctx, suite.tenantName)
+	suite.NoError(err)
+	err = suite.coordinator.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           collection.ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.RollbackCollection(ctx, collection.ID, 7)
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+}
+
+func (suite *APIsTestSuite) TestSoftAndHardDeleteDatabase() {
+	ctx := context.Background()
+
+	createDatabaseWithCollection := func(databaseName string) (types.UniqueID, types.UniqueID) {
+		_, err := suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+			ID:     types.NewUniqueID().String(),
+			Name:   databaseName,
+			Tenant: suite.tenantName,
+		})
+		suite.NoError(err)
+		collectionID := types.NewUniqueID()
+		segmentID := types.NewUniqueID()
+		_, _, err = suite.coordinator.CreateCollectionAndSegments(ctx, &model.CreateCollection{
+			ID:           collectionID,
+			Name:         "collection_" + databaseName,
+			TenantID:     suite.tenantName,
+			DatabaseName: databaseName,
+		}, []*model.CreateSegment{
+			{
+				ID:           segmentID,
+				Type:         "test_type_a",
+				Scope:        "VECTOR",
+				CollectionID: collectionID,
+			},
+			{
+				ID:           types.NewUniqueID(),
+				Type:         "test_type_b",
+				Scope:        "METADATA",
+				CollectionID: collectionID,
+			},
+			{
+				ID:           types.NewUniqueID(),
+				Type:         "test_type_c",
+				Scope:        "RECORD",
+				CollectionID: collectionID,
+			},
+		})
+		suite.NoError(err)
+		return collectionID, segmentID
+	}
+
+	// Deleting a database that does not exist fails.
+	err := suite.coordinator.DeleteDatabase(ctx, &model.DeleteDatabase{
+		Name:   "database_does_not_exist",
+		Tenant: suite.tenantName,
+	})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	// Hard delete removes the database together with its collections and segments.
+	suite.coordinator.deleteMode = HardDelete
+	hardDeleteDatabase := "hard_delete_database_" + suite.T().Name()
+	collectionID, segmentID := createDatabaseWithCollection(hardDeleteDatabase)
+	err = suite.coordinator.DeleteDatabase(ctx, &model.DeleteDatabase{
+		Name:   hardDeleteDatabase,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.GetDatabase(ctx, &model.GetDatabase{
+		Name:   hardDeleteDatabase,
+		Tenant: suite.tenantName,
+	})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+	id := collectionID.String()
+	softDeletedCollections, err := suite.coordinator.GetSoftDeletedCollections(ctx, &id, "", "", 10)
+	suite.NoError(err)
+	suite.Empty(softDeletedCollections)
+	segments, err := suite.coordinator.GetSegments(ctx, segmentID, nil, nil, collectionID)
+	suite.NoError(err)
+	suite.Empty(segments)
+
+	// Soft delete hides the database and its collections until the cleaner reaps them.
+	suite.coordinator.deleteMode = SoftDelete
+	softDeleteDatabase := "soft_delete_database_" + suite.T().Name()
+	collectionID, segmentID = createDatabaseWithCollection(softDeleteDatabase)
+	err = suite.coordinator.DeleteDatabase(ctx, &model.DeleteDatabase{
+		Name:   softDeleteDatabase,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.GetDatabase(ctx, &model.GetDatabase{
+		Name:   softDeleteDatabase,
+		Tenant: suite.tenantName,
+	})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+	id = collectionID.String()
+	softDeletedCollections, err = suite.coordinator.GetSoftDeletedCollections(ctx, &id, suite.tenantName, "", 10)
+	suite.NoError(err)
+	suite.Len(softDeletedCollections, 1)
+
+	softDeletedDatabases, err := suite.coordinator.GetSoftDeletedDatabases(ctx, 100)
+	suite.NoError(err)
+	var softDeletedDatabaseID string
+	for _, database := range softDeletedDatabases {
+		if database.Tenant == suite.tenantName {
+			softDeletedDatabaseID = database.ID
+			suite.Contains(database.Name, fmt.Sprintf("deleted_%s_", softDeleteDatabase))
+		}
+	}
+	suite.NotEmpty(softDeletedDatabaseID)
+
+	// The name of a soft deleted database can be reused right away.
+	_, err = suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+		ID:     types.NewUniqueID().String(),
+		Name:   softDeleteDatabase,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+
+	err = suite.coordinator.CleanupSoftDeletedDatabase(ctx, softDeletedDatabaseID)
+	suite.NoError(err)
+	softDeletedCollections, err = suite.coordinator.GetSoftDeletedCollections(ctx, &id, suite.tenantName, "", 10)
+	suite.NoError(err)
+	suite.Empty(softDeletedCollections)
+	segments, err = suite.coordinator.GetSegments(ctx, segmentID, nil, nil, collectionID)
+	suite.NoError(err)
+	suite.Empty(segments)
+	err = suite.coordinator.CleanupSoftDeletedDatabase(ctx, softDeletedDatabaseID)
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	// The hard delete is audited under the tenant of the database.
+	auditEvents, err := suite.coordinator.ListAuditEvents(ctx, &model.ListAuditEvents{TenantID: suite.tenantName, Limit: 1000})
+	suite.NoError(err)
+	purged := 0
+	for _, auditEvent := range auditEvents {
+		if auditEvent.Operation == model.AuditOperationPurgeDatabase {
+			suite.Equal(softDeletedDatabaseID, auditEvent.DatabaseID)
+			purged++
+		}
+	}
+	suite.Equal(1, purged)
+
+	err = dao.CleanUpTestDatabase(suite.db, suite.tenantName, softDeleteDatabase)
+	suite.NoError(err)
+}
+
+func (suite *APIsTestSuite) TestSuspendResumeDeleteTenant() {
+	ctx := context.Background()
+	tenantName := "tenant_lifecycle_" + suite.T().Name()
+	databaseName := "database_lifecycle_" + suite.T().Name()
+	_, err := dao.CreateTestTenantAndDatabase(suite.db, tenantName, databaseName)
+	suite.NoError(err)
+
+	collectionID := types.NewUniqueID()
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           collectionID,
+		Name:         "collection_lifecycle",
+		TenantID:     tenantName,
+		DatabaseName: databaseName,
+	})
+	suite.NoError(err)
+
+	err = suite.coordinator.SuspendTenant(ctx, "tenant_does_not_exist")
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+
+	// A suspended tenant can be read but not written to.
+	err = suite.coordinator.SuspendTenant(ctx, tenantName)
+	suite.NoError(err)
+	_, err = suite.coordinator.GetTenant(ctx, &model.GetTenant{Name: tenantName})
+	suite.NoError(err)
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "collection_lifecycle_suspended",
+		TenantID:     tenantName,
+		DatabaseName: databaseName,
+	})
+	suite.ErrorIs(err, common.ErrTenantSuspended)
+	newName := "collection_lifecycle_renamed"
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:   collectionID,
+		Name: &newName,
+	})
+	suite.ErrorIs(err, common.ErrTenantSuspended)
+	_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       collectionID,
+		TenantID:                 tenantName,
+		LogPosition:              10,
+		CurrentCollectionVersion: 0,
+	})
+	suite.ErrorIs(err, common.ErrTenantSuspended)
+
+	err = suite.coordinator.ResumeTenant(ctx, tenantName)
+	suite.NoError(err)
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:   collectionID,
+		Name: &newName,
+	})
+	suite.NoError(err)
+
+	// Deleting the tenant hides it along with its databases and collections.
+	err = suite.coordinator.DeleteTenant(ctx, &model.DeleteTenant{Name: tenantName})
+	suite.NoError(err)
+	_, err = suite.coordinator.GetTenant(ctx, &model.GetTenant{Name: tenantName})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+	_, err = suite.coordinator.GetDatabase(ctx, &model.GetDatabase{Name: databaseName, Tenant: tenantName})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+	id := collectionID.String()
+	softDeletedCollections, err := suite.coordinator.GetSoftDeletedCollections(ctx, &id, tenantName, "", 10)
+	suite.NoError(err)
+	suite.Len(softDeletedCollections, 1)
+	err = suite.coordinator.DeleteTenant(ctx, &model.DeleteTenant{Name: tenantName})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+	err = suite.coordinator.SuspendTenant(ctx, tenantName)
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+
+	softDeletedTenants, err := suite.coordinator.GetSoftDeletedTenants(ctx, 100)
+	suite.NoError(err)
+	found := false
+	for _, tenant := range softDeletedTenants {
+		if tenant.Name == tenantName {
+			found = true
+		}
+	}
+	suite.True(found)
+
+	err = suite.coordinator.CleanupSoftDeletedTenant(ctx, tenantName)
+	suite.NoError(err)
+	softDeletedCollections, err = suite.coordinator.GetSoftDeletedCollections(ctx, &id, tenantName, "", 10)
+	suite.NoError(err)
+	suite.Empty(softDeletedCollections)
+
+	// The tenant name can be reused once the tenant is hard deleted.
+	_, err = suite.coordinator.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	err = dao.CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
 func TestAPIsTestSuite(t *testing.T) {
 	testSuite := new(APIsTestSuite)
 	suite.Run(t, testSuite)
 }
+
+func TestAPIsTestSuite_Memdb(t *testing.T) {
+	testSuite := &APIsTestSuite{memdb: true}
+	suite.Run(t, testSuite)
+}
@@ -0,0 +1,50 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+const (
+	AuditOperationCreateTenant       = "create_tenant"
+	AuditOperationDeleteTenant       = "delete_tenant"
+	AuditOperationPurgeTenant        = "purge_tenant"
+	AuditOperationSetTenantSuspended = "set_tenant_suspended"
+	AuditOperationSetTenantQuota     = "set_tenant_quota"
+	AuditOperationUpdateTenant       = "update_tenant"
+	AuditOperationCreateDatabase     = "create_database"
+	AuditOperationDeleteDatabase     = "delete_database"
+	AuditOperationPurgeDatabase      = "purge_database"
+	AuditOperationUpdateDatabase     = "update_database"
+	AuditOperationCreateCollection   = "create_collection"
+	AuditOperationUpdateCollection   = "update_collection"
+	AuditOperationDeleteCollection   = "delete_collection"
+	AuditOperationRestoreCollection  = "restore_collection"
+	AuditOperationForkCollection     = "fork_collection"
+	AuditOperationRollbackCollection = "rollback_collection"
+	AuditOperationFlushCollection    = "flush_collection"
+	AuditOperationCreateSegment      = "create_segment"
+	AuditOperationUpdateSegment      = "update_segment"
+)
+
+// AuditEvent is a change to the catalog, with who made it. Before and After
+// are the JSON of the object changed, nil when it did not exist.
+type AuditEvent struct {
+	ID           int64
+	Actor        string
+	Operation    string
+	TenantID     string
+	DatabaseID   string
+	CollectionID string
+	Before       *string
+	After        *string
+	CreatedAt    types.Timestamp
+}
+
+// ListAuditEvents selects the audit events created in [Start, End), in
+// creation order, after the event AfterID. Zero bounds and an empty tenant id
+// do not filter.
+type ListAuditEvents struct {
+	TenantID string
+	Start    types.Timestamp
+	End      types.Timestamp
+	AfterID  int64
+	Limit    int32
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
@@ -16,6 +18,8 @@ type Collection struct {
 	LogPosition          int64
 	Version              int32
 	UpdatedAt            types.Timestamp
+	// RowVersion is bumped by every change to the collection row.
+	RowVersion int64
 }
 
 type CreateCollection struct {
@@ -28,6 +32,25 @@ type CreateCollection struct {
 	TenantID             string
 	DatabaseName         string
 	Ts                   types.Timestamp
+	LogPosition          int64
+}
+
+// CollectionCursor is the position of a collection in the (created_at, id)
+// order collections are listed in.
+type CollectionCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+type ListCollections struct {
+	ID           types.UniqueID
+	Name         *string
+	TenantID     string
+	DatabaseName string
+	Where        *CollectionMetadataFilter
+	After        *CollectionCursor
+	Limit        *int32
+	Offset       *int32
 }
 
 type DeleteCollection struct {
@@ -37,15 +60,40 @@ type DeleteCollection struct {
 	Ts           types.Timestamp
 }
 
+type ForkCollection struct {
+	SourceCollectionID   types.UniqueID
+	TargetCollectionID   types.UniqueID
+	TargetCollectionName string
+	TenantID             string
+	DatabaseName         string
+	Ts                   types.Timestamp
+}
+
+type RestoreCollection struct {
+	ID           types.UniqueID
+	Name         *string
+	TenantID     string
+	DatabaseName string
+}
+
 type UpdateCollection struct {
-	ID            types.UniqueID
-	Name          *string
-	Dimension     *int32
-	Metadata      *CollectionMetadata[CollectionMetadataValueType]
-	ResetMetadata bool
-	TenantID      string
-	DatabaseName  string
-	Ts            types.Timestamp
+	ID   types.UniqueID
+	Name *string
+	// ConfigurationJsonStr holds the configuration fields to change.
+	ConfigurationJsonStr *string
+	Dimension            *int32
+	Metadata             *CollectionMetadata[CollectionMetadataValueType]
+	ResetMetadata        bool
+	// MergeMetadata upserts the keys of Metadata instead of replacing the
+	// metadata. Keys with a nil value are deleted.
+	MergeMetadata   bool
+	NewDatabaseName *string
+	// ExpectedRowVersion makes the update fail with a
+	// RowVersionMismatchError when the row version differs.
+	ExpectedRowVersion *int64
+	TenantID           string
+	DatabaseName       string
+	Ts                 types.Timestamp
 }
 
 type FlushCollectionCompaction struct {
@@ -56,6 +104,16 @@ type FlushCollectionCompaction struct {
 	FlushSegmentCompactions  []*FlushSegmentCompaction
 }
 
+// CollectionVersion is a snapshot of a collection recorded at every version:
+// its log position and the file paths of each segment, keyed by segment id.
+type CollectionVersion struct {
+	CollectionID     types.UniqueID
+	Version          int32
+	LogPosition      int64
+	SegmentFilePaths map[string]map[string][]string
+	CreatedAt        types.Timestamp
+}
+
 type FlushCollectionInfo struct {
 	ID                       string
 	CollectionVersion        int32
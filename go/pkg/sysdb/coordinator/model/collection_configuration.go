@@ -0,0 +1,341 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+)
+
+// CollectionConfiguration is the typed form of the configuration JSON of a
+// collection, as written by the clients. It configures at most one vector
+// index, HNSW or SPANN, and optionally the embedding function of the
+// collection. Unset fields take the defaults of the index.
+type CollectionConfiguration struct {
+	HNSW              *HNSWConfiguration              `json:"hnsw_configuration,omitempty"`
+	SPANN             *SPANNConfiguration             `json:"spann_configuration,omitempty"`
+	EmbeddingFunction *EmbeddingFunctionConfiguration `json:"embedding_function,omitempty"`
+	Type              string                          `json:"_type,omitempty"`
+}
+
+type HNSWConfiguration struct {
+	Space          *string  `json:"space,omitempty"`
+	EFConstruction *int     `json:"ef_construction,omitempty"`
+	EFSearch       *int     `json:"ef_search,omitempty"`
+	NumThreads     *int     `json:"num_threads,omitempty"`
+	M              *int     `json:"M,omitempty"`
+	ResizeFactor   *float64 `json:"resize_factor,omitempty"`
+	BatchSize      *int     `json:"batch_size,omitempty"`
+	SyncThreshold  *int     `json:"sync_threshold,omitempty"`
+	Type           string   `json:"_type,omitempty"`
+}
+
+type SPANNConfiguration struct {
+	Space                 *string `json:"space,omitempty"`
+	SearchNprobe          *int    `json:"search_nprobe,omitempty"`
+	WriteNprobe           *int    `json:"write_nprobe,omitempty"`
+	EFConstruction        *int    `json:"ef_construction,omitempty"`
+	EFSearch              *int    `json:"ef_search,omitempty"`
+	MaxNeighbors          *int    `json:"max_neighbors,omitempty"`
+	ReassignNeighborCount *int    `json:"reassign_neighbor_count,omitempty"`
+	SplitThreshold        *int    `json:"split_threshold,omitempty"`
+	MergeThreshold        *int    `json:"merge_threshold,omitempty"`
+	Type                  string  `json:"_type,omitempty"`
+}
+
+// EmbeddingFunctionConfiguration describes the embedding function of a
+// collection. A "known" embedding function is one the clients can build from
+// its name and config, a "legacy" one is not persisted by the clients.
+type EmbeddingFunctionConfiguration struct {
+	Type   string                 `json:"type"`
+	Name   string                 `json:"name,omitempty"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+const (
+	EmbeddingFunctionTypeKnown  = "known"
+	EmbeddingFunctionTypeLegacy = "legacy"
+)
+
+var distanceFunctions = []string{"l2", "ip", "cosine"}
+
+func invalidConfiguration(field string, description string) error {
+	return &common.InvalidCollectionConfigurationError{Field: field, Description: description}
+}
+
+// ParseCollectionConfiguration parses the configuration JSON of a collection.
+// Fields that are not part of the configuration are rejected, so that a
+// misspelled parameter is not silently ignored. An empty string is no
+// configuration and parses to nil.
+func ParseCollectionConfiguration(configurationJsonStr string) (*CollectionConfiguration, error) {
+	if configurationJsonStr == "" {
+		return nil, nil
+	}
+	if err := checkConfigurationFields("", []byte(configurationJsonStr), reflect.TypeOf(CollectionConfiguration{})); err != nil {
+		return nil, err
+	}
+	configuration := &CollectionConfiguration{}
+	if err := json.Unmarshal([]byte(configurationJsonStr), configuration); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, invalidConfiguration(typeErr.Field, "must be a JSON "+typeErr.Type.String())
+		}
+		return nil, invalidConfiguration("", err.Error())
+	}
+	return configuration, nil
+}
+
+// checkConfigurationFields reports the first field of the JSON object data
+// that the struct type t does not declare, descending into the nested
+// configurations.
+func checkConfigurationFields(path string, data []byte, t reflect.Type) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		if path == "" {
+			return invalidConfiguration("", err.Error())
+		}
+		return invalidConfiguration(path, "must be a JSON object")
+	}
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		fields[name] = t.Field(i).Type
+	}
+	for name, value := range object {
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		fieldType, ok := fields[name]
+		if !ok {
+			return invalidConfiguration(fieldPath, "unknown field")
+		}
+		if fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Struct {
+			if err := checkConfigurationFields(fieldPath, value, fieldType.Elem()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *CollectionConfiguration) String() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// Validate checks the values of the configuration.
+func (c *CollectionConfiguration) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.HNSW != nil && c.SPANN != nil {
+		return invalidConfiguration("spann_configuration", "can not be set along with hnsw_configuration")
+	}
+	if err := c.HNSW.validate(); err != nil {
+		return err
+	}
+	if err := c.SPANN.validate(); err != nil {
+		return err
+	}
+	return c.EmbeddingFunction.validate()
+}
+
+func validateSpace(field string, space *string) error {
+	if space == nil {
+		return nil
+	}
+	for _, distanceFunction := range distanceFunctions {
+		if *space == distanceFunction {
+			return nil
+		}
+	}
+	return invalidConfiguration(field, "must be one of "+strings.Join(distanceFunctions, ", "))
+}
+
+// intField is an integer field of a configuration, named by its JSON path.
+type intField struct {
+	path  string
+	value *int
+}
+
+func validatePositive(fields ...intField) error {
+	for _, field := range fields {
+		if field.value != nil && *field.value < 1 {
+			return invalidConfiguration(field.path, "must be at least 1")
+		}
+	}
+	return nil
+}
+
+func (h *HNSWConfiguration) validate() error {
+	if h == nil {
+		return nil
+	}
+	if err := validateSpace("hnsw_configuration.space", h.Space); err != nil {
+		return err
+	}
+	if err := validatePositive(
+		intField{"hnsw_configuration.ef_construction", h.EFConstruction},
+		intField{"hnsw_configuration.ef_search", h.EFSearch},
+		intField{"hnsw_configuration.num_threads", h.NumThreads},
+		intField{"hnsw_configuration.M", h.M},
+		intField{"hnsw_configuration.batch_size", h.BatchSize},
+		intField{"hnsw_configuration.sync_threshold", h.SyncThreshold},
+	); err != nil {
+		return err
+	}
+	if h.ResizeFactor != nil && *h.ResizeFactor < 1 {
+		return invalidConfiguration("hnsw_configuration.resize_factor", "must be at least 1")
+	}
+	if h.BatchSize != nil && h.SyncThreshold != nil && *h.BatchSize > *h.SyncThreshold {
+		return invalidConfiguration("hnsw_configuration.batch_size", "must be less than or equal to sync_threshold")
+	}
+	return nil
+}
+
+func (s *SPANNConfiguration) validate() error {
+	if s == nil {
+		return nil
+	}
+	if err := validateSpace("spann_configuration.space", s.Space); err != nil {
+		return err
+	}
+	if err := validatePositive(
+		intField{"spann_configuration.search_nprobe", s.SearchNprobe},
+		intField{"spann_configuration.write_nprobe", s.WriteNprobe},
+		intField{"spann_configuration.ef_construction", s.EFConstruction},
+		intField{"spann_configuration.ef_search", s.EFSearch},
+		intField{"spann_configuration.max_neighbors", s.MaxNeighbors},
+		intField{"spann_configuration.reassign_neighbor_count", s.ReassignNeighborCount},
+		intField{"spann_configuration.split_threshold", s.SplitThreshold},
+		intField{"spann_configuration.merge_threshold", s.MergeThreshold},
+	); err != nil {
+		return err
+	}
+	if s.MergeThreshold != nil && s.SplitThreshold != nil && *s.MergeThreshold >= *s.SplitThreshold {
+		return invalidConfiguration("spann_configuration.merge_threshold", "must be less than split_threshold")
+	}
+	return nil
+}
+
+func (e *EmbeddingFunctionConfiguration) validate() error {
+	if e == nil {
+		return nil
+	}
+	switch e.Type {
+	case EmbeddingFunctionTypeKnown:
+		if e.Name == "" {
+			return invalidConfiguration("embedding_function.name", "is required for a known embedding function")
+		}
+	case EmbeddingFunctionTypeLegacy:
+	default:
+		return invalidConfiguration("embedding_function.type", "must be one of "+EmbeddingFunctionTypeKnown+", "+EmbeddingFunctionTypeLegacy)
+	}
+	return nil
+}
+
+// Update returns the configuration with the fields set in update applied. The
+// index parameters that the built index depends on are immutable: update may
+// only repeat their current value. Changing the space is a violation of the
+// invariants of the collection rather than an invalid configuration and fails
+// with ErrCollectionSpaceImmutable. The search parameters and the embedding
+// function can be changed. The result is not validated.
+func (c *CollectionConfiguration) Update(update *CollectionConfiguration) (*CollectionConfiguration, error) {
+	updated := &CollectionConfiguration{}
+	if c != nil {
+		*updated = *c
+	}
+	if update == nil {
+		return updated, nil
+	}
+	var err error
+	if update.HNSW != nil {
+		if updated.HNSW, err = updated.HNSW.update(update.HNSW); err != nil {
+			return nil, err
+		}
+	}
+	if update.SPANN != nil {
+		if updated.SPANN, err = updated.SPANN.update(update.SPANN); err != nil {
+			return nil, err
+		}
+	}
+	if update.EmbeddingFunction != nil {
+		updated.EmbeddingFunction = update.EmbeddingFunction
+	}
+	if update.Type != "" {
+		updated.Type = update.Type
+	}
+	return updated, nil
+}
+
+func updateImmutable[T comparable](field string, current *T, update *T) error {
+	if update == nil || (current != nil && *current == *update) {
+		return nil
+	}
+	return invalidConfiguration(field, "is immutable")
+}
+
+func updateMutable[T any](current **T, update *T) {
+	if update != nil {
+		*current = update
+	}
+}
+
+func (h *HNSWConfiguration) update(update *HNSWConfiguration) (*HNSWConfiguration, error) {
+	if h == nil {
+		h = &HNSWConfiguration{}
+	}
+	updated := *h
+	if update.Space != nil && (h.Space == nil || *h.Space != *update.Space) {
+		return nil, common.ErrCollectionSpaceImmutable
+	}
+	for _, err := range []error{
+		updateImmutable("hnsw_configuration.ef_construction", h.EFConstruction, update.EFConstruction),
+		updateImmutable("hnsw_configuration.M", h.M, update.M),
+		updateImmutable("hnsw_configuration.resize_factor", h.ResizeFactor, update.ResizeFactor),
+		updateImmutable("hnsw_configuration.batch_size", h.BatchSize, update.BatchSize),
+		updateImmutable("hnsw_configuration.sync_threshold", h.SyncThreshold, update.SyncThreshold),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	updateMutable(&updated.EFSearch, update.EFSearch)
+	updateMutable(&updated.NumThreads, update.NumThreads)
+	return &updated, nil
+}
+
+func (s *SPANNConfiguration) update(update *SPANNConfiguration) (*SPANNConfiguration, error) {
+	if s == nil {
+		s = &SPANNConfiguration{}
+	}
+	updated := *s
+	if update.Space != nil && (s.Space == nil || *s.Space != *update.Space) {
+		return nil, common.ErrCollectionSpaceImmutable
+	}
+	for _, err := range []error{
+		updateImmutable("spann_configuration.write_nprobe", s.WriteNprobe, update.WriteNprobe),
+		updateImmutable("spann_configuration.ef_construction", s.EFConstruction, update.EFConstruction),
+		updateImmutable("spann_configuration.max_neighbors", s.MaxNeighbors, update.MaxNeighbors),
+		updateImmutable("spann_configuration.reassign_neighbor_count", s.ReassignNeighborCount, update.ReassignNeighborCount),
+		updateImmutable("spann_configuration.split_threshold", s.SplitThreshold, update.SplitThreshold),
+		updateImmutable("spann_configuration.merge_threshold", s.MergeThreshold, update.MergeThreshold),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	updateMutable(&updated.SearchNprobe, update.SearchNprobe)
+	updateMutable(&updated.EFSearch, update.EFSearch)
+	return &updated, nil
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/chroma-core/chroma/go/pkg/common"
+
 type CollectionMetadataValueType interface {
 	IsCollectionMetadataValueType()
 	Equals(other CollectionMetadataValueType) bool
@@ -103,3 +105,94 @@ func (m *CollectionMetadata[T]) Equals(other *CollectionMetadata[T]) bool {
 	}
 	return true
 }
+
+type CollectionMetadataFilterOperator int
+
+const (
+	CollectionMetadataFilterEq CollectionMetadataFilterOperator = iota
+	CollectionMetadataFilterNe
+	CollectionMetadataFilterGt
+	CollectionMetadataFilterGte
+	CollectionMetadataFilterLt
+	CollectionMetadataFilterLte
+	CollectionMetadataFilterIn
+	CollectionMetadataFilterNin
+	CollectionMetadataFilterAnd
+	CollectionMetadataFilterOr
+)
+
+// CollectionMetadataFilter is a tree of conditions on collection metadata.
+// A leaf compares the value of Key to Values, which holds a single value
+// unless the operator is In or Nin. And and Or combine Children.
+//
+// As for record metadata, Ne and Nin also match collections without the key,
+// and int and float values compare with each other.
+type CollectionMetadataFilter struct {
+	Operator CollectionMetadataFilterOperator
+	Key      string
+	Values   []CollectionMetadataValueType
+	Children []*CollectionMetadataFilter
+}
+
+// Validate checks that leaves have a key and values of a single type (ints
+// and floats may be mixed), that only numbers are compared for order, and
+// that And and Or have children.
+func (f *CollectionMetadataFilter) Validate() error {
+	switch f.Operator {
+	case CollectionMetadataFilterAnd, CollectionMetadataFilterOr:
+		if len(f.Children) == 0 {
+			return common.ErrInvalidMetadataFilter
+		}
+		for _, child := range f.Children {
+			if child == nil {
+				return common.ErrInvalidMetadataFilter
+			}
+			if err := child.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case CollectionMetadataFilterIn, CollectionMetadataFilterNin:
+		if len(f.Values) == 0 {
+			return common.ErrInvalidMetadataFilter
+		}
+	case CollectionMetadataFilterEq, CollectionMetadataFilterNe, CollectionMetadataFilterGt, CollectionMetadataFilterGte, CollectionMetadataFilterLt, CollectionMetadataFilterLte:
+		if len(f.Values) != 1 {
+			return common.ErrInvalidMetadataFilter
+		}
+	default:
+		return common.ErrInvalidMetadataFilter
+	}
+	if f.Key == "" {
+		return common.ErrInvalidMetadataFilter
+	}
+	kind := metadataFilterValueKind(f.Values[0])
+	if kind == "" {
+		return common.ErrInvalidMetadataFilter
+	}
+	for _, value := range f.Values[1:] {
+		if metadataFilterValueKind(value) != kind {
+			return common.ErrInvalidMetadataFilter
+		}
+	}
+	switch f.Operator {
+	case CollectionMetadataFilterGt, CollectionMetadataFilterGte, CollectionMetadataFilterLt, CollectionMetadataFilterLte:
+		if kind != "number" {
+			return common.ErrInvalidMetadataFilter
+		}
+	}
+	return nil
+}
+
+// metadataFilterValueKind groups the value types that compare with each other.
+func metadataFilterValueKind(value CollectionMetadataValueType) string {
+	switch value.(type) {
+	case *CollectionMetadataValueStringType:
+		return "string"
+	case *CollectionMetadataValueBoolType:
+		return "bool"
+	case *CollectionMetadataValueInt64Type, *CollectionMetadataValueFloat64Type:
+		return "number"
+	}
+	return ""
+}
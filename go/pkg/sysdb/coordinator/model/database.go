@@ -3,21 +3,49 @@ package model
 import "github.com/chroma-core/chroma/go/pkg/types"
 
 type Database struct {
-	ID     string
-	Name   string
-	Tenant string
-	Ts     types.Timestamp
+	ID        string
+	Name      string
+	Tenant    string
+	Metadata  *CollectionMetadata[CollectionMetadataValueType]
+	Ts        types.Timestamp
+	CreatedAt types.Timestamp
+	UpdatedAt types.Timestamp
 }
 
 type CreateDatabase struct {
+	ID       string
+	Name     string
+	Tenant   string
+	Metadata *CollectionMetadata[CollectionMetadataValueType]
+	Ts       types.Timestamp
+}
+
+// UpdateDatabase changes the metadata of a database the way UpdateCollection
+// changes the metadata of a collection.
+type UpdateDatabase struct {
+	Name          string
+	Tenant        string
+	Metadata      *CollectionMetadata[CollectionMetadataValueType]
+	ResetMetadata bool
+	MergeMetadata bool
+}
+
+type GetDatabase struct {
 	ID     string
 	Name   string
 	Tenant string
 	Ts     types.Timestamp
 }
 
-type GetDatabase struct {
-	ID     string
+type ListDatabases struct {
+	Tenant     string
+	NamePrefix string
+	// Only databases whose name sorts after StartAfter are returned.
+	StartAfter string
+	Limit      int32
+}
+
+type DeleteDatabase struct {
 	Name   string
 	Tenant string
 	Ts     types.Timestamp
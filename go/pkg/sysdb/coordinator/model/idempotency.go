@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// IdempotencyRecord is the response recorded for a request sent with an
+// idempotency key. The response is opaque to the coordinator, and unset while
+// the request is pending. ReservedAt is when the pending request started.
+type IdempotencyRecord struct {
+	Method      string
+	Key         string
+	RequestHash string
+	Response    []byte
+	Pending     bool
+	CreatedAt   time.Time
+	ReservedAt  time.Time
+}
@@ -1,17 +1,37 @@
 package model
 
+import "github.com/chroma-core/chroma/go/pkg/types"
+
 const (
 	NotificationTypeCreateCollection = "create_collection"
+	NotificationTypeUpdateCollection = "update_collection"
 	NotificationTypeDeleteCollection = "delete_collection"
+	NotificationTypeFlushCollection  = "flush_collection"
 )
 
 const (
 	NotificationStatusPending = "pending"
 )
 
+// Notification is a change to a collection. ID is the revision of the
+// change.
 type Notification struct {
 	ID           int64
 	CollectionID string
+	TenantID     string
+	DatabaseName string
 	Type         string
 	Status       string
+	CreatedAt    types.Timestamp
+}
+
+// ListNotifications selects the notifications with a revision in
+// (AfterRevision, UpToRevision]. Empty tenant or database ids do not filter.
+// The database is selected by id, as a soft deleted database is renamed.
+type ListNotifications struct {
+	TenantID      string
+	DatabaseID    string
+	AfterRevision int64
+	UpToRevision  int64
+	Limit         int32
 }
@@ -0,0 +1,47 @@
+package model
+
+// Quota holds the limits on the resources of a tenant. A limit of zero or
+// less is unlimited. MaxMetadataValueSize is in bytes and applies to string
+// values.
+type Quota struct {
+	MaxDatabases                 int32
+	MaxCollectionsPerDatabase    int32
+	MaxMetadataKeysPerCollection int32
+	MaxMetadataValueSize         int32
+	MaxCollectionDimension       int32
+}
+
+// TenantQuota overrides the default quota for a tenant. A nil limit keeps
+// the default.
+type TenantQuota struct {
+	TenantID                     string
+	MaxDatabases                 *int32
+	MaxCollectionsPerDatabase    *int32
+	MaxMetadataKeysPerCollection *int32
+	MaxMetadataValueSize         *int32
+	MaxCollectionDimension       *int32
+}
+
+// Apply returns the quota enforced for the tenant.
+func (q *TenantQuota) Apply(defaults Quota) Quota {
+	quota := defaults
+	if q == nil {
+		return quota
+	}
+	if q.MaxDatabases != nil {
+		quota.MaxDatabases = *q.MaxDatabases
+	}
+	if q.MaxCollectionsPerDatabase != nil {
+		quota.MaxCollectionsPerDatabase = *q.MaxCollectionsPerDatabase
+	}
+	if q.MaxMetadataKeysPerCollection != nil {
+		quota.MaxMetadataKeysPerCollection = *q.MaxMetadataKeysPerCollection
+	}
+	if q.MaxMetadataValueSize != nil {
+		quota.MaxMetadataValueSize = *q.MaxMetadataValueSize
+	}
+	if q.MaxCollectionDimension != nil {
+		quota.MaxCollectionDimension = *q.MaxCollectionDimension
+	}
+	return quota
+}
@@ -12,6 +12,8 @@ type Segment struct {
 	Metadata     *SegmentMetadata[SegmentMetadataValueType]
 	Ts           types.Timestamp
 	FilePaths    map[string][]string
+	// RowVersion is bumped by every change to the segment row.
+	RowVersion int64
 }
 
 type CreateSegment struct {
@@ -21,6 +23,7 @@ type CreateSegment struct {
 	CollectionID types.UniqueID
 	Metadata     *SegmentMetadata[SegmentMetadataValueType]
 	Ts           types.Timestamp
+	FilePaths    map[string][]string
 }
 
 type UpdateSegment struct {
@@ -30,6 +33,9 @@ type UpdateSegment struct {
 	Metadata      *SegmentMetadata[SegmentMetadataValueType]
 	ResetMetadata bool
 	Ts            types.Timestamp
+	// ExpectedRowVersion makes the update fail with a
+	// RowVersionMismatchError when the row version differs.
+	ExpectedRowVersion *int64
 }
 
 type GetSegments struct {
@@ -3,15 +3,40 @@ package model
 import "github.com/chroma-core/chroma/go/pkg/types"
 
 type Tenant struct {
-	Name string
+	Name      string
+	Metadata  *CollectionMetadata[CollectionMetadataValueType]
+	CreatedAt types.Timestamp
+	UpdatedAt types.Timestamp
 }
 
 type CreateTenant struct {
+	Name     string
+	Metadata *CollectionMetadata[CollectionMetadataValueType]
+	Ts       types.Timestamp
+}
+
+// UpdateTenant changes the metadata of a tenant the way UpdateCollection
+// changes the metadata of a collection.
+type UpdateTenant struct {
+	Name          string
+	Metadata      *CollectionMetadata[CollectionMetadataValueType]
+	ResetMetadata bool
+	MergeMetadata bool
+}
+
+type GetTenant struct {
 	Name string
 	Ts   types.Timestamp
 }
 
-type GetTenant struct {
+type ListTenants struct {
+	NamePrefix string
+	// Only tenants whose name sorts after StartAfter are returned.
+	StartAfter string
+	Limit      int32
+}
+
+type DeleteTenant struct {
 	Name string
 	Ts   types.Timestamp
 }
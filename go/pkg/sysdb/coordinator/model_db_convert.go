@@ -24,6 +24,7 @@ func convertCollectionToModel(collectionAndMetadataList []*dbmodel.CollectionAnd
 			Ts:                   collectionAndMetadata.Collection.Ts,
 			LogPosition:          collectionAndMetadata.Collection.LogPosition,
 			Version:              collectionAndMetadata.Collection.Version,
+			RowVersion:           collectionAndMetadata.Collection.RowVersion,
 		}
 		collection.Metadata = convertCollectionMetadataToModel(collectionAndMetadata.CollectionMetadata)
 		collections = append(collections, collection)
@@ -32,6 +33,16 @@ func convertCollectionToModel(collectionAndMetadataList []*dbmodel.CollectionAnd
 	return collections
 }
 
+func convertCollectionVersionToModel(collectionVersion *dbmodel.CollectionVersion) *model.CollectionVersion {
+	return &model.CollectionVersion{
+		CollectionID:     types.MustParse(collectionVersion.CollectionID),
+		Version:          collectionVersion.Version,
+		LogPosition:      collectionVersion.LogPosition,
+		SegmentFilePaths: collectionVersion.SegmentFilePaths,
+		CreatedAt:        types.Timestamp(collectionVersion.CreatedAt.Unix()),
+	}
+}
+
 func convertCollectionMetadataToModel(collectionMetadataList []*dbmodel.CollectionMetadata) *model.CollectionMetadata[model.CollectionMetadataValueType] {
 	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
 	if collectionMetadataList == nil {
@@ -69,6 +80,10 @@ func convertCollectionMetadataToDB(collectionID string, metadata *model.Collecti
 	}
 	dbCollectionMetadataList := make([]*dbmodel.CollectionMetadata, 0, len(metadata.Metadata))
 	for key, value := range metadata.Metadata {
+		if value == nil {
+			// A key without a value is deleted by a metadata merge.
+			continue
+		}
 		keyCopy := key
 		dbCollectionMetadata := &dbmodel.CollectionMetadata{
 			CollectionID: collectionID,
@@ -99,10 +114,11 @@ func convertSegmentToModel(segmentAndMetadataList []*dbmodel.SegmentAndMetadata)
 	segments := make([]*model.Segment, 0, len(segmentAndMetadataList))
 	for _, segmentAndMetadata := range segmentAndMetadataList {
 		segment := &model.Segment{
-			ID:    types.MustParse(segmentAndMetadata.Segment.ID),
-			Type:  segmentAndMetadata.Segment.Type,
-			Scope: segmentAndMetadata.Segment.Scope,
-			Ts:    segmentAndMetadata.Segment.Ts,
+			ID:         types.MustParse(segmentAndMetadata.Segment.ID),
+			Type:       segmentAndMetadata.Segment.Type,
+			Scope:      segmentAndMetadata.Segment.Scope,
+			Ts:         segmentAndMetadata.Segment.Ts,
+			RowVersion: segmentAndMetadata.Segment.RowVersion,
 		}
 		if segmentAndMetadata.Segment.CollectionID != nil {
 			segment.CollectionID = types.MustParse(*segmentAndMetadata.Segment.CollectionID)
@@ -177,14 +193,173 @@ func convertSegmentMetadataToDB(segmentID string, metadata *model.SegmentMetadat
 
 func convertDatabaseToModel(dbDatabase *dbmodel.Database) *model.Database {
 	return &model.Database{
-		ID:     dbDatabase.ID,
-		Name:   dbDatabase.Name,
-		Tenant: dbDatabase.TenantID,
+		ID:        dbDatabase.ID,
+		Name:      dbDatabase.Name,
+		Tenant:    dbDatabase.TenantID,
+		Ts:        dbDatabase.Ts,
+		CreatedAt: types.Timestamp(dbDatabase.CreatedAt.Unix()),
+		UpdatedAt: types.Timestamp(dbDatabase.UpdatedAt.Unix()),
 	}
 }
 
 func convertTenantToModel(dbTenant *dbmodel.Tenant) *model.Tenant {
 	return &model.Tenant{
-		Name: dbTenant.ID,
+		Name:      dbTenant.ID,
+		CreatedAt: types.Timestamp(dbTenant.CreatedAt.Unix()),
+		UpdatedAt: types.Timestamp(dbTenant.UpdatedAt.Unix()),
+	}
+}
+
+func convertIdempotencyKeyToModel(dbIdempotencyKey *dbmodel.IdempotencyKey) *model.IdempotencyRecord {
+	return &model.IdempotencyRecord{
+		Method:      dbIdempotencyKey.Method,
+		Key:         dbIdempotencyKey.Key,
+		RequestHash: dbIdempotencyKey.RequestHash,
+		Response:    dbIdempotencyKey.Response,
+		Pending:     dbIdempotencyKey.Pending,
+		CreatedAt:   dbIdempotencyKey.CreatedAt,
+		ReservedAt:  dbIdempotencyKey.ReservedAt,
+	}
+}
+
+func convertNotificationToModel(dbNotification *dbmodel.Notification) *model.Notification {
+	return &model.Notification{
+		ID:           dbNotification.ID,
+		CollectionID: dbNotification.CollectionID,
+		TenantID:     dbNotification.TenantID,
+		DatabaseName: dbNotification.DatabaseName,
+		Type:         dbNotification.Type,
+		CreatedAt:    types.Timestamp(dbNotification.CreatedAt.Unix()),
+	}
+}
+
+func convertAuditEventToModel(dbAuditEvent *dbmodel.AuditEvent) *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:           dbAuditEvent.ID,
+		Actor:        dbAuditEvent.Actor,
+		Operation:    dbAuditEvent.Operation,
+		TenantID:     dbAuditEvent.TenantID,
+		DatabaseID:   dbAuditEvent.DatabaseID,
+		CollectionID: dbAuditEvent.CollectionID,
+		Before:       dbAuditEvent.Before,
+		After:        dbAuditEvent.After,
+		CreatedAt:    types.Timestamp(dbAuditEvent.CreatedAt.Unix()),
+	}
+}
+
+func convertTenantQuotaToModel(dbTenantQuota *dbmodel.TenantQuota) *model.TenantQuota {
+	if dbTenantQuota == nil {
+		return nil
+	}
+	return &model.TenantQuota{
+		TenantID:                     dbTenantQuota.TenantID,
+		MaxDatabases:                 dbTenantQuota.MaxDatabases,
+		MaxCollectionsPerDatabase:    dbTenantQuota.MaxCollectionsPerDatabase,
+		MaxMetadataKeysPerCollection: dbTenantQuota.MaxMetadataKeysPerCollection,
+		MaxMetadataValueSize:         dbTenantQuota.MaxMetadataValueSize,
+		MaxCollectionDimension:       dbTenantQuota.MaxCollectionDimension,
+	}
+}
+
+func convertTenantMetadataToModel(tenantMetadataList []*dbmodel.TenantMetadata) *model.CollectionMetadata[model.CollectionMetadataValueType] {
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	for _, tenantMetadata := range tenantMetadataList {
+		if value := convertMetadataValueToModel(tenantMetadata.StrValue, tenantMetadata.IntValue, tenantMetadata.FloatValue, tenantMetadata.BoolValue); value != nil {
+			metadata.Add(*tenantMetadata.Key, value)
+		}
+	}
+	if metadata.Empty() {
+		return nil
+	}
+	return metadata
+}
+
+func convertTenantMetadataToDB(tenantID string, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) []*dbmodel.TenantMetadata {
+	if metadata == nil {
+		return nil
+	}
+	dbTenantMetadataList := make([]*dbmodel.TenantMetadata, 0, len(metadata.Metadata))
+	for key, value := range metadata.Metadata {
+		if value == nil {
+			// A key without a value is deleted by a metadata merge.
+			continue
+		}
+		keyCopy := key
+		dbTenantMetadata := &dbmodel.TenantMetadata{
+			TenantID: tenantID,
+			Key:      &keyCopy,
+		}
+		dbTenantMetadata.StrValue, dbTenantMetadata.IntValue, dbTenantMetadata.FloatValue, dbTenantMetadata.BoolValue = convertMetadataValueToDB(value)
+		dbTenantMetadataList = append(dbTenantMetadataList, dbTenantMetadata)
+	}
+	return dbTenantMetadataList
+}
+
+func convertDatabaseMetadataToModel(databaseMetadataList []*dbmodel.DatabaseMetadata) *model.CollectionMetadata[model.CollectionMetadataValueType] {
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	for _, databaseMetadata := range databaseMetadataList {
+		if value := convertMetadataValueToModel(databaseMetadata.StrValue, databaseMetadata.IntValue, databaseMetadata.FloatValue, databaseMetadata.BoolValue); value != nil {
+			metadata.Add(*databaseMetadata.Key, value)
+		}
+	}
+	if metadata.Empty() {
+		return nil
+	}
+	return metadata
+}
+
+func convertDatabaseMetadataToDB(databaseID string, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) []*dbmodel.DatabaseMetadata {
+	if metadata == nil {
+		return nil
+	}
+	dbDatabaseMetadataList := make([]*dbmodel.DatabaseMetadata, 0, len(metadata.Metadata))
+	for key, value := range metadata.Metadata {
+		if value == nil {
+			// A key without a value is deleted by a metadata merge.
+			continue
+		}
+		keyCopy := key
+		dbDatabaseMetadata := &dbmodel.DatabaseMetadata{
+			DatabaseID: databaseID,
+			Key:        &keyCopy,
+		}
+		dbDatabaseMetadata.StrValue, dbDatabaseMetadata.IntValue, dbDatabaseMetadata.FloatValue, dbDatabaseMetadata.BoolValue = convertMetadataValueToDB(value)
+		dbDatabaseMetadataList = append(dbDatabaseMetadataList, dbDatabaseMetadata)
+	}
+	return dbDatabaseMetadataList
+}
+
+// convertMetadataValueToModel reads a metadata value stored in the columns of
+// a tenant or database metadata row, nil if none is set.
+func convertMetadataValueToModel(strValue *string, intValue *int64, floatValue *float64, boolValue *bool) model.CollectionMetadataValueType {
+	switch {
+	case boolValue != nil:
+		return &model.CollectionMetadataValueBoolType{Value: *boolValue}
+	case strValue != nil:
+		return &model.CollectionMetadataValueStringType{Value: *strValue}
+	case intValue != nil:
+		return &model.CollectionMetadataValueInt64Type{Value: *intValue}
+	case floatValue != nil:
+		return &model.CollectionMetadataValueFloat64Type{Value: *floatValue}
+	default:
+		return nil
+	}
+}
+
+// convertMetadataValueToDB splits a metadata value into the columns of a
+// tenant or database metadata row.
+func convertMetadataValueToDB(value model.CollectionMetadataValueType) (strValue *string, intValue *int64, floatValue *float64, boolValue *bool) {
+	switch v := value.(type) {
+	case *model.CollectionMetadataValueBoolType:
+		boolValue = &v.Value
+	case *model.CollectionMetadataValueStringType:
+		strValue = &v.Value
+	case *model.CollectionMetadataValueInt64Type:
+		intValue = &v.Value
+	case *model.CollectionMetadataValueFloat64Type:
+		floatValue = &v.Value
+	default:
+		log.Error("unknown metadata type", zap.Any("value", v))
 	}
+	return
 }
@@ -0,0 +1,169 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+// SetDefaultQuota sets the quota of the tenants that have none of their own.
+func (tc *Catalog) SetDefaultQuota(quota model.Quota) {
+	tc.defaultQuota = quota
+}
+
+// GetTenantQuota returns the quota set for a tenant, nil if it has none, and
+// the quota enforced for it.
+func (tc *Catalog) GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, model.Quota, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(tenantID)
+	if err != nil {
+		return nil, model.Quota{}, err
+	}
+	if len(tenants) == 0 {
+		return nil, model.Quota{}, common.ErrTenantNotFound
+	}
+	tenantQuota, err := tc.metaDomain.TenantQuotaDb(ctx).Get(tenantID)
+	if err != nil {
+		return nil, model.Quota{}, err
+	}
+	result := convertTenantQuotaToModel(tenantQuota)
+	return result, result.Apply(tc.defaultQuota), nil
+}
+
+// SetTenantQuota replaces the quota of a tenant and returns the quota now
+// enforced for it. Resources already over a lowered limit are kept, only
+// later changes are refused.
+func (tc *Catalog) SetTenantQuota(ctx context.Context, tenantQuota *model.TenantQuota) (model.Quota, error) {
+	var result model.Quota
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantQuota.TenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		existing, err := tc.metaDomain.TenantQuotaDb(txCtx).Get(tenantQuota.TenantID)
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.TenantQuotaDb(txCtx).Upsert(&dbmodel.TenantQuota{
+			TenantID:                     tenantQuota.TenantID,
+			MaxDatabases:                 tenantQuota.MaxDatabases,
+			MaxCollectionsPerDatabase:    tenantQuota.MaxCollectionsPerDatabase,
+			MaxMetadataKeysPerCollection: tenantQuota.MaxMetadataKeysPerCollection,
+			MaxMetadataValueSize:         tenantQuota.MaxMetadataValueSize,
+			MaxCollectionDimension:       tenantQuota.MaxCollectionDimension,
+		})
+		if err != nil {
+			return err
+		}
+		result = tenantQuota.Apply(tc.defaultQuota)
+		return tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation: model.AuditOperationSetTenantQuota,
+			TenantID:  tenantQuota.TenantID,
+		}, convertTenantQuotaToModel(existing), tenantQuota)
+	})
+	if err != nil {
+		return model.Quota{}, err
+	}
+	return result, nil
+}
+
+// getQuota returns the quota enforced for a tenant.
+func (tc *Catalog) getQuota(txCtx context.Context, tenantID string) (model.Quota, error) {
+	tenantQuota, err := tc.metaDomain.TenantQuotaDb(txCtx).Get(tenantID)
+	if err != nil {
+		return model.Quota{}, err
+	}
+	return convertTenantQuotaToModel(tenantQuota).Apply(tc.defaultQuota), nil
+}
+
+// checkQuota returns a QuotaExceededError if requested is over limit.
+func checkQuota(tenantID string, quota string, limit int32, requested int64) error {
+	if limit > 0 && requested > int64(limit) {
+		return &common.QuotaExceededError{
+			TenantID:  tenantID,
+			Quota:     quota,
+			Limit:     int64(limit),
+			Requested: requested,
+		}
+	}
+	return nil
+}
+
+// checkCollectionQuota checks the dimension and the metadata values given to
+// a collection. The number of metadata keys depends on how the metadata is
+// applied and is checked by the caller.
+func checkCollectionQuota(tenantID string, quota model.Quota, dimension *int32, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) error {
+	if dimension != nil {
+		if err := checkQuota(tenantID, common.QuotaMaxCollectionDimension, quota.MaxCollectionDimension, int64(*dimension)); err != nil {
+			return err
+		}
+	}
+	if metadata == nil {
+		return nil
+	}
+	for _, value := range metadata.Metadata {
+		if s, ok := value.(*model.CollectionMetadataValueStringType); ok {
+			if err := checkQuota(tenantID, common.QuotaMaxMetadataValueSize, quota.MaxMetadataValueSize, int64(len(s.Value))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lockTenant locks the row of a tenant until the transaction ends, so that
+// concurrent changes do not both fit in a quota counted before either commits.
+func (tc *Catalog) lockTenant(txCtx context.Context, tenantID string) error {
+	_, err := tc.metaDomain.TenantDb(txCtx).GetTenantsForUpdate(tenantID)
+	return err
+}
+
+// checkCollectionCountQuota checks that one more collection fits in a
+// database of a tenant.
+func (tc *Catalog) checkCollectionCountQuota(txCtx context.Context, tenantID string, databaseName string, quota model.Quota) error {
+	if err := tc.lockTenant(txCtx, tenantID); err != nil {
+		return err
+	}
+	collectionCount, err := tc.metaDomain.CollectionDb(txCtx).CountCollections(tenantID, &databaseName)
+	if err != nil {
+		return err
+	}
+	return checkQuota(tenantID, common.QuotaMaxCollectionsPerDatabase, quota.MaxCollectionsPerDatabase, int64(collectionCount)+1)
+}
+
+// checkDatabaseCountQuota checks that one more database fits in a tenant.
+func (tc *Catalog) checkDatabaseCountQuota(txCtx context.Context, tenantID string, quota model.Quota) error {
+	if err := tc.lockTenant(txCtx, tenantID); err != nil {
+		return err
+	}
+	databaseCount, err := tc.metaDomain.DatabaseDb(txCtx).CountDatabases(tenantID)
+	if err != nil {
+		return err
+	}
+	return checkQuota(tenantID, common.QuotaMaxDatabases, quota.MaxDatabases, int64(databaseCount)+1)
+}
+
+// checkCreateCollectionQuota checks that createCollection fits in the quota of
+// its tenant. It is only called once the collection is known to be new.
+func (tc *Catalog) checkCreateCollectionQuota(txCtx context.Context, createCollection *model.CreateCollection) error {
+	tenantID := createCollection.TenantID
+	quota, err := tc.getQuota(txCtx, tenantID)
+	if err != nil {
+		return err
+	}
+	err = tc.checkCollectionCountQuota(txCtx, tenantID, createCollection.DatabaseName, quota)
+	if err != nil {
+		return err
+	}
+	if createCollection.Metadata != nil {
+		err = checkQuota(tenantID, common.QuotaMaxMetadataKeysPerCollection, quota.MaxMetadataKeysPerCollection, int64(len(createCollection.Metadata.Metadata)))
+		if err != nil {
+			return err
+		}
+	}
+	return checkCollectionQuota(tenantID, quota, createCollection.Dimension, createCollection.Metadata)
+}
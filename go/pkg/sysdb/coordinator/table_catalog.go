@@ -7,6 +7,7 @@ import (
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/logservice"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/chroma-core/chroma/go/shared/otel"
@@ -16,8 +17,14 @@ import (
 
 // The catalog backed by databases using GORM.
 type Catalog struct {
-	metaDomain dbmodel.IMetaDomain
-	txImpl     dbmodel.ITransaction
+	metaDomain   dbmodel.IMetaDomain
+	txImpl       dbmodel.ITransaction
+	defaultQuota model.Quota
+	// cache is nil when the catalog cache is disabled.
+	cache *catalogCache
+	// logService is nil when the catalog has no log service, collections can
+	// not be forked then.
+	logService logservice.ILogService
 }
 
 func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain) *Catalog {
@@ -27,7 +34,14 @@ func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain
 	}
 }
 
+// SetLogService sets the log service the records of forked collections are
+// copied through.
+func (tc *Catalog) SetLogService(logService logservice.ILogService) {
+	tc.logService = logService
+}
+
 func (tc *Catalog) ResetState(ctx context.Context) error {
+	defer tc.cache.invalidateAll()
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
 		err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteAll()
 		if err != nil {
@@ -44,6 +58,46 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 			log.Error("error reset segment metadata db", zap.Error(err))
 			return err
 		}
+		err = tc.metaDomain.FileReferenceDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset file reference db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionVersionDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection version db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.IdempotencyKeyDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset idempotency key db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.NotificationDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset notification db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.AuditEventDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset audit event db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.TenantQuotaDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset tenant quota db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.TenantMetadataDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset tenant metadata db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.DatabaseMetadataDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset database metadata db", zap.Error(err))
+			return err
+		}
 		err = tc.metaDomain.SegmentDb(txCtx).DeleteAll()
 		if err != nil {
 			log.Error("error reset segment db", zap.Error(err))
@@ -105,24 +159,46 @@ func (tc *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.Cre
 	}
 
 	err = tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		quota, err := tc.getQuota(txCtx, createDatabase.Tenant)
+		if err != nil {
+			return err
+		}
+		err = tc.checkDatabaseCountQuota(txCtx, createDatabase.Tenant, quota)
+		if err != nil {
+			return err
+		}
 		dbDatabase := &dbmodel.Database{
 			ID:       createDatabase.ID,
 			Name:     createDatabase.Name,
 			TenantID: createDatabase.Tenant,
 			Ts:       ts,
 		}
-		err := tc.metaDomain.DatabaseDb(txCtx).Insert(dbDatabase)
+		err = tc.metaDomain.DatabaseDb(txCtx).Insert(dbDatabase)
 		if err != nil {
 			log.Error("error inserting database", zap.Error(err))
 			return err
 		}
+		dbDatabaseMetadataList := convertDatabaseMetadataToDB(createDatabase.ID, createDatabase.Metadata)
+		if len(dbDatabaseMetadataList) != 0 {
+			err = tc.metaDomain.DatabaseMetadataDb(txCtx).Insert(dbDatabaseMetadataList)
+			if err != nil {
+				return err
+			}
+		}
 		databaseList, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(createDatabase.Tenant, createDatabase.Name)
 		if err != nil {
 			log.Error("error getting database", zap.Error(err))
 			return err
 		}
-		result = convertDatabaseToModel(databaseList[0])
-		return nil
+		result, err = tc.getDatabaseWithMetadata(txCtx, databaseList[0])
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation:  model.AuditOperationCreateDatabase,
+			TenantID:   result.Tenant,
+			DatabaseID: result.ID,
+		}, nil, result)
 	})
 	if err != nil {
 		log.Error("error creating database", zap.Error(err))
@@ -140,46 +216,66 @@ func (tc *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatab
 	if len(databases) == 0 {
 		return nil, common.ErrDatabaseNotFound
 	}
-	result := make([]*model.Database, 0, len(databases))
-	for _, database := range databases {
-		result = append(result, convertDatabaseToModel(database))
-	}
-	return result[0], nil
+	return tc.getDatabaseWithMetadata(ctx, databases[0])
 }
 
-func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error) {
-	databases, err := tc.metaDomain.DatabaseDb(ctx).GetAllDatabases()
+// getDatabaseWithMetadata converts a database to the model along with its
+// metadata.
+func (tc *Catalog) getDatabaseWithMetadata(ctx context.Context, dbDatabase *dbmodel.Database) (*model.Database, error) {
+	dbDatabaseMetadataList, err := tc.metaDomain.DatabaseMetadataDb(ctx).GetByDatabaseID(dbDatabase.ID)
 	if err != nil {
-		log.Error("error getting all databases", zap.Error(err))
 		return nil, err
 	}
-	result := make([]*model.Database, 0, len(databases))
-	for _, database := range databases {
-		result = append(result, convertDatabaseToModel(database))
-	}
+	result := convertDatabaseToModel(dbDatabase)
+	result.Metadata = convertDatabaseMetadataToModel(dbDatabaseMetadataList)
 	return result, nil
 }
 
-func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error) {
-	var result *model.Tenant
-
+// UpdateDatabase changes the metadata of a database.
+func (tc *Catalog) UpdateDatabase(ctx context.Context, updateDatabase *model.UpdateDatabase) (*model.Database, error) {
+	defer tc.cache.invalidateAll()
+	var result *model.Database
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// TODO: createTenant has ts, don't need to pass in
-		dbTenant := &dbmodel.Tenant{
-			ID:                 createTenant.Name,
-			Ts:                 ts,
-			LastCompactionTime: time.Now().Unix(),
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(updateDatabase.Tenant, updateDatabase.Name)
+		if err != nil {
+			return err
 		}
-		err := tc.metaDomain.TenantDb(txCtx).Insert(dbTenant)
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
+		}
+		before, err := tc.getDatabaseWithMetadata(txCtx, databases[0])
 		if err != nil {
 			return err
 		}
-		tenantList, err := tc.metaDomain.TenantDb(txCtx).GetTenants(createTenant.Name)
+		databaseID := databases[0].ID
+		metadataDb := tc.metaDomain.DatabaseMetadataDb(txCtx)
+		switch {
+		case updateDatabase.ResetMetadata:
+			if updateDatabase.Metadata != nil || updateDatabase.MergeMetadata {
+				return common.ErrInvalidMetadataUpdate
+			}
+			_, err = metadataDb.DeleteByDatabaseID(databaseID)
+		case updateDatabase.Metadata != nil:
+			if updateDatabase.MergeMetadata {
+				_, err = metadataDb.DeleteByDatabaseIDAndKeys(databaseID, deletedMetadataKeys(updateDatabase.Metadata))
+			} else {
+				_, err = metadataDb.DeleteByDatabaseID(databaseID)
+			}
+			if err != nil {
+				return err
+			}
+			if dbDatabaseMetadataList := convertDatabaseMetadataToDB(databaseID, updateDatabase.Metadata); len(dbDatabaseMetadataList) != 0 {
+				err = metadataDb.Insert(dbDatabaseMetadataList)
+			}
+		}
 		if err != nil {
 			return err
 		}
-		result = convertTenantToModel(tenantList[0])
-		return nil
+		result, err = tc.getDatabaseWithMetadata(txCtx, databases[0])
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, databaseAuditEvent(model.AuditOperationUpdateDatabase, databases[0]), before, result)
 	})
 	if err != nil {
 		return nil, err
@@ -187,404 +283,1768 @@ func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateT
 	return result, nil
 }
 
-func (tc *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error) {
-	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(getTenant.Name)
-	if err != nil {
-		log.Error("error getting tenants", zap.Error(err))
-		return nil, err
-	}
-	if (len(tenants)) == 0 {
-		log.Error("tenant not found", zap.Error(err))
-		return nil, common.ErrTenantNotFound
-	}
-	result := make([]*model.Tenant, 0, len(tenants))
-	for _, tenant := range tenants {
-		result = append(result, convertTenantToModel(tenant))
+// deletedMetadataKeys returns the keys a metadata merge deletes, those
+// without a value.
+func deletedMetadataKeys(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) []string {
+	keys := make([]string, 0)
+	for key, value := range metadata.Metadata {
+		if value == nil {
+			keys = append(keys, key)
+		}
 	}
-	return result[0], nil
+	return keys
 }
 
-func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error) {
-	tenants, err := tc.metaDomain.TenantDb(ctx).GetAllTenants()
+func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error) {
+	databases, err := tc.metaDomain.DatabaseDb(ctx).GetAllDatabases()
 	if err != nil {
-		log.Error("error getting all tenants", zap.Error(err))
+		log.Error("error getting all databases", zap.Error(err))
 		return nil, err
 	}
-	result := make([]*model.Tenant, 0, len(tenants))
-	for _, tenant := range tenants {
-		result = append(result, convertTenantToModel(tenant))
+	result := make([]*model.Database, 0, len(databases))
+	for _, database := range databases {
+		result = append(result, convertDatabaseToModel(database))
 	}
 	return result, nil
 }
 
-func (tc *Catalog) createCollectionImpl(txCtx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, bool, error) {
-	// insert collection
-	databaseName := createCollection.DatabaseName
-	tenantID := createCollection.TenantID
-	databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(tenantID, databaseName)
+func (tc *Catalog) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(listDatabases.Tenant)
 	if err != nil {
-		log.Error("error getting database", zap.Error(err))
-		return nil, false, err
+		log.Error("error getting tenants", zap.Error(err))
+		return nil, err
 	}
-	if len(databases) == 0 {
-		log.Error("database not found", zap.Error(err))
-		return nil, false, common.ErrDatabaseNotFound
+	if len(tenants) == 0 {
+		return nil, common.ErrTenantNotFound
 	}
-
-	collectionName := createCollection.Name
-	existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil)
+	databases, err := tc.metaDomain.DatabaseDb(ctx).ListDatabases(listDatabases.Tenant, listDatabases.NamePrefix, listDatabases.StartAfter, listDatabases.Limit)
 	if err != nil {
-		log.Error("error getting collection", zap.Error(err))
-		return nil, false, err
+		log.Error("error listing databases", zap.Error(err))
+		return nil, err
 	}
-	if len(existing) != 0 {
-		if createCollection.GetOrCreate {
-			collection := convertCollectionToModel(existing)[0]
-			return collection, false, nil
-		} else {
-			return nil, false, common.ErrCollectionUniqueConstraintViolation
+	result := make([]*model.Database, 0, len(databases))
+	for _, database := range databases {
+		result = append(result, convertDatabaseToModel(database))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase, softDelete bool) error {
+	defer tc.cache.invalidateAll()
+	if softDelete {
+		return tc.softDeleteDatabase(ctx, deleteDatabase)
+	}
+	return tc.hardDeleteDatabase(ctx, deleteDatabase)
+}
+
+func (tc *Catalog) hardDeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	log.Info("hard deleting database", zap.Any("deleteDatabase", deleteDatabase))
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(deleteDatabase.Tenant, deleteDatabase.Name)
+		if err != nil {
+			return err
 		}
-	} else {
-		// If collection is soft-deleted, then new collection will throw an error since name should be unique, so we need to rename it.
-		isSoftDeleted, sdCollectionID, err := tc.metaDomain.CollectionDb(txCtx).CheckCollectionIsSoftDeleted(createCollection.Name, tenantID, databaseName)
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
+		}
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollectionsByDatabaseID(databases[0].ID)
 		if err != nil {
-			return nil, false, fmt.Errorf("failed to create collection: %w", err)
+			return err
 		}
-		if isSoftDeleted {
-			log.Info("new collection create request with same name as collection that was soft deleted", zap.Any("collection", createCollection))
-			// Rename the soft deleted collection to a new name with timestamp
-			err = tc.renameSoftDeletedCollection(txCtx, sdCollectionID, createCollection.Name, tenantID, databaseName)
+		for _, collection := range collections {
+			if collection.IsDeleted {
+				continue
+			}
+			err = tc.notifyCollectionChange(txCtx, model.NotificationTypeDeleteCollection, &dbmodel.CollectionAndMetadata{
+				Collection:   collection,
+				TenantID:     databases[0].TenantID,
+				DatabaseName: databases[0].Name,
+			})
 			if err != nil {
-				return nil, false, fmt.Errorf("failed to create collection: %w", err)
+				return err
 			}
 		}
-	}
-
-	dbCollection := &dbmodel.Collection{
-		ID:                   createCollection.ID.String(),
-		Name:                 &createCollection.Name,
-		ConfigurationJsonStr: &createCollection.ConfigurationJsonStr,
-		Dimension:            createCollection.Dimension,
-		DatabaseID:           databases[0].ID,
-		Ts:                   ts,
-		LogPosition:          0,
-	}
+		err = tc.recordAudit(txCtx, databaseAuditEvent(model.AuditOperationDeleteDatabase, databases[0]), convertDatabaseToModel(databases[0]), nil)
+		if err != nil {
+			return err
+		}
+		return tc.hardDeleteDatabaseByID(txCtx, databases[0].ID)
+	})
+}
 
-	err = tc.metaDomain.CollectionDb(txCtx).Insert(dbCollection)
+// hardDeleteDatabaseByID deletes a database and everything it contains: its
+// collections, their metadata, their segments and the segment metadata. It
+// must run inside a transaction.
+func (tc *Catalog) hardDeleteDatabaseByID(txCtx context.Context, databaseID string) error {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollectionsByDatabaseID(databaseID)
 	if err != nil {
-		log.Error("error inserting collection", zap.Error(err))
-		return nil, false, err
+		log.Error("error getting collections during database hard delete", zap.Error(err))
+		return err
 	}
-	// insert collection metadata
-	metadata := createCollection.Metadata
-	dbCollectionMetadataList := convertCollectionMetadataToDB(createCollection.ID.String(), metadata)
-	if len(dbCollectionMetadataList) != 0 {
-		err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
+	for _, collection := range collections {
+		_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collection.ID)
 		if err != nil {
-			return nil, false, err
+			log.Error("error deleting collection metadata during database hard delete", zap.Error(err))
+			return err
+		}
+		err = tc.hardDeleteSegments(txCtx, collection.ID)
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collection.ID)
+		if err != nil {
+			log.Error("error deleting collection during database hard delete", zap.Error(err))
+			return err
 		}
 	}
-	// get collection
-	collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil)
+	_, err = tc.metaDomain.DatabaseMetadataDb(txCtx).DeleteByDatabaseID(databaseID)
 	if err != nil {
-		log.Error("error getting collection", zap.Error(err))
-		return nil, false, err
-	}
-	result := convertCollectionToModel(collectionList)[0]
-	return result, true, nil
-
-}
-
-func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, bool, error) {
-	var result *model.Collection
-	created := false
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		var err error
-		result, created, err = tc.createCollectionImpl(txCtx, createCollection, ts)
+		log.Error("error deleting database metadata during hard delete", zap.Error(err))
 		return err
-	})
-	if err != nil {
-		log.Error("error creating collection", zap.Error(err))
-		return nil, false, err
-	}
-	log.Info("collection created", zap.Any("collection", result))
-	return result, created, nil
-}
-
-func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	tracer := otel.Tracer
-	if tracer != nil {
-		_, span := tracer.Start(ctx, "Catalog.GetCollections")
-		defer span.End()
 	}
-
-	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset)
+	databaseDeletedCount, err := tc.metaDomain.DatabaseDb(txCtx).DeleteByID(databaseID)
 	if err != nil {
-		return nil, err
+		log.Error("error deleting database during hard delete", zap.Error(err))
+		return err
 	}
-	collections := convertCollectionToModel(collectionAndMetadataList)
-	return collections, nil
-}
-
-func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection, softDelete bool) error {
-	if softDelete {
-		return tc.softDeleteCollection(ctx, deleteCollection)
+	if databaseDeletedCount == 0 {
+		return common.ErrDatabaseNotFound
 	}
-	return tc.hardDeleteCollection(ctx, deleteCollection)
+	log.Info("database hard deleted", zap.String("databaseID", databaseID), zap.Int("collectionDeletedCount", len(collections)))
+	return nil
 }
 
-func (tc *Catalog) hardDeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
-	log.Info("hard deleting collection", zap.Any("deleteCollection", deleteCollection), zap.String("databaseName", deleteCollection.DatabaseName))
+// softDeleteDatabase marks the database and its collections as deleted. The
+// database is renamed right away so that its name can be reused, the
+// SoftDeleteCleaner hard deletes it later.
+func (tc *Catalog) softDeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	log.Info("soft deleting database", zap.Any("deleteDatabase", deleteDatabase))
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		collectionID := deleteCollection.ID
-
-		collectionEntry, err := tc.metaDomain.CollectionDb(txCtx).GetCollectionEntry(types.FromUniqueID(collectionID), &deleteCollection.DatabaseName)
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(deleteDatabase.Tenant, deleteDatabase.Name)
 		if err != nil {
 			return err
 		}
-		if collectionEntry == nil {
-			log.Info("collection not found during hard delete", zap.Any("deleteCollection", deleteCollection))
-			return common.ErrCollectionDeleteNonExistingCollection
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
 		}
-
-		// Delete collection and collection metadata.
-		collectionDeletedCount, err := tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collectionID.String())
+		err = tc.recordAudit(txCtx, databaseAuditEvent(model.AuditOperationDeleteDatabase, databases[0]), convertDatabaseToModel(databases[0]), nil)
 		if err != nil {
-			log.Error("error deleting collection during hard delete", zap.Error(err))
 			return err
 		}
-		if collectionDeletedCount == 0 {
-			log.Info("collection not found during hard delete", zap.Any("deleteCollection", deleteCollection))
-			return common.ErrCollectionDeleteNonExistingCollection
+		return tc.softDeleteDatabaseEntry(txCtx, databases[0], deleteDatabase.Ts)
+	})
+}
+
+// softDeleteDatabaseEntry must run inside a transaction.
+func (tc *Catalog) softDeleteDatabaseEntry(txCtx context.Context, database *dbmodel.Database, ts types.Timestamp) error {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollectionsByDatabaseID(database.ID)
+	if err != nil {
+		log.Error("error getting collections during database soft delete", zap.Error(err))
+		return err
+	}
+	for _, collection := range collections {
+		if collection.IsDeleted {
+			continue
 		}
-		// Delete collection metadata.
-		collectionMetadataDeletedCount, err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID.String())
+		err = tc.metaDomain.CollectionDb(txCtx).Update(&dbmodel.Collection{
+			ID:        collection.ID,
+			IsDeleted: true,
+			Ts:        ts,
+		})
 		if err != nil {
-			log.Error("error deleting collection metadata during hard delete", zap.Error(err))
+			log.Error("error soft deleting collection during database soft delete", zap.Error(err))
+			return err
+		}
+		err = tc.notifyCollectionChange(txCtx, model.NotificationTypeDeleteCollection, &dbmodel.CollectionAndMetadata{
+			Collection:   collection,
+			TenantID:     database.TenantID,
+			DatabaseName: database.Name,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tc.metaDomain.DatabaseDb(txCtx).Update(&dbmodel.Database{
+		ID:        database.ID,
+		Name:      fmt.Sprintf("deleted_%s_%d", database.Name, time.Now().Unix()),
+		IsDeleted: true,
+		Ts:        ts,
+	})
+	if err != nil {
+		log.Error("soft delete database failed", zap.Error(err))
+		return fmt.Errorf("database delete failed due to update error: %w", err)
+	}
+	return nil
+}
+
+func (tc *Catalog) GetSoftDeletedDatabases(ctx context.Context, limit int32) ([]*model.Database, error) {
+	databases, err := tc.metaDomain.DatabaseDb(ctx).GetSoftDeletedDatabases(limit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Database, 0, len(databases))
+	for _, dbDatabase := range databases {
+		result = append(result, convertDatabaseToModel(dbDatabase))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) CleanupSoftDeletedDatabase(ctx context.Context, databaseID string) error {
+	defer tc.cache.invalidateAll()
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// Load the database first, the audit event records its tenant.
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabaseByID(databaseID)
+		if err != nil {
+			return err
+		}
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
+		}
+		err = tc.hardDeleteDatabaseByID(txCtx, databaseID)
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, databaseAuditEvent(model.AuditOperationPurgeDatabase, databases[0]), nil, nil)
+	})
+}
+
+func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error) {
+	var result *model.Tenant
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// TODO: createTenant has ts, don't need to pass in
+		dbTenant := &dbmodel.Tenant{
+			ID:                 createTenant.Name,
+			Ts:                 ts,
+			LastCompactionTime: time.Now().Unix(),
+		}
+		err := tc.metaDomain.TenantDb(txCtx).Insert(dbTenant)
+		if err != nil {
+			return err
+		}
+		dbTenantMetadataList := convertTenantMetadataToDB(createTenant.Name, createTenant.Metadata)
+		if len(dbTenantMetadataList) != 0 {
+			err = tc.metaDomain.TenantMetadataDb(txCtx).Insert(dbTenantMetadataList)
+			if err != nil {
+				return err
+			}
+		}
+		tenantList, err := tc.metaDomain.TenantDb(txCtx).GetTenants(createTenant.Name)
+		if err != nil {
+			return err
+		}
+		result, err = tc.getTenantWithMetadata(txCtx, tenantList[0])
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation: model.AuditOperationCreateTenant,
+			TenantID:  result.Name,
+		}, nil, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (tc *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(getTenant.Name)
+	if err != nil {
+		log.Error("error getting tenants", zap.Error(err))
+		return nil, err
+	}
+	if (len(tenants)) == 0 {
+		log.Error("tenant not found", zap.Error(err))
+		return nil, common.ErrTenantNotFound
+	}
+	return tc.getTenantWithMetadata(ctx, tenants[0])
+}
+
+// getTenantWithMetadata converts a tenant to the model along with its
+// metadata.
+func (tc *Catalog) getTenantWithMetadata(ctx context.Context, dbTenant *dbmodel.Tenant) (*model.Tenant, error) {
+	dbTenantMetadataList, err := tc.metaDomain.TenantMetadataDb(ctx).GetByTenantID(dbTenant.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := convertTenantToModel(dbTenant)
+	result.Metadata = convertTenantMetadataToModel(dbTenantMetadataList)
+	return result, nil
+}
+
+// UpdateTenant changes the metadata of a tenant.
+func (tc *Catalog) UpdateTenant(ctx context.Context, updateTenant *model.UpdateTenant) (*model.Tenant, error) {
+	var result *model.Tenant
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(updateTenant.Name)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		before, err := tc.getTenantWithMetadata(txCtx, tenants[0])
+		if err != nil {
+			return err
+		}
+		tenantID := tenants[0].ID
+		metadataDb := tc.metaDomain.TenantMetadataDb(txCtx)
+		switch {
+		case updateTenant.ResetMetadata:
+			if updateTenant.Metadata != nil || updateTenant.MergeMetadata {
+				return common.ErrInvalidMetadataUpdate
+			}
+			_, err = metadataDb.DeleteByTenantID(tenantID)
+		case updateTenant.Metadata != nil:
+			if updateTenant.MergeMetadata {
+				_, err = metadataDb.DeleteByTenantIDAndKeys(tenantID, deletedMetadataKeys(updateTenant.Metadata))
+			} else {
+				_, err = metadataDb.DeleteByTenantID(tenantID)
+			}
+			if err != nil {
+				return err
+			}
+			if dbTenantMetadataList := convertTenantMetadataToDB(tenantID, updateTenant.Metadata); len(dbTenantMetadataList) != 0 {
+				err = metadataDb.Insert(dbTenantMetadataList)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		result, err = tc.getTenantWithMetadata(txCtx, tenants[0])
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation: model.AuditOperationUpdateTenant,
+			TenantID:  tenantID,
+		}, before, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (tc *Catalog) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).ListTenants(listTenants.NamePrefix, listTenants.StartAfter, listTenants.Limit)
+	if err != nil {
+		log.Error("error listing tenants", zap.Error(err))
+		return nil, err
+	}
+	result := make([]*model.Tenant, 0, len(tenants))
+	for _, tenant := range tenants {
+		result = append(result, convertTenantToModel(tenant))
+	}
+	return result, nil
+}
+
+// DeleteTenant soft deletes the tenant with all its databases and collections.
+// The SoftDeleteCleaner hard deletes them later.
+func (tc *Catalog) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	defer tc.cache.invalidateAll()
+	log.Info("soft deleting tenant", zap.Any("deleteTenant", deleteTenant))
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabasesByTenantID(deleteTenant.Name)
+		if err != nil {
+			log.Error("error getting databases during tenant soft delete", zap.Error(err))
+			return err
+		}
+		for _, database := range databases {
+			if database.IsDeleted {
+				continue
+			}
+			err = tc.softDeleteDatabaseEntry(txCtx, database, deleteTenant.Ts)
+			if err != nil {
+				return err
+			}
+		}
+		err = tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation: model.AuditOperationDeleteTenant,
+			TenantID:  deleteTenant.Name,
+		}, convertTenantToModel(tenants[0]), nil)
+		if err != nil {
+			return err
+		}
+		return tc.metaDomain.TenantDb(txCtx).SoftDelete(deleteTenant.Name, deleteTenant.Ts)
+	})
+}
+
+func (tc *Catalog) GetSoftDeletedTenants(ctx context.Context, limit int32) ([]*model.Tenant, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetSoftDeletedTenants(limit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Tenant, 0, len(tenants))
+	for _, dbTenant := range tenants {
+		result = append(result, convertTenantToModel(dbTenant))
+	}
+	return result, nil
+}
+
+// CleanupSoftDeletedTenant hard deletes a soft deleted tenant and everything it contains.
+func (tc *Catalog) CleanupSoftDeletedTenant(ctx context.Context, tenantID string) error {
+	defer tc.cache.invalidateAll()
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabasesByTenantID(tenantID)
+		if err != nil {
+			return err
+		}
+		for _, database := range databases {
+			err = tc.hardDeleteDatabaseByID(txCtx, database.ID)
+			if err != nil {
+				return err
+			}
+		}
+		err = tc.metaDomain.TenantQuotaDb(txCtx).DeleteByTenantID(tenantID)
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.TenantMetadataDb(txCtx).DeleteByTenantID(tenantID)
+		if err != nil {
+			return err
+		}
+		tenantDeletedCount, err := tc.metaDomain.TenantDb(txCtx).DeleteByID(tenantID)
+		if err != nil {
+			log.Error("error deleting tenant during hard delete", zap.Error(err))
+			return err
+		}
+		if tenantDeletedCount == 0 {
+			return common.ErrTenantNotFound
+		}
+		log.Info("tenant hard deleted", zap.String("tenantID", tenantID), zap.Int("databaseDeletedCount", len(databases)))
+		return tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation: model.AuditOperationPurgeTenant,
+			TenantID:  tenantID,
+		}, nil, nil)
+	})
+}
+
+func (tc *Catalog) SetTenantSuspended(ctx context.Context, tenantID string, isSuspended bool) error {
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		err = tc.metaDomain.TenantDb(txCtx).UpdateTenantSuspended(tenantID, isSuspended)
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, &dbmodel.AuditEvent{
+			Operation: model.AuditOperationSetTenantSuspended,
+			TenantID:  tenantID,
+		}, tenantSuspension{IsSuspended: tenants[0].IsSuspended}, tenantSuspension{IsSuspended: isSuspended})
+	})
+}
+
+// checkTenantNotSuspended returns ErrTenantSuspended if the tenant is
+// suspended. A missing tenant is left to the caller to report.
+func (tc *Catalog) checkTenantNotSuspended(txCtx context.Context, tenantID string) error {
+	tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantID)
+	if err != nil {
+		log.Error("error getting tenants", zap.Error(err))
+		return err
+	}
+	if len(tenants) != 0 && tenants[0].IsSuspended {
+		return common.ErrTenantSuspended
+	}
+	return nil
+}
+
+func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetAllTenants()
+	if err != nil {
+		log.Error("error getting all tenants", zap.Error(err))
+		return nil, err
+	}
+	result := make([]*model.Tenant, 0, len(tenants))
+	for _, tenant := range tenants {
+		result = append(result, convertTenantToModel(tenant))
+	}
+	return result, nil
+}
+
+// createCollectionImpl records the creation under auditOperation, as a fork
+// also creates its collection this way.
+func (tc *Catalog) createCollectionImpl(txCtx context.Context, createCollection *model.CreateCollection, ts types.Timestamp, auditOperation string) (*model.Collection, bool, error) {
+	// insert collection
+	databaseName := createCollection.DatabaseName
+	tenantID := createCollection.TenantID
+	if err := tc.checkTenantNotSuspended(txCtx, tenantID); err != nil {
+		return nil, false, err
+	}
+	databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(tenantID, databaseName)
+	if err != nil {
+		log.Error("error getting database", zap.Error(err))
+		return nil, false, err
+	}
+	if len(databases) == 0 {
+		log.Error("database not found", zap.Error(err))
+		return nil, false, common.ErrDatabaseNotFound
+	}
+
+	collectionName := createCollection.Name
+	existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil)
+	if err != nil {
+		log.Error("error getting collection", zap.Error(err))
+		return nil, false, err
+	}
+	if len(existing) != 0 {
+		if createCollection.GetOrCreate {
+			collection := convertCollectionToModel(existing)[0]
+			return collection, false, nil
+		} else {
+			return nil, false, common.ErrCollectionUniqueConstraintViolation
+		}
+	} else {
+		// If collection is soft-deleted, then new collection will throw an error since name should be unique, so we need to rename it.
+		isSoftDeleted, sdCollectionID, err := tc.metaDomain.CollectionDb(txCtx).CheckCollectionIsSoftDeleted(createCollection.Name, tenantID, databaseName)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create collection: %w", err)
+		}
+		if isSoftDeleted {
+			log.Info("new collection create request with same name as collection that was soft deleted", zap.Any("collection", createCollection))
+			// Rename the soft deleted collection to a new name with timestamp
+			err = tc.renameSoftDeletedCollection(txCtx, sdCollectionID, createCollection.Name, tenantID, databaseName)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to create collection: %w", err)
+			}
+		}
+	}
+
+	if err := tc.checkCreateCollectionQuota(txCtx, createCollection); err != nil {
+		return nil, false, err
+	}
+
+	dbCollection := &dbmodel.Collection{
+		ID:                   createCollection.ID.String(),
+		Name:                 &createCollection.Name,
+		ConfigurationJsonStr: &createCollection.ConfigurationJsonStr,
+		Dimension:            createCollection.Dimension,
+		DatabaseID:           databases[0].ID,
+		Ts:                   ts,
+		LogPosition:          createCollection.LogPosition,
+	}
+
+	err = tc.metaDomain.CollectionDb(txCtx).Insert(dbCollection)
+	if err != nil {
+		log.Error("error inserting collection", zap.Error(err))
+		return nil, false, err
+	}
+	// insert collection metadata
+	metadata := createCollection.Metadata
+	dbCollectionMetadataList := convertCollectionMetadataToDB(createCollection.ID.String(), metadata)
+	if len(dbCollectionMetadataList) != 0 {
+		err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	// get collection
+	collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil)
+	if err != nil {
+		log.Error("error getting collection", zap.Error(err))
+		return nil, false, err
+	}
+	err = tc.notifyCollectionChange(txCtx, model.NotificationTypeCreateCollection, collectionList[0])
+	if err != nil {
+		return nil, false, err
+	}
+	result := convertCollectionToModel(collectionList)[0]
+	err = tc.recordAudit(txCtx, collectionAuditEvent(auditOperation, collectionList[0]), nil, result)
+	if err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+
+}
+
+func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, bool, error) {
+	defer tc.cache.invalidateCollection(createCollection.ID.String())
+	var result *model.Collection
+	created := false
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		var err error
+		result, created, err = tc.createCollectionImpl(txCtx, createCollection, ts, model.AuditOperationCreateCollection)
+		return err
+	})
+	if err != nil {
+		log.Error("error creating collection", zap.Error(err))
+		return nil, false, err
+	}
+	log.Info("collection created", zap.Any("collection", result))
+	return result, created, nil
+}
+
+func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
+	tracer := otel.Tracer
+	if tracer != nil {
+		_, span := tracer.Start(ctx, "Catalog.GetCollections")
+		defer span.End()
+	}
+
+	if tc.cache != nil && collectionID != types.NilUniqueID() && collectionName == nil && limit == nil && offset == nil {
+		return tc.getCollectionCached(ctx, collectionID.String(), tenantID, databaseName)
+	}
+	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	collections := convertCollectionToModel(collectionAndMetadataList)
+	return collections, nil
+}
+
+// ListCollections returns a page of collections in (created_at, id) order and,
+// when more collections follow, the cursor to pass as After for the next page.
+func (tc *Catalog) ListCollections(ctx context.Context, listCollections *model.ListCollections) ([]*model.Collection, *model.CollectionCursor, error) {
+	tracer := otel.Tracer
+	if tracer != nil {
+		_, span := tracer.Start(ctx, "Catalog.ListCollections")
+		defer span.End()
+	}
+
+	// A lookup by id returns a single collection, there is no next page.
+	if tc.cache != nil && isCachedCollectionList(listCollections) {
+		collections, err := tc.getCollectionCached(ctx, listCollections.ID.String(), listCollections.TenantID, listCollections.DatabaseName)
+		return collections, nil, err
+	}
+	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).ListCollections(newListCollectionsQuery(listCollections))
+	if err != nil {
+		return nil, nil, err
+	}
+	collectionAndMetadataList, next := collectionPage(collectionAndMetadataList, listCollections.Limit)
+	return convertCollectionToModel(collectionAndMetadataList), next, nil
+}
+
+// ListSoftDeletedCollections returns a page of soft deleted collections the
+// same way ListCollections does for live ones. The UpdatedAt of the returned
+// collections is their deletion time.
+func (tc *Catalog) ListSoftDeletedCollections(ctx context.Context, listCollections *model.ListCollections) ([]*model.Collection, *model.CollectionCursor, error) {
+	tracer := otel.Tracer
+	if tracer != nil {
+		_, span := tracer.Start(ctx, "Catalog.ListSoftDeletedCollections")
+		defer span.End()
+	}
+
+	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).ListSoftDeletedCollections(newListCollectionsQuery(listCollections))
+	if err != nil {
+		return nil, nil, err
+	}
+	collectionAndMetadataList, next := collectionPage(collectionAndMetadataList, listCollections.Limit)
+	collectionList := convertCollectionToModel(collectionAndMetadataList)
+	for i, collection := range collectionList {
+		collection.UpdatedAt = types.Timestamp(collectionAndMetadataList[i].Collection.UpdatedAt.Unix())
+	}
+	return collectionList, next, nil
+}
+
+func newListCollectionsQuery(listCollections *model.ListCollections) *dbmodel.ListCollectionsQuery {
+	query := &dbmodel.ListCollectionsQuery{
+		ID:           types.FromUniqueID(listCollections.ID),
+		Name:         listCollections.Name,
+		TenantID:     listCollections.TenantID,
+		DatabaseName: listCollections.DatabaseName,
+		Where:        listCollections.Where,
+		Offset:       listCollections.Offset,
+	}
+	if listCollections.After != nil {
+		query.After = &dbmodel.CollectionCursor{
+			CreatedAt: listCollections.After.CreatedAt,
+			ID:        listCollections.After.ID,
+		}
+	}
+	if listCollections.Limit != nil {
+		// Fetch one extra row to know whether there is a next page.
+		limit := *listCollections.Limit + 1
+		query.Limit = &limit
+	}
+	return query
+}
+
+// collectionPage trims the extra row fetched by a query built with
+// newListCollectionsQuery and returns the cursor of the next page, if any.
+func collectionPage(collectionAndMetadataList []*dbmodel.CollectionAndMetadata, limit *int32) ([]*dbmodel.CollectionAndMetadata, *model.CollectionCursor) {
+	if limit == nil || len(collectionAndMetadataList) <= int(*limit) {
+		return collectionAndMetadataList, nil
+	}
+	collectionAndMetadataList = collectionAndMetadataList[:*limit]
+	last := len(collectionAndMetadataList) - 1
+	if last < 0 {
+		return collectionAndMetadataList, nil
+	}
+	return collectionAndMetadataList, &model.CollectionCursor{
+		CreatedAt: collectionAndMetadataList[last].Collection.CreatedAt,
+		ID:        collectionAndMetadataList[last].Collection.ID,
+	}
+}
+
+func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection, softDelete bool) error {
+	defer tc.cache.invalidateCollection(deleteCollection.ID.String())
+	if softDelete {
+		return tc.softDeleteCollection(ctx, deleteCollection)
+	}
+	return tc.hardDeleteCollection(ctx, deleteCollection)
+}
+
+func (tc *Catalog) hardDeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	log.Info("hard deleting collection", zap.Any("deleteCollection", deleteCollection), zap.String("databaseName", deleteCollection.DatabaseName))
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collectionID := deleteCollection.ID
+
+		collectionEntry, err := tc.metaDomain.CollectionDb(txCtx).GetCollectionEntry(types.FromUniqueID(collectionID), &deleteCollection.DatabaseName)
+		if err != nil {
+			return err
+		}
+		if collectionEntry == nil {
+			log.Info("collection not found during hard delete", zap.Any("deleteCollection", deleteCollection))
+			return common.ErrCollectionDeleteNonExistingCollection
+		}
+		auditEvent := &dbmodel.AuditEvent{
+			Operation:    model.AuditOperationDeleteCollection,
+			TenantID:     deleteCollection.TenantID,
+			DatabaseID:   collectionEntry.DatabaseID,
+			CollectionID: collectionEntry.ID,
+		}
+		var before *model.Collection
+		// A soft deleted collection already notified its deletion.
+		if !collectionEntry.IsDeleted {
+			collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(collectionID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil)
+			if err != nil {
+				return err
+			}
+			if len(collections) != 0 {
+				err = tc.notifyCollectionChange(txCtx, model.NotificationTypeDeleteCollection, collections[0])
+				if err != nil {
+					return err
+				}
+				auditEvent = collectionAuditEvent(model.AuditOperationDeleteCollection, collections[0])
+				before = convertCollectionToModel(collections)[0]
+			}
+		}
+		err = tc.recordAudit(txCtx, auditEvent, before, nil)
+		if err != nil {
+			return err
+		}
+
+		// Delete collection and collection metadata.
+		collectionDeletedCount, err := tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collectionID.String())
+		if err != nil {
+			log.Error("error deleting collection during hard delete", zap.Error(err))
+			return err
+		}
+		if collectionDeletedCount == 0 {
+			log.Info("collection not found during hard delete", zap.Any("deleteCollection", deleteCollection))
+			return common.ErrCollectionDeleteNonExistingCollection
+		}
+		// Delete collection metadata.
+		collectionMetadataDeletedCount, err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID.String())
+		if err != nil {
+			log.Error("error deleting collection metadata during hard delete", zap.Error(err))
 			return err
 		}
 		// Delete segments.
-		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegmentsByCollectionID(collectionID.String())
+		err = tc.hardDeleteSegments(txCtx, collectionID.String())
+		if err != nil {
+			return err
+		}
+
+		log.Info("collection hard deleted", zap.Any("collection", collectionID),
+			zap.Int("collectionDeletedCount", collectionDeletedCount),
+			zap.Int("collectionMetadataDeletedCount", collectionMetadataDeletedCount))
+		return nil
+	})
+}
+
+// hardDeleteSegments deletes the segments of a collection together with their
+// metadata, and the file references and version history of the collection.
+func (tc *Catalog) hardDeleteSegments(txCtx context.Context, collectionID string) error {
+	segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegmentsByCollectionID(collectionID)
+	if err != nil {
+		log.Error("error getting segments during hard delete", zap.Error(err))
+		return err
+	}
+	for _, segment := range segments {
+		err = tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(segment.ID)
+		if err != nil {
+			log.Error("error deleting segment during hard delete", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segment.ID)
+		if err != nil {
+			log.Error("error deleting segment metadata during hard delete", zap.Error(err))
+			return err
+		}
+	}
+	_, err = tc.metaDomain.FileReferenceDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		log.Error("error deleting file references during hard delete", zap.Error(err))
+		return err
+	}
+	_, err = tc.metaDomain.CollectionVersionDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		log.Error("error deleting collection versions during hard delete", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (tc *Catalog) renameSoftDeletedCollection(ctx context.Context, collectionID string, collectionName string, tenantID string, databaseName string) error {
+	log.Info("Renaming soft deleted collection", zap.String("collectionID", collectionID), zap.String("collectionName", collectionName), zap.Any("tenantID", tenantID), zap.String("databaseName", databaseName))
+	// Generate new name with timestamp
+	newName := fmt.Sprintf("deleted_%s_%d", collectionName, time.Now().Unix())
+
+	dbCollection := &dbmodel.Collection{
+		ID:        collectionID,
+		Name:      &newName,
+		IsDeleted: true,
+	} // Not updating the timestamp or updated_at.
+
+	err := tc.metaDomain.CollectionDb(ctx).Update(dbCollection)
+	if err != nil {
+		log.Error("rename soft deleted collection failed", zap.Error(err))
+		return fmt.Errorf("collection rename failed due to update error: %w", err)
+	}
+	return nil
+}
+
+func (tc *Catalog) softDeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	log.Info("Soft deleting collection", zap.Any("softDeleteCollection", deleteCollection))
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// Check if collection exists
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(deleteCollection.ID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionDeleteNonExistingCollection
+		}
+
+		dbCollection := &dbmodel.Collection{
+			ID:        deleteCollection.ID.String(),
+			IsDeleted: true,
+			Ts:        deleteCollection.Ts,
+			UpdatedAt: time.Now(),
+		}
+		err = tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+		if err != nil {
+			log.Error("soft delete collection failed", zap.Error(err))
+			return fmt.Errorf("collection delete failed due to update error: %w", err)
+		}
+		err = tc.recordAudit(txCtx, collectionAuditEvent(model.AuditOperationDeleteCollection, collections[0]), convertCollectionToModel(collections)[0], nil)
+		if err != nil {
+			return err
+		}
+		return tc.notifyCollectionChange(txCtx, model.NotificationTypeDeleteCollection, collections[0])
+	})
+}
+
+func (tc *Catalog) GetSoftDeletedCollections(ctx context.Context, collectionID *string, tenantID string, databaseName string, limit int32) ([]*model.Collection, error) {
+	collections, err := tc.metaDomain.CollectionDb(ctx).GetSoftDeletedCollections(collectionID, tenantID, databaseName, limit)
+	if err != nil {
+		return nil, err
+	}
+	collectionList := convertCollectionToModel(collections)
+	// The last update of a soft deleted collection is its deletion.
+	for i, collection := range collectionList {
+		collection.UpdatedAt = types.Timestamp(collections[i].Collection.UpdatedAt.Unix())
+	}
+	return collectionList, nil
+}
+
+func (tc *Catalog) RestoreCollection(ctx context.Context, restoreCollection *model.RestoreCollection) (*model.Collection, error) {
+	defer tc.cache.invalidateCollection(restoreCollection.ID.String())
+	log.Info("restoring collection", zap.Any("restoreCollection", restoreCollection))
+	var result *model.Collection
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collectionID := restoreCollection.ID.String()
+		deleted, err := tc.metaDomain.CollectionDb(txCtx).GetSoftDeletedCollections(&collectionID, restoreCollection.TenantID, restoreCollection.DatabaseName, 1)
+		if err != nil {
+			return err
+		}
+		if len(deleted) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		// The request may leave the tenant or database unset, the name checks
+		// below must run against the database the collection belongs to.
+		tenantID := deleted[0].TenantID
+		databaseName := deleted[0].DatabaseName
+		if err := tc.checkTenantNotSuspended(txCtx, tenantID); err != nil {
+			return err
+		}
+
+		name := *deleted[0].Collection.Name
+		if restoreCollection.Name != nil {
+			name = *restoreCollection.Name
+		}
+		if name == "" {
+			return common.ErrCollectionNameEmpty
+		}
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &name, tenantID, databaseName, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		// Another soft deleted collection may hold the name, rename it as CreateCollection does.
+		isSoftDeleted, sdCollectionID, err := tc.metaDomain.CollectionDb(txCtx).CheckCollectionIsSoftDeleted(name, tenantID, databaseName)
+		if err != nil {
+			return err
+		}
+		if isSoftDeleted && sdCollectionID != collectionID {
+			err = tc.renameSoftDeletedCollection(txCtx, sdCollectionID, name, tenantID, databaseName)
+			if err != nil {
+				return err
+			}
+		}
+		// The restored collection counts against the quota like a new one.
+		restored := convertCollectionToModel(deleted)[0]
+		err = tc.checkCreateCollectionQuota(txCtx, &model.CreateCollection{
+			Name:         name,
+			TenantID:     tenantID,
+			DatabaseName: databaseName,
+			Dimension:    restored.Dimension,
+			Metadata:     restored.Metadata,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = tc.metaDomain.CollectionDb(txCtx).Restore(collectionID, name)
+		if err != nil {
+			return err
+		}
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&collectionID, nil, tenantID, databaseName, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		// A restored collection reappears to watchers as created.
+		err = tc.notifyCollectionChange(txCtx, model.NotificationTypeCreateCollection, collectionList[0])
+		if err != nil {
+			return err
+		}
+		result = convertCollectionToModel(collectionList)[0]
+		return tc.recordAudit(txCtx, collectionAuditEvent(model.AuditOperationRestoreCollection, collectionList[0]), restored, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("collection restored", zap.String("collectionID", result.ID.String()), zap.String("name", result.Name))
+	return result, nil
+}
+
+func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
+	defer tc.cache.invalidateCollection(updateCollection.ID.String())
+	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
+	var result *model.Collection
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if updateCollection.ExpectedRowVersion != nil {
+			err := tc.metaDomain.CollectionDb(txCtx).CheckRowVersion(updateCollection.ID.String(), *updateCollection.ExpectedRowVersion)
+			if err != nil {
+				return err
+			}
+		}
+		// The update request does not carry the tenant, look it up from the collection.
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, updateCollection.TenantID, updateCollection.DatabaseName, nil, nil)
 		if err != nil {
-			log.Error("error getting segments during hard delete", zap.Error(err))
 			return err
 		}
-		for _, segment := range segments {
-			err = tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(segment.ID)
+		var quota model.Quota
+		if len(existing) != 0 {
+			if err := tc.checkTenantNotSuspended(txCtx, existing[0].TenantID); err != nil {
+				return err
+			}
+			quota, err = tc.getQuota(txCtx, existing[0].TenantID)
 			if err != nil {
-				log.Error("error deleting segment during hard delete", zap.Error(err))
 				return err
 			}
-			err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segment.ID)
+			err = checkCollectionQuota(existing[0].TenantID, quota, updateCollection.Dimension, updateCollection.Metadata)
 			if err != nil {
-				log.Error("error deleting segment metadata during hard delete", zap.Error(err))
 				return err
 			}
+			// The dimension can only go from unset to set, the records of the
+			// collection are stored at the dimension it was set to.
+			currentDimension := existing[0].Collection.Dimension
+			if updateCollection.Dimension != nil && currentDimension != nil && *currentDimension != *updateCollection.Dimension {
+				return common.ErrCollectionDimensionImmutable
+			}
 		}
 
-		log.Info("collection hard deleted", zap.Any("collection", collectionID),
-			zap.Int("collectionDeletedCount", collectionDeletedCount),
-			zap.Int("collectionMetadataDeletedCount", collectionMetadataDeletedCount))
-		return nil
+		dbCollection := &dbmodel.Collection{
+			ID:        updateCollection.ID.String(),
+			Name:      updateCollection.Name,
+			Dimension: updateCollection.Dimension,
+			Ts:        ts,
+		}
+		if updateCollection.NewDatabaseName != nil {
+			if len(existing) == 0 {
+				return common.ErrCollectionNotFound
+			}
+			databaseID, err := tc.prepareCollectionMove(txCtx, existing[0], updateCollection)
+			if err != nil {
+				return err
+			}
+			if databaseID != existing[0].Collection.DatabaseID {
+				err = tc.checkCollectionCountQuota(txCtx, existing[0].TenantID, *updateCollection.NewDatabaseName, quota)
+				if err != nil {
+					return err
+				}
+			}
+			dbCollection.DatabaseID = databaseID
+		}
+		if updateCollection.ConfigurationJsonStr != nil {
+			if len(existing) == 0 {
+				return common.ErrCollectionNotFound
+			}
+			configurationJsonStr, err := updateCollectionConfiguration(existing[0].Collection.ConfigurationJsonStr, *updateCollection.ConfigurationJsonStr)
+			if err != nil {
+				return err
+			}
+			dbCollection.ConfigurationJsonStr = &configurationJsonStr
+		}
+		err = tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+		if err != nil {
+			return err
+		}
+
+		// Case 1: if ResetMetadata is true, then delete all metadata for the collection
+		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
+		// Case 3: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
+		// Case 4: if ResetMetadata is false and metadata is nil, then leave the metadata as is
+		// In case 3, MergeMetadata only upserts the given keys and deletes those without a value.
+		metadata := updateCollection.Metadata
+		resetMetadata := updateCollection.ResetMetadata
+		if resetMetadata {
+			if metadata != nil || updateCollection.MergeMetadata { // Case 2
+				return common.ErrInvalidMetadataUpdate
+			} else { // Case 1
+				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			if metadata != nil { // Case 3
+				if updateCollection.MergeMetadata {
+					_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionIDAndKeys(updateCollection.ID.String(), deletedMetadataKeys(metadata))
+				} else {
+					_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
+				}
+				if err != nil {
+					return err
+				}
+				dbCollectionMetadataList := convertCollectionMetadataToDB(updateCollection.ID.String(), metadata)
+				if len(dbCollectionMetadataList) != 0 {
+					err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+		databaseName := updateCollection.DatabaseName
+		if updateCollection.NewDatabaseName != nil {
+			databaseName = *updateCollection.NewDatabaseName
+		}
+		tenantID := updateCollection.TenantID
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil)
+		if err != nil {
+			return err
+		}
+		if collectionList == nil || len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		if metadata != nil {
+			err = checkQuota(collectionList[0].TenantID, common.QuotaMaxMetadataKeysPerCollection, quota.MaxMetadataKeysPerCollection, int64(len(collectionList[0].CollectionMetadata)))
+			if err != nil {
+				return err
+			}
+		}
+		if len(existing) != 0 && existing[0].Collection.DatabaseID != collectionList[0].Collection.DatabaseID {
+			// Watchers of either database only see the collection leave or
+			// arrive.
+			err = tc.notifyCollectionChange(txCtx, model.NotificationTypeDeleteCollection, existing[0])
+			if err != nil {
+				return err
+			}
+			err = tc.notifyCollectionChange(txCtx, model.NotificationTypeCreateCollection, collectionList[0])
+		} else {
+			err = tc.notifyCollectionChange(txCtx, model.NotificationTypeUpdateCollection, collectionList[0])
+		}
+		if err != nil {
+			return err
+		}
+		result = convertCollectionToModel(collectionList)[0]
+		var before *model.Collection
+		if len(existing) != 0 {
+			before = convertCollectionToModel(existing)[0]
+		}
+		return tc.recordAudit(txCtx, collectionAuditEvent(model.AuditOperationUpdateCollection, collectionList[0]), before, result)
 	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("collection updated", zap.String("collectionID", result.ID.String()))
+	return result, nil
 }
 
-func (tc *Catalog) renameSoftDeletedCollection(ctx context.Context, collectionID string, collectionName string, tenantID string, databaseName string) error {
-	log.Info("Renaming soft deleted collection", zap.String("collectionID", collectionID), zap.String("collectionName", collectionName), zap.Any("tenantID", tenantID), zap.String("databaseName", databaseName))
-	// Generate new name with timestamp
-	newName := fmt.Sprintf("deleted_%s_%d", collectionName, time.Now().Unix())
+// updateCollectionConfiguration applies the configuration fields to change to
+// the current configuration of a collection and returns the new configuration
+// JSON.
+func updateCollectionConfiguration(current *string, update string) (string, error) {
+	var currentConfiguration *model.CollectionConfiguration
+	if current != nil {
+		var err error
+		currentConfiguration, err = model.ParseCollectionConfiguration(*current)
+		if err != nil {
+			return "", err
+		}
+	}
+	updateConfiguration, err := model.ParseCollectionConfiguration(update)
+	if err != nil {
+		return "", err
+	}
+	configuration, err := currentConfiguration.Update(updateConfiguration)
+	if err != nil {
+		return "", err
+	}
+	if err := configuration.Validate(); err != nil {
+		return "", err
+	}
+	return configuration.String(), nil
+}
 
-	dbCollection := &dbmodel.Collection{
-		ID:        collectionID,
-		Name:      &newName,
-		IsDeleted: true,
-	} // Not updating the timestamp or updated_at.
+// prepareCollectionMove resolves the database a collection is moved to within
+// its tenant and makes sure the collection name is free there. A soft deleted
+// collection holding the name is renamed, as CreateCollection does.
+func (tc *Catalog) prepareCollectionMove(txCtx context.Context, existing *dbmodel.CollectionAndMetadata, updateCollection *model.UpdateCollection) (string, error) {
+	tenantID := existing.TenantID
+	databaseName := *updateCollection.NewDatabaseName
+	databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(tenantID, databaseName)
+	if err != nil {
+		return "", err
+	}
+	if len(databases) == 0 {
+		return "", common.ErrDatabaseNotFound
+	}
+	databaseID := databases[0].ID
+	if databaseID == existing.Collection.DatabaseID {
+		return databaseID, nil
+	}
 
-	err := tc.metaDomain.CollectionDb(ctx).Update(dbCollection)
+	name := *existing.Collection.Name
+	if updateCollection.Name != nil {
+		name = *updateCollection.Name
+	}
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &name, tenantID, databaseName, nil, nil)
 	if err != nil {
-		log.Error("rename soft deleted collection failed", zap.Error(err))
-		return fmt.Errorf("collection rename failed due to update error: %w", err)
+		return "", err
+	}
+	if len(collections) != 0 {
+		return "", common.ErrCollectionUniqueConstraintViolation
+	}
+	isSoftDeleted, sdCollectionID, err := tc.metaDomain.CollectionDb(txCtx).CheckCollectionIsSoftDeleted(name, tenantID, databaseName)
+	if err != nil {
+		return "", err
+	}
+	if isSoftDeleted {
+		err = tc.renameSoftDeletedCollection(txCtx, sdCollectionID, name, tenantID, databaseName)
+		if err != nil {
+			return "", err
+		}
+	}
+	log.Info("moving collection", zap.String("collectionID", existing.Collection.ID), zap.String("fromDatabaseID", existing.Collection.DatabaseID), zap.String("toDatabaseID", databaseID))
+	return databaseID, nil
+}
+
+func (tc *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
+	defer tc.cache.invalidateCollection(createSegment.CollectionID.String())
+	var result *model.Segment
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// A collection has exactly one segment of each scope, the same
+		// invariant CreateCollectionAndSegments checks up front.
+		scope := createSegment.Scope
+		existing, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, &scope, createSegment.CollectionID)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			return common.ErrCollectionSegmentScopes
+		}
+		result, err = tc.createSegmentImpl(txCtx, createSegment, ts)
+		if err != nil {
+			return err
+		}
+		auditEvent, err := tc.collectionAuditEventByID(txCtx, model.AuditOperationCreateSegment, createSegment.CollectionID.String())
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, auditEvent, nil, result)
+	})
+	if err != nil {
+		log.Error("error creating segment", zap.Error(err))
+		return nil, err
+	}
+	log.Info("segment created", zap.Any("segment", result))
+	return result, nil
+}
+
+func (tc *Catalog) createSegmentImpl(txCtx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
+	var result *model.Segment
+
+	// insert segment
+	collectionString := createSegment.CollectionID.String()
+	dbSegment := &dbmodel.Segment{
+		ID:           createSegment.ID.String(),
+		CollectionID: &collectionString,
+		Type:         createSegment.Type,
+		Scope:        createSegment.Scope,
+		Ts:           ts,
+		FilePaths:    createSegment.FilePaths,
+	}
+	err := tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
+	if err != nil {
+		log.Error("error inserting segment", zap.Error(err))
+		return nil, err
+	}
+	// insert segment metadata
+	metadata := createSegment.Metadata
+	if metadata != nil {
+		dbSegmentMetadataList := convertSegmentMetadataToDB(createSegment.ID.String(), metadata)
+		if len(dbSegmentMetadataList) != 0 {
+			err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
+			if err != nil {
+				log.Error("error inserting segment metadata", zap.Error(err))
+				return nil, err
+			}
+		}
+	}
+	// get segment
+	segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(createSegment.ID, nil, nil, createSegment.CollectionID)
+	if err != nil {
+		log.Error("error getting segment", zap.Error(err))
+		return nil, err
+	}
+	result = convertSegmentToModel(segmentList)[0]
+
+	return result, nil
+}
+
+// ForkCollection creates a copy-on-write fork of a collection in the same
+// database. The fork starts with the configuration, metadata and segment files
+// of the source and diverges from it afterwards. Files are shared rather than
+// copied, so both collections register a reference to them and a garbage
+// collector must check GetSharedFilePaths before deleting any of them.
+//
+// The log of the fork is its own: the records of the source after its log
+// position are copied to it through the log service, numbered from 1, before
+// the catalog transaction so that no row is locked during the remote call. If
+// the fork cannot be created afterwards its log is deleted again.
+func (tc *Catalog) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection) (*model.Collection, error) {
+	defer tc.cache.invalidateCollection(forkCollection.TargetCollectionID.String())
+	log.Info("forking collection", zap.Any("forkCollection", forkCollection))
+	var result *model.Collection
+
+	if tc.logService == nil {
+		return nil, common.ErrLogServiceNotConfigured
+	}
+	sourceID := forkCollection.SourceCollectionID
+	sourceList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(sourceID), nil, forkCollection.TenantID, forkCollection.DatabaseName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceList) == 0 {
+		return nil, common.ErrCollectionNotFound
+	}
+	forkedAfter := sourceList[0].Collection.LogPosition
+	recordCount, err := tc.logService.ForkLogs(ctx, sourceID.String(), forkCollection.TargetCollectionID.String(), forkedAfter)
+	if err != nil {
+		log.Error("error forking collection log", zap.Error(err))
+		return nil, err
+	}
+	log.Info("forked collection log", zap.String("collectionID", forkCollection.TargetCollectionID.String()), zap.Int64("recordCount", recordCount))
+
+	err = tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		sourceList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(sourceID), nil, forkCollection.TenantID, forkCollection.DatabaseName, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(sourceList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		source := convertCollectionToModel(sourceList)[0]
+		// The source may have been flushed since its log was forked, the fork
+		// then starts with the records its files already contain. A rollback
+		// of the source would make the forked records stale.
+		if source.LogPosition < forkedAfter {
+			return common.ErrCollectionLogPositionStale
+		}
+
+		createCollection := &model.CreateCollection{
+			ID:                   forkCollection.TargetCollectionID,
+			Name:                 forkCollection.TargetCollectionName,
+			ConfigurationJsonStr: source.ConfigurationJsonStr,
+			Dimension:            source.Dimension,
+			Metadata:             source.Metadata,
+			TenantID:             source.TenantID,
+			DatabaseName:         source.DatabaseName,
+			LogPosition:          source.LogPosition - forkedAfter,
+		}
+		result, _, err = tc.createCollectionImpl(txCtx, createCollection, forkCollection.Ts, model.AuditOperationForkCollection)
+		if err != nil {
+			return err
+		}
+
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, sourceID)
+		if err != nil {
+			return err
+		}
+		references := make([]*dbmodel.FileReference, 0)
+		for _, segmentAndMetadata := range segments {
+			segment := segmentAndMetadata.Segment
+			createSegment := &model.CreateSegment{
+				ID:           types.NewUniqueID(),
+				Type:         segment.Type,
+				Scope:        segment.Scope,
+				CollectionID: result.ID,
+				Metadata:     convertSegmentMetadataToModel(segmentAndMetadata.SegmentMetadata),
+				FilePaths:    segment.FilePaths,
+			}
+			_, err = tc.createSegmentImpl(txCtx, createSegment, forkCollection.Ts)
+			if err != nil {
+				return err
+			}
+			// The references of the source are only new on its first fork,
+			// Insert skips those that exist.
+			for _, filePaths := range segment.FilePaths {
+				for _, filePath := range filePaths {
+					references = append(references,
+						&dbmodel.FileReference{FilePath: filePath, CollectionID: sourceID.String()},
+						&dbmodel.FileReference{FilePath: filePath, CollectionID: result.ID.String()})
+				}
+			}
+		}
+		return tc.metaDomain.FileReferenceDb(txCtx).Insert(references)
+	})
+	if err != nil {
+		log.Error("error forking collection", zap.Error(err))
+		// Logs sysdb does not know are garbage collected eventually, deleting
+		// it now only keeps it from being compacted in the meantime.
+		if deleteErr := tc.logService.DeleteLogs(ctx, forkCollection.TargetCollectionID.String()); deleteErr != nil {
+			log.Error("error deleting forked collection log", zap.Error(deleteErr), zap.String("collectionID", forkCollection.TargetCollectionID.String()))
+		}
+		return nil, err
+	}
+	log.Info("collection forked", zap.String("sourceCollectionID", sourceID.String()), zap.String("collectionID", result.ID.String()))
+	return result, nil
+}
+
+// CountCollections counts the live collections of a tenant, or of one of its
+// databases when databaseName is set.
+func (tc *Catalog) CountCollections(ctx context.Context, tenantID string, databaseName *string) (uint64, error) {
+	return tc.metaDomain.CollectionDb(ctx).CountCollections(tenantID, databaseName)
+}
+
+// CheckCollections returns the collection ids, among collectionIDs, of
+// collections that exist. Soft deleted collections are reported until they
+// are hard deleted, the log service must keep their records for a restore.
+func (tc *Catalog) CheckCollections(ctx context.Context, collectionIDs []string) ([]string, error) {
+	return tc.metaDomain.CollectionDb(ctx).GetExistingCollectionIDs(collectionIDs)
+}
+
+// notifyCollectionChange records a change to a collection in the notification
+// outbox. It must run inside the transaction of the change, so that the
+// notification is committed if and only if the change is.
+func (tc *Catalog) notifyCollectionChange(txCtx context.Context, notificationType string, collection *dbmodel.CollectionAndMetadata) error {
+	err := tc.metaDomain.NotificationDb(txCtx).Insert(&dbmodel.Notification{
+		CollectionID: collection.Collection.ID,
+		TenantID:     collection.TenantID,
+		DatabaseID:   collection.Collection.DatabaseID,
+		DatabaseName: collection.DatabaseName,
+		Type:         notificationType,
+	})
+	if err != nil {
+		log.Error("error notifying collection change", zap.String("collectionID", collection.Collection.ID), zap.String("type", notificationType), zap.Error(err))
 	}
-	return nil
+	return err
 }
 
-func (tc *Catalog) softDeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
-	log.Info("Soft deleting collection", zap.Any("softDeleteCollection", deleteCollection))
-	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// Check if collection exists
-		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(deleteCollection.ID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil)
-		if err != nil {
-			return err
-		}
-		if len(collections) == 0 {
-			return common.ErrCollectionDeleteNonExistingCollection
-		}
-
-		dbCollection := &dbmodel.Collection{
-			ID:        deleteCollection.ID.String(),
-			IsDeleted: true,
-			Ts:        deleteCollection.Ts,
-			UpdatedAt: time.Now(),
-		}
-		err = tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
-		if err != nil {
-			log.Error("soft delete collection failed", zap.Error(err))
-			return fmt.Errorf("collection delete failed due to update error: %w", err)
-		}
+// notifyCollectionFlush records that new files of a collection were
+// registered. Flushes of soft deleted collections are not notified.
+func (tc *Catalog) notifyCollectionFlush(txCtx context.Context, collectionID string) error {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&collectionID, nil, "", "", nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(collections) == 0 {
 		return nil
-	})
+	}
+	return tc.notifyCollectionChange(txCtx, model.NotificationTypeFlushCollection, collections[0])
 }
 
-func (tc *Catalog) GetSoftDeletedCollections(ctx context.Context, collectionID *string, tenantID string, databaseName string, limit int32) ([]*model.Collection, error) {
-	collections, err := tc.metaDomain.CollectionDb(ctx).GetSoftDeletedCollections(collectionID, tenantID, databaseName, limit)
+// ListNotifications returns the changes to collections with a revision in
+// (AfterRevision, UpToRevision], in revision order.
+func (tc *Catalog) ListNotifications(ctx context.Context, listNotifications *model.ListNotifications) ([]*model.Notification, error) {
+	notifications, err := tc.metaDomain.NotificationDb(ctx).ListNotifications(&dbmodel.ListNotificationsQuery{
+		TenantID:      listNotifications.TenantID,
+		DatabaseID:    listNotifications.DatabaseID,
+		AfterRevision: listNotifications.AfterRevision,
+		UpToRevision:  listNotifications.UpToRevision,
+		Limit:         listNotifications.Limit,
+	})
 	if err != nil {
 		return nil, err
 	}
-	// Convert to model.Collection
-	collectionList := make([]*model.Collection, 0, len(collections))
-	for _, dbCollection := range collections {
-		collection := &model.Collection{
-			ID:           types.MustParse(dbCollection.Collection.ID),
-			Name:         *dbCollection.Collection.Name,
-			DatabaseName: dbCollection.DatabaseName,
-			TenantID:     dbCollection.TenantID,
-			Ts:           types.Timestamp(dbCollection.Collection.Ts),
-			UpdatedAt:    types.Timestamp(dbCollection.Collection.UpdatedAt.Unix()),
-		}
-		collectionList = append(collectionList, collection)
+	result := make([]*model.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		result = append(result, convertNotificationToModel(notification))
 	}
-	return collectionList, nil
+	return result, nil
 }
 
-func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
-	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
-	var result *model.Collection
+// ListNotificationRevisions returns the committed revisions after a revision,
+// of all tenants, in order.
+func (tc *Catalog) ListNotificationRevisions(ctx context.Context, afterRevision int64, limit int32) ([]int64, error) {
+	return tc.metaDomain.NotificationDb(ctx).ListRevisions(afterRevision, limit)
+}
+
+// GetNotificationRevisionRange returns the oldest and the latest revisions
+// kept, both zero if there is none.
+func (tc *Catalog) GetNotificationRevisionRange(ctx context.Context) (int64, int64, error) {
+	return tc.metaDomain.NotificationDb(ctx).GetRevisionRange()
+}
+
+// PurgeNotifications deletes the changes to collections recorded before
+// notBefore. The latest change is kept so that the revisions stay known.
+func (tc *Catalog) PurgeNotifications(ctx context.Context, notBefore time.Time) (int, error) {
+	return tc.metaDomain.NotificationDb(ctx).DeleteCreatedBefore(notBefore)
+}
 
+// ReserveIdempotencyKey records a pending idempotency key for a method. A key
+// still pending since before reservedBefore is left over by a request that did
+// not finish, and is reserved again. It returns nil once the key is reserved,
+// or the record of the key if it is already recorded.
+func (tc *Catalog) ReserveIdempotencyKey(ctx context.Context, record *model.IdempotencyRecord, reservedBefore time.Time) (*model.IdempotencyRecord, error) {
+	var existing *model.IdempotencyRecord
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		dbCollection := &dbmodel.Collection{
-			ID:        updateCollection.ID.String(),
-			Name:      updateCollection.Name,
-			Dimension: updateCollection.Dimension,
-			Ts:        ts,
+		idempotencyKey := &dbmodel.IdempotencyKey{
+			Method:      record.Method,
+			Key:         record.Key,
+			RequestHash: record.RequestHash,
+			Pending:     true,
+			CreatedAt:   record.CreatedAt,
+			ReservedAt:  record.ReservedAt,
 		}
-		err := tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+		inserted, err := tc.metaDomain.IdempotencyKeyDb(txCtx).Insert(idempotencyKey)
+		if err != nil || inserted {
+			return err
+		}
+		takenOver, err := tc.metaDomain.IdempotencyKeyDb(txCtx).TakeOver(idempotencyKey, reservedBefore)
 		if err != nil {
 			return err
 		}
-
-		// Case 1: if ResetMetadata is true, then delete all metadata for the collection
-		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
-		// Case 3: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
-		// Case 4: if ResetMetadata is false and metadata is nil, then leave the metadata as is
-		metadata := updateCollection.Metadata
-		resetMetadata := updateCollection.ResetMetadata
-		if resetMetadata {
-			if metadata != nil { // Case 2
-				return common.ErrInvalidMetadataUpdate
-			} else { // Case 1
-				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			if metadata != nil { // Case 3
-				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
-				if err != nil {
-					return err
-				}
-				dbCollectionMetadataList := convertCollectionMetadataToDB(updateCollection.ID.String(), metadata)
-				if len(dbCollectionMetadataList) != 0 {
-					err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
-					if err != nil {
-						return err
-					}
-				}
-			}
+		if takenOver {
+			log.Info("took over expired idempotency key reservation", zap.String("method", record.Method), zap.String("key", record.Key))
+			return nil
 		}
-		databaseName := updateCollection.DatabaseName
-		tenantID := updateCollection.TenantID
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil)
+		recorded, err := tc.metaDomain.IdempotencyKeyDb(txCtx).Get(record.Method, record.Key)
 		if err != nil {
 			return err
 		}
-		if collectionList == nil || len(collectionList) == 0 {
-			return common.ErrCollectionNotFound
+		if recorded != nil {
+			existing = convertIdempotencyKeyToModel(recorded)
 		}
-		result = convertCollectionToModel(collectionList)[0]
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	log.Info("collection updated", zap.String("collectionID", result.ID.String()))
-	return result, nil
+	return existing, nil
 }
 
-func (tc *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
-	var result *model.Segment
+// CompleteIdempotencyKey records the response to the request a pending
+// idempotency key was reserved for.
+func (tc *Catalog) CompleteIdempotencyKey(ctx context.Context, method string, key string, response []byte) error {
+	return tc.metaDomain.IdempotencyKeyDb(ctx).Complete(method, key, response)
+}
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		var err error
-		result, err = tc.createSegmentImpl(txCtx, createSegment, ts)
-		return err
+// ReleaseIdempotencyKey deletes a pending idempotency key, so that the request
+// it was reserved for can be retried with it.
+func (tc *Catalog) ReleaseIdempotencyKey(ctx context.Context, method string, key string) error {
+	return tc.metaDomain.IdempotencyKeyDb(ctx).DeletePending(method, key)
+}
+
+// PurgeIdempotencyKeys deletes the idempotency keys recorded before notBefore.
+func (tc *Catalog) PurgeIdempotencyKeys(ctx context.Context, notBefore time.Time) (int, error) {
+	return tc.metaDomain.IdempotencyKeyDb(ctx).DeleteCreatedBefore(notBefore)
+}
+
+// GetSharedFilePaths returns the file paths, among filePaths, that a
+// collection other than collectionID still references. The garbage collector
+// must keep them.
+func (tc *Catalog) GetSharedFilePaths(ctx context.Context, collectionID types.UniqueID, filePaths []string) ([]string, error) {
+	return tc.metaDomain.FileReferenceDb(ctx).GetSharedFilePaths(collectionID.String(), filePaths)
+}
+
+// pruneFileReferences drops the references of a collection to the files its
+// segments no longer use.
+func (tc *Catalog) pruneFileReferences(txCtx context.Context, collectionID string, segments []*dbmodel.Segment) error {
+	_, err := tc.metaDomain.FileReferenceDb(txCtx).DeleteUnusedByCollectionID(collectionID, segmentFilePathList(segments))
+	return err
+}
+
+func segmentFilePathList(segments []*dbmodel.Segment) []string {
+	filePathList := make([]string, 0)
+	for _, segment := range segments {
+		for _, filePaths := range segment.FilePaths {
+			filePathList = append(filePathList, filePaths...)
+		}
+	}
+	return filePathList
+}
+
+// recordCollectionVersion adds a version of a collection, with the current
+// file paths of its segments, to the version history.
+func (tc *Catalog) recordCollectionVersion(txCtx context.Context, collectionID string, version int32, logPosition int64, segments []*dbmodel.Segment) error {
+	segmentFilePaths := make(map[string]map[string][]string, len(segments))
+	for _, segment := range segments {
+		filePaths := segment.FilePaths
+		if filePaths == nil {
+			filePaths = map[string][]string{}
+		}
+		segmentFilePaths[segment.ID] = filePaths
+	}
+	return tc.metaDomain.CollectionVersionDb(txCtx).Insert(&dbmodel.CollectionVersion{
+		CollectionID:     collectionID,
+		Version:          version,
+		LogPosition:      logPosition,
+		SegmentFilePaths: segmentFilePaths,
 	})
+}
+
+func (tc *Catalog) ListCollectionVersions(ctx context.Context, collectionID types.UniqueID, limit int32) ([]*model.CollectionVersion, error) {
+	versions, err := tc.metaDomain.CollectionVersionDb(ctx).ListByCollectionID(collectionID.String(), limit)
 	if err != nil {
-		log.Error("error creating segment", zap.Error(err))
 		return nil, err
 	}
-	log.Info("segment created", zap.Any("segment", result))
+	result := make([]*model.CollectionVersion, 0, len(versions))
+	for _, version := range versions {
+		result = append(result, convertCollectionVersionToModel(version))
+	}
 	return result, nil
 }
 
-func (tc *Catalog) createSegmentImpl(txCtx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
-	var result *model.Segment
-
-	// insert segment
-	collectionString := createSegment.CollectionID.String()
-	dbSegment := &dbmodel.Segment{
-		ID:           createSegment.ID.String(),
-		CollectionID: &collectionString,
-		Type:         createSegment.Type,
-		Scope:        createSegment.Scope,
-		Ts:           ts,
+// GetSegmentsAtVersion returns the segments of a collection with the file
+// paths they had at the given version. Segments created after that version are
+// left out.
+func (tc *Catalog) GetSegmentsAtVersion(ctx context.Context, collectionID types.UniqueID, version int32) ([]*model.Segment, error) {
+	collectionVersion, err := tc.metaDomain.CollectionVersionDb(ctx).GetByCollectionIDAndVersion(collectionID.String(), version)
+	if err != nil {
+		return nil, err
 	}
-	err := tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
+	if collectionVersion == nil {
+		return nil, common.ErrCollectionVersionNotFound
+	}
+	segments, err := tc.GetSegments(ctx, types.NilUniqueID(), nil, nil, collectionID)
 	if err != nil {
-		log.Error("error inserting segment", zap.Error(err))
 		return nil, err
 	}
-	// insert segment metadata
-	metadata := createSegment.Metadata
-	if metadata != nil {
-		dbSegmentMetadataList := convertSegmentMetadataToDB(createSegment.ID.String(), metadata)
-		if len(dbSegmentMetadataList) != 0 {
-			err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
-			if err != nil {
-				log.Error("error inserting segment metadata", zap.Error(err))
-				return nil, err
-			}
+	result := make([]*model.Segment, 0, len(segments))
+	for _, segment := range segments {
+		filePaths, ok := collectionVersion.SegmentFilePaths[segment.ID.String()]
+		if !ok {
+			continue
 		}
+		segment.FilePaths = filePaths
+		result = append(result, segment)
 	}
-	// get segment
-	segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(createSegment.ID, nil, nil, createSegment.CollectionID)
+	return result, nil
+}
+
+// RollbackCollection restores the segment file paths and log position of a
+// prior version. The rollback is itself recorded as a new version, so the
+// history stays append only and compactors holding the old version fail their
+// next flush.
+//
+// The log records after the prior version are compacted again, so the log
+// service must still have them. The log is rewound before the catalog
+// transaction so that no row is locked during the remote call, and moved
+// forward again if the rollback fails. The files the collection no longer uses
+// may have been garbage collected, a version using any of them that no fork
+// references is not restored.
+func (tc *Catalog) RollbackCollection(ctx context.Context, collectionID types.UniqueID, version int32) (*model.CollectionVersion, error) {
+	defer tc.cache.invalidateCollection(collectionID.String())
+	log.Info("rolling back collection", zap.String("collectionID", collectionID.String()), zap.Int32("version", version))
+	var result *model.CollectionVersion
+
+	if tc.logService == nil {
+		return nil, common.ErrLogServiceNotConfigured
+	}
+	id := collectionID.String()
+	collections, err := tc.metaDomain.CollectionDb(ctx).GetCollections(&id, nil, "", "", nil, nil)
 	if err != nil {
-		log.Error("error getting segment", zap.Error(err))
 		return nil, err
 	}
-	result = convertSegmentToModel(segmentList)[0]
+	if len(collections) == 0 {
+		return nil, common.ErrCollectionNotFound
+	}
+	if err := tc.checkTenantNotSuspended(ctx, collections[0].TenantID); err != nil {
+		return nil, err
+	}
+	target, err := tc.metaDomain.CollectionVersionDb(ctx).GetByCollectionIDAndVersion(id, version)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, common.ErrCollectionVersionNotFound
+	}
+	compactedLogPosition := collections[0].Collection.LogPosition
+	err = tc.logService.ResetCollectionLogOffset(ctx, id, target.LogPosition)
+	if err != nil {
+		log.Error("error rewinding collection log", zap.Error(err))
+		return nil, err
+	}
+
+	err = tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&id, nil, "", "", nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		if err := tc.checkTenantNotSuspended(txCtx, collections[0].TenantID); err != nil {
+			return err
+		}
+
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegmentsByCollectionID(id)
+		if err != nil {
+			return err
+		}
+		// The garbage collector may delete the files the collection no longer
+		// uses, unless another collection still references them.
+		inUse := make(map[string]bool)
+		for _, filePath := range segmentFilePathList(segments) {
+			inUse[filePath] = true
+		}
+		unused := make([]string, 0)
+		for _, filePaths := range target.SegmentFilePaths {
+			for _, paths := range filePaths {
+				for _, filePath := range paths {
+					if !inUse[filePath] {
+						inUse[filePath] = true
+						unused = append(unused, filePath)
+					}
+				}
+			}
+		}
+		kept, err := tc.metaDomain.FileReferenceDb(txCtx).GetSharedFilePaths(id, unused)
+		if err != nil {
+			return err
+		}
+		if len(kept) != len(unused) {
+			return common.ErrCollectionVersionFilesCollected
+		}
+		flushSegmentCompactions := make([]*model.FlushSegmentCompaction, 0, len(segments))
+		for _, segment := range segments {
+			filePaths, ok := target.SegmentFilePaths[segment.ID]
+			if !ok {
+				filePaths = map[string][]string{}
+			}
+			segment.FilePaths = filePaths
+			flushSegmentCompactions = append(flushSegmentCompactions, &model.FlushSegmentCompaction{
+				ID:        types.MustParse(segment.ID),
+				FilePaths: filePaths,
+			})
+		}
+		err = tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushSegmentCompactions)
+		if err != nil {
+			return err
+		}
+
+		newVersion, err := tc.metaDomain.CollectionDb(txCtx).ResetLogPosition(id, target.LogPosition)
+		if err != nil {
+			return err
+		}
 
+		// Files shared with a fork may be back in use, reference them again so
+		// the fork does not garbage collect them.
+		filePathList := segmentFilePathList(segments)
+		shared, err := tc.metaDomain.FileReferenceDb(txCtx).GetSharedFilePaths(id, filePathList)
+		if err != nil {
+			return err
+		}
+		references := make([]*dbmodel.FileReference, 0, len(shared))
+		for _, filePath := range shared {
+			references = append(references, &dbmodel.FileReference{FilePath: filePath, CollectionID: id})
+		}
+		err = tc.metaDomain.FileReferenceDb(txCtx).Insert(references)
+		if err != nil {
+			return err
+		}
+		err = tc.pruneFileReferences(txCtx, id, segments)
+		if err != nil {
+			return err
+		}
+
+		err = tc.recordCollectionVersion(txCtx, id, newVersion, target.LogPosition, segments)
+		if err != nil {
+			return err
+		}
+		err = tc.notifyCollectionFlush(txCtx, id)
+		if err != nil {
+			return err
+		}
+		recorded, err := tc.metaDomain.CollectionVersionDb(txCtx).GetByCollectionIDAndVersion(id, newVersion)
+		if err != nil {
+			return err
+		}
+		result = convertCollectionVersionToModel(recorded)
+		auditEvent, err := tc.collectionAuditEventByID(txCtx, model.AuditOperationRollbackCollection, id)
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, auditEvent, nil, result)
+	})
+	if err != nil {
+		log.Error("error rolling back collection", zap.Error(err))
+		// A rewound log only compacts records again, moving it forward keeps
+		// the compactor from doing so needlessly.
+		if updateErr := tc.logService.UpdateCollectionLogOffset(ctx, id, compactedLogPosition); updateErr != nil {
+			log.Error("error restoring collection log offset", zap.Error(updateErr), zap.String("collectionID", id))
+		}
+		return nil, err
+	}
+	log.Info("collection rolled back", zap.String("collectionID", collectionID.String()), zap.Int32("version", version), zap.Int32("newVersion", result.Version))
 	return result, nil
 }
 
 func (tc *Catalog) CreateCollectionAndSegments(ctx context.Context, createCollection *model.CreateCollection, createSegments []*model.CreateSegment, ts types.Timestamp) (*model.Collection, bool, error) {
+	defer tc.cache.invalidateCollection(createCollection.ID.String())
 	var resultCollection *model.Collection
 	created := false
 
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
 		// Create the collection using the refactored helper
 		var err error
-		resultCollection, created, err = tc.createCollectionImpl(txCtx, createCollection, ts)
+		resultCollection, created, err = tc.createCollectionImpl(txCtx, createCollection, ts, model.AuditOperationCreateCollection)
 		if err != nil {
 			log.Error("error creating collection", zap.Error(err))
 			return err
@@ -626,6 +2086,13 @@ func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, se
 		defer span.End()
 	}
 
+	if tc.cache != nil && collectionID != types.NilUniqueID() {
+		return tc.getSegmentsCached(ctx, segmentID, segmentType, scope, collectionID)
+	}
+	return tc.getSegments(ctx, segmentID, segmentType, scope, collectionID)
+}
+
+func (tc *Catalog) getSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
 	segmentAndMetadataList, err := tc.metaDomain.SegmentDb(ctx).GetSegments(segmentID, segmentType, scope, collectionID)
 	if err != nil {
 		return nil, err
@@ -633,11 +2100,12 @@ func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, se
 	segments := make([]*model.Segment, 0, len(segmentAndMetadataList))
 	for _, segmentAndMetadata := range segmentAndMetadataList {
 		segment := &model.Segment{
-			ID:        types.MustParse(segmentAndMetadata.Segment.ID),
-			Type:      segmentAndMetadata.Segment.Type,
-			Scope:     segmentAndMetadata.Segment.Scope,
-			Ts:        segmentAndMetadata.Segment.Ts,
-			FilePaths: segmentAndMetadata.Segment.FilePaths,
+			ID:         types.MustParse(segmentAndMetadata.Segment.ID),
+			Type:       segmentAndMetadata.Segment.Type,
+			Scope:      segmentAndMetadata.Segment.Scope,
+			Ts:         segmentAndMetadata.Segment.Ts,
+			FilePaths:  segmentAndMetadata.Segment.FilePaths,
+			RowVersion: segmentAndMetadata.Segment.RowVersion,
 		}
 
 		if segmentAndMetadata.Segment.CollectionID != nil {
@@ -667,19 +2135,25 @@ func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.Updat
 	if err != nil {
 		return nil, err
 	}
+	defer tc.cache.invalidateCollection(parsedCollectionID.String())
 
 	var result *model.Segment
 
 	err = tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		{
-			results, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, parsedCollectionID)
+		results, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, parsedCollectionID)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			return common.ErrSegmentUpdateNonExistingSegment
+		}
+		updateSegment.Collection = results[0].Segment.CollectionID
+		before := convertSegmentToModel(results)[0]
+		if updateSegment.ExpectedRowVersion != nil {
+			err := tc.metaDomain.SegmentDb(txCtx).CheckRowVersion(updateSegment.ID.String(), *updateSegment.ExpectedRowVersion)
 			if err != nil {
 				return err
 			}
-			if len(results) == 0 {
-				return common.ErrSegmentUpdateNonExistingSegment
-			}
-			updateSegment.Collection = results[0].Segment.CollectionID
 		}
 
 		// update segment
@@ -688,7 +2162,7 @@ func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.Updat
 			Collection: updateSegment.Collection,
 		}
 
-		err := tc.metaDomain.SegmentDb(txCtx).Update(dbSegment)
+		err = tc.metaDomain.SegmentDb(txCtx).Update(dbSegment)
 		if err != nil {
 			return err
 		}
@@ -740,7 +2214,11 @@ func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.Updat
 			return err
 		}
 		result = convertSegmentToModel(segmentList)[0]
-		return nil
+		auditEvent, err := tc.collectionAuditEventByID(txCtx, model.AuditOperationUpdateSegment, *updateSegment.Collection)
+		if err != nil {
+			return err
+		}
+		return tc.recordAudit(txCtx, auditEvent, before, result)
 	})
 	if err != nil {
 		log.Error("error updating segment", zap.Error(err))
@@ -760,13 +2238,19 @@ func (tc *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs [
 }
 
 func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
+	defer tc.cache.invalidateCollection(flushCollectionCompaction.ID.String())
 	flushCollectionInfo := &model.FlushCollectionInfo{
 		ID: flushCollectionCompaction.ID.String(),
 	}
 
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		err := tc.checkTenantNotSuspended(txCtx, flushCollectionCompaction.TenantID)
+		if err != nil {
+			return err
+		}
+
 		// register files to Segment metadata
-		err := tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushCollectionCompaction.FlushSegmentCompactions)
+		err = tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushCollectionCompaction.FlushSegmentCompactions)
 		if err != nil {
 			return err
 		}
@@ -778,6 +2262,23 @@ func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 		}
 		flushCollectionInfo.CollectionVersion = collectionVersion
 
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegmentsByCollectionID(flushCollectionCompaction.ID.String())
+		if err != nil {
+			return err
+		}
+		err = tc.pruneFileReferences(txCtx, flushCollectionCompaction.ID.String(), segments)
+		if err != nil {
+			return err
+		}
+		err = tc.recordCollectionVersion(txCtx, flushCollectionCompaction.ID.String(), collectionVersion, flushCollectionCompaction.LogPosition, segments)
+		if err != nil {
+			return err
+		}
+		err = tc.notifyCollectionFlush(txCtx, flushCollectionCompaction.ID.String())
+		if err != nil {
+			return err
+		}
+
 		// update tenant last compaction time
 		// TODO: add a system configuration to disable
 		// since this might cause resource contention if one tenant has a lot of collection compactions at the same time
@@ -788,6 +2289,15 @@ func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 		}
 		flushCollectionInfo.TenantLastCompactionTime = lastCompactionTime
 
+		auditEvent, err := tc.collectionAuditEventByID(txCtx, model.AuditOperationFlushCollection, flushCollectionCompaction.ID.String())
+		if err != nil {
+			return err
+		}
+		err = tc.recordAudit(txCtx, auditEvent, nil, flushCollectionInfo)
+		if err != nil {
+			return err
+		}
+
 		// return nil will commit the transaction
 		return nil
 	})
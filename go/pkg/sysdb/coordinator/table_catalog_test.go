@@ -8,6 +8,7 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel/mocks"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/memdb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -137,3 +138,84 @@ func TestCatalog_GetCollections(t *testing.T) {
 	// assert that the mock methods were called as expected
 	mockMetaDomain.AssertExpectations(t)
 }
+
+// newMemdbCatalog returns a catalog backed by the in-memory implementation,
+// seeded with the default tenant and database.
+func newMemdbCatalog(t *testing.T) (*Catalog, *memdb.MetaDomain) {
+	metaDomain := memdb.NewMetaDomain()
+	catalog := NewTableCatalog(metaDomain, metaDomain)
+	assert.NoError(t, catalog.ResetState(context.Background()))
+	return catalog, metaDomain
+}
+
+func TestCatalog_CreateCollection_Memdb(t *testing.T) {
+	catalog, _ := newMemdbCatalog(t)
+
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("test_key", &model.CollectionMetadataValueStringType{Value: "test_value"})
+	collection := &model.CreateCollection{
+		ID:           types.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name:         "test_collection",
+		Metadata:     metadata,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}
+	ts := types.Timestamp(1234567890)
+
+	_, _, err := catalog.CreateCollection(context.Background(), collection, ts)
+	assert.NoError(t, err)
+
+	collections, err := catalog.GetCollections(context.Background(), collection.ID, nil, defaultTenant, defaultDatabase, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 1)
+	assert.Equal(t, "test_collection", collections[0].Name)
+	assert.Equal(t, ts, collections[0].Ts)
+	assert.True(t, metadata.Equals(collections[0].Metadata))
+}
+
+func TestCatalog_GetCollections_Memdb(t *testing.T) {
+	catalog, metaDomain := newMemdbCatalog(t)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	collectionName := "test_collection"
+	testKey := "test_key"
+	testValue := "test_value"
+	collectionConfigurationJsonStr := "{\"a\": \"param\", \"b\": \"param2\", \"3\": true}"
+	databases, err := metaDomain.DatabaseDb(context.Background()).GetDatabases(defaultTenant, defaultDatabase)
+	assert.NoError(t, err)
+	assert.Len(t, databases, 1)
+	err = metaDomain.CollectionDb(context.Background()).Insert(&dbmodel.Collection{
+		ID:                   collectionID.String(),
+		Name:                 &collectionName,
+		ConfigurationJsonStr: &collectionConfigurationJsonStr,
+		DatabaseID:           databases[0].ID,
+		Ts:                   types.Timestamp(1234567890),
+	})
+	assert.NoError(t, err)
+	err = metaDomain.CollectionMetadataDb(context.Background()).Insert([]*dbmodel.CollectionMetadata{
+		{
+			CollectionID: collectionID.String(),
+			Key:          &testKey,
+			StrValue:     &testValue,
+			Ts:           types.Timestamp(1234567890),
+		},
+	})
+	assert.NoError(t, err)
+
+	collections, err := catalog.GetCollections(context.Background(), collectionID, &collectionName, defaultTenant, defaultDatabase, nil, nil)
+	assert.NoError(t, err)
+
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("test_key", &model.CollectionMetadataValueStringType{Value: "test_value"})
+	assert.Equal(t, []*model.Collection{
+		{
+			ID:                   collectionID,
+			Name:                 "test_collection",
+			ConfigurationJsonStr: collectionConfigurationJsonStr,
+			TenantID:             defaultTenant,
+			DatabaseName:         defaultDatabase,
+			Ts:                   types.Timestamp(1234567890),
+			Metadata:             metadata,
+		},
+	}, collections)
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *coordinatorpb.ListAuditEventsRequest) (*coordinatorpb.ListAuditEventsResponse, error) {
+	res := &coordinatorpb.ListAuditEventsResponse{}
+	afterID, err := decodeIDPageToken(req.PageToken)
+	if err != nil {
+		log.Error("error ListAuditEvents", zap.String("request", req.String()), zap.Error(err))
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", err.Error())
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	if req.StartTime != nil && req.EndTime != nil && req.GetStartTime() > req.GetEndTime() {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("end_time", "end_time must not be before start_time")
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	limit := pageSize(req.Limit)
+	// Fetch one extra row to know whether there is a next page.
+	auditEvents, err := s.coordinator.ListAuditEvents(ctx, &model.ListAuditEvents{
+		TenantID: req.GetTenant(),
+		Start:    req.GetStartTime(),
+		End:      req.GetEndTime(),
+		AfterID:  afterID,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		log.Error("error ListAuditEvents", zap.String("request", req.String()), zap.Error(err))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if len(auditEvents) > int(limit) {
+		auditEvents = auditEvents[:limit]
+		res.NextPageToken, err = encodePageToken(&idPageToken{After: auditEvents[limit-1].ID})
+		if err != nil {
+			return res, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	res.Events = make([]*coordinatorpb.AuditEvent, 0, len(auditEvents))
+	for _, auditEvent := range auditEvents {
+		res.Events = append(res.Events, convertAuditEventToProto(auditEvent))
+	}
+	log.Info("ListAuditEvents success", zap.String("request", req.String()), zap.Int("count", len(res.Events)))
+	return res, nil
+}
@@ -75,7 +75,37 @@ func (s *SoftDeleteCleaner) run() {
 			}
 		}
 		log.Info("Deleted soft deleted collections", zap.Int("numDeleted", numDeleted))
+
+		s.cleanupDatabases()
+		s.cleanupTenants()
+	}
+}
+
+// cleanupDatabases hard deletes the soft deleted databases older than the max
+// age, along with whatever collections they still contain.
+func (s *SoftDeleteCleaner) cleanupDatabases() {
+	databases, err := s.coordinator.GetSoftDeletedDatabases(context.Background(), int32(s.limitPerCheck))
+	log.Info("Fetched soft deleted databases", zap.Int("num_databases", len(databases)))
+	if err != nil {
+		log.Error("Error while getting soft deleted databases", zap.Error(err))
+		return
+	}
+	numDeleted := 0
+	for _, database := range databases {
+		timeSinceDelete := time.Since(time.Unix(database.UpdatedAt, 0))
+		if timeSinceDelete > s.maxAge {
+			log.Info("Deleting soft deleted database", zap.String("database_id", database.ID), zap.Duration("time_since_delete", timeSinceDelete))
+			err := s.coordinator.CleanupSoftDeletedDatabase(context.Background(), database.ID)
+			if err != nil {
+				if err != common.ErrDatabaseNotFound {
+					log.Error("Error while deleting soft deleted database", zap.Error(err), zap.String("database", database.ID))
+				}
+			} else {
+				numDeleted++
+			}
+		}
 	}
+	log.Info("Deleted soft deleted databases", zap.Int("numDeleted", numDeleted))
 }
 
 func (s *SoftDeleteCleaner) Stop() error {
@@ -83,3 +113,30 @@ func (s *SoftDeleteCleaner) Stop() error {
 	s.ticker.Stop()
 	return nil
 }
+
+// cleanupTenants hard deletes the soft deleted tenants older than the max age,
+// along with whatever databases and collections they still contain.
+func (s *SoftDeleteCleaner) cleanupTenants() {
+	tenants, err := s.coordinator.GetSoftDeletedTenants(context.Background(), int32(s.limitPerCheck))
+	log.Info("Fetched soft deleted tenants", zap.Int("num_tenants", len(tenants)))
+	if err != nil {
+		log.Error("Error while getting soft deleted tenants", zap.Error(err))
+		return
+	}
+	numDeleted := 0
+	for _, tenant := range tenants {
+		timeSinceDelete := time.Since(time.Unix(tenant.UpdatedAt, 0))
+		if timeSinceDelete > s.maxAge {
+			log.Info("Deleting soft deleted tenant", zap.String("tenant", tenant.Name), zap.Duration("time_since_delete", timeSinceDelete))
+			err := s.coordinator.CleanupSoftDeletedTenant(context.Background(), tenant.Name)
+			if err != nil {
+				if err != common.ErrTenantNotFound {
+					log.Error("Error while deleting soft deleted tenant", zap.Error(err), zap.String("tenant", tenant.Name))
+				}
+			} else {
+				numDeleted++
+			}
+		}
+	}
+	log.Info("Deleted soft deleted tenants", zap.Int("numDeleted", numDeleted))
+}
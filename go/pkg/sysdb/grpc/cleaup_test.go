@@ -43,16 +43,16 @@ func (suite *CleanupTestSuite) SetupSuite() {
 	suite.s = s
 	suite.tenantName = "tenant_" + suite.T().Name()
 	suite.databaseName = "database_" + suite.T().Name()
-	DbId, err := dao.CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
+	DbId, err := dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), suite.tenantName, suite.databaseName)
 	suite.NoError(err)
 	suite.databaseId = DbId
 }
 
 func (suite *CleanupTestSuite) TearDownSuite() {
 	log.Info("teardown suite")
-	err := dao.CleanUpTestDatabase(suite.db, suite.tenantName, suite.databaseName)
+	err := dao.CleanUpTestDatabase(dao.NewMetaDomain(), suite.tenantName, suite.databaseName)
 	suite.NoError(err)
-	err = dao.CleanUpTestTenant(suite.db, suite.tenantName)
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), suite.tenantName)
 	suite.NoError(err)
 }
 
@@ -61,7 +61,7 @@ func (suite *CleanupTestSuite) TestSoftDeleteCleanup() {
 	collections := make([]string, 2)
 	for i := 0; i < 2; i++ {
 		collectionName := "cleanup_test_collection_" + strconv.Itoa(i)
-		collectionID, err := dao.CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+		collectionID, err := dao.CreateTestCollection(dao.NewMetaDomain(), collectionName, 128, suite.databaseId)
 		suite.NoError(err)
 		collections[i] = collectionID
 	}
@@ -97,7 +97,7 @@ func (suite *CleanupTestSuite) TestSoftDeleteCleanup() {
 
 	// Create a test collection
 	collectionName := "cleanup_test_collection_double_delete"
-	collectionID, err := dao.CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	collectionID, err := dao.CreateTestCollection(dao.NewMetaDomain(), collectionName, 128, suite.databaseId)
 	suite.NoError(err)
 
 	// Hard delete it once
@@ -3,6 +3,8 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 
@@ -56,6 +58,10 @@ func (s *Server) ResetState(context.Context, *emptypb.Empty) (*coordinatorpb.Res
 // https://github.com/chroma-core/chroma/issues/2390
 // https://github.com/chroma-core/chroma/pull/2810
 func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.CreateCollectionRequest) (*coordinatorpb.CreateCollectionResponse, error) {
+	return withIdempotencyKey(ctx, s, "CreateCollection", req, s.createCollection)
+}
+
+func (s *Server) createCollection(ctx context.Context, req *coordinatorpb.CreateCollectionRequest) (*coordinatorpb.CreateCollectionResponse, error) {
 	res := &coordinatorpb.CreateCollectionResponse{}
 
 	log.Info("CreateCollectionRequest", zap.Any("request", req))
@@ -107,9 +113,20 @@ func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.Create
 			Database:             req.Database,
 		}
 		res.Created = false
+		var quotaExceeded *common.QuotaExceededError
+		if errors.As(err, &quotaExceeded) {
+			return res, buildQuotaExceededGrpcError(quotaExceeded)
+		}
+		var invalidConfiguration *common.InvalidCollectionConfigurationError
+		if errors.As(err, &invalidConfiguration) {
+			return res, buildInvalidCollectionConfigurationGrpcError(invalidConfiguration)
+		}
 		if err == common.ErrCollectionUniqueConstraintViolation {
 			return res, grpcutils.BuildAlreadyExistsGrpcError(err.Error())
 		}
+		if err == common.ErrTenantSuspended || err == common.ErrCollectionSegmentScopes {
+			return res, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 	res.Collection = convertCollectionToProto(collection)
@@ -119,6 +136,7 @@ func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.Create
 }
 
 func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	ctx = replicaReadContext(ctx)
 	collectionID := req.Id
 	collectionName := req.Name
 	tenantID := req.Tenant
@@ -133,12 +151,59 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 		log.Error("GetCollections failed. collection id format error", zap.Error(err), zap.Stringp("collection_id", collectionID), zap.Stringp("collection_name", collectionName))
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
+	after, err := decodeCollectionPageToken(req.PageToken)
+	if err != nil {
+		log.Error("GetCollections failed. page token format error", zap.Error(err), zap.Stringp("page_token", req.PageToken))
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", err.Error())
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+
+	var where *model.CollectionMetadataFilter
+	if req.Where != nil {
+		where, err = convertWhereToModel(req.Where)
+		if err == nil {
+			err = where.Validate()
+		}
+		if err != nil {
+			log.Error("GetCollections failed. invalid where", zap.Error(err), zap.Any("where", req.Where))
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("where", err.Error())
+			if err != nil {
+				return res, err
+			}
+			return res, grpcError
+		}
+	}
 
-	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, collectionName, tenantID, databaseName, limit, offset)
+	collections, next, err := s.coordinator.ListCollections(ctx, &model.ListCollections{
+		ID:           parsedCollectionID,
+		Name:         collectionName,
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		Where:        where,
+		After:        after,
+		Limit:        limit,
+		Offset:       offset,
+	})
 	if err != nil {
 		log.Error("GetCollections failed. ", zap.Error(err), zap.Stringp("collection_id", collectionID), zap.Stringp("collection_name", collectionName))
+		if err == common.ErrInvalidMetadataFilter {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("where", err.Error())
+			if err != nil {
+				return res, err
+			}
+			return res, grpcError
+		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
+	if next != nil {
+		res.NextPageToken, err = encodeCollectionPageToken(next)
+		if err != nil {
+			return res, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
 	res.Collections = make([]*coordinatorpb.Collection, 0, len(collections))
 	for _, collection := range collections {
 		collectionpb := convertCollectionToProto(collection)
@@ -173,6 +238,183 @@ func (s *Server) DeleteCollection(ctx context.Context, req *coordinatorpb.Delete
 	return res, nil
 }
 
+func (s *Server) RestoreCollection(ctx context.Context, req *coordinatorpb.RestoreCollectionRequest) (*coordinatorpb.RestoreCollectionResponse, error) {
+	collectionID := req.GetId()
+	res := &coordinatorpb.RestoreCollectionResponse{}
+	parsedCollectionID, err := types.Parse(collectionID)
+	if err != nil {
+		log.Error("RestoreCollection failed", zap.Error(err), zap.String("collection_id", collectionID))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	restoreCollection := &model.RestoreCollection{
+		ID:           parsedCollectionID,
+		Name:         req.Name,
+		TenantID:     req.GetTenant(),
+		DatabaseName: req.GetDatabase(),
+	}
+	collection, err := s.coordinator.RestoreCollection(ctx, restoreCollection)
+	if err != nil {
+		log.Error("RestoreCollection failed", zap.Error(err), zap.String("collection_id", collectionID))
+		if err == common.ErrCollectionNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		if err == common.ErrCollectionUniqueConstraintViolation {
+			return res, grpcutils.BuildAlreadyExistsGrpcError(err.Error())
+		}
+		if err == common.ErrTenantSuspended {
+			return res, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
+		if err == common.ErrCollectionNameEmpty {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("name", err.Error())
+			if err != nil {
+				return res, err
+			}
+			return res, grpcError
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Collection = convertCollectionToProto(collection)
+	log.Info("RestoreCollection succeeded", zap.String("collection_id", collectionID), zap.String("collection_name", collection.Name))
+	return res, nil
+}
+
+func (s *Server) CountCollections(ctx context.Context, req *coordinatorpb.CountCollectionsRequest) (*coordinatorpb.CountCollectionsResponse, error) {
+	res := &coordinatorpb.CountCollectionsResponse{}
+	count, err := s.coordinator.CountCollections(ctx, req.GetTenant(), req.Database)
+	if err != nil {
+		log.Error("CountCollections failed", zap.Error(err), zap.String("tenant", req.GetTenant()), zap.Stringp("database", req.Database))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Count = count
+	return res, nil
+}
+
+// maxCheckCollectionIDs bounds the number of collections a CheckCollections
+// request may ask for.
+const maxCheckCollectionIDs = 1000
+
+func (s *Server) CheckCollections(ctx context.Context, req *coordinatorpb.CheckCollectionsRequest) (*coordinatorpb.CheckCollectionsResponse, error) {
+	res := &coordinatorpb.CheckCollectionsResponse{}
+	if len(req.GetCollectionIds()) > maxCheckCollectionIDs {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection_ids", fmt.Sprintf("at most %d collection ids can be checked at once", maxCheckCollectionIDs))
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	collectionIDs, err := s.coordinator.CheckCollections(ctx, req.GetCollectionIds())
+	if err != nil {
+		log.Error("CheckCollections failed", zap.Error(err), zap.Int("count", len(req.GetCollectionIds())))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.CollectionIds = collectionIDs
+	return res, nil
+}
+
+func (s *Server) ListDeletedCollections(ctx context.Context, req *coordinatorpb.ListDeletedCollectionsRequest) (*coordinatorpb.ListDeletedCollectionsResponse, error) {
+	res := &coordinatorpb.ListDeletedCollectionsResponse{}
+	if req.GetTenant() == "" {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("tenant", "tenant is required")
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	after, err := decodeCollectionPageToken(req.PageToken)
+	if err != nil {
+		log.Error("ListDeletedCollections failed. page token format error", zap.Error(err), zap.Stringp("page_token", req.PageToken))
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", err.Error())
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	limit := pageSize(req.Limit)
+	collections, next, err := s.coordinator.ListSoftDeletedCollections(ctx, &model.ListCollections{
+		TenantID:     req.GetTenant(),
+		DatabaseName: req.GetDatabase(),
+		After:        after,
+		Limit:        &limit,
+	})
+	if err != nil {
+		log.Error("ListDeletedCollections failed", zap.Error(err), zap.String("tenant", req.GetTenant()), zap.String("database", req.GetDatabase()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if next != nil {
+		res.NextPageToken, err = encodeCollectionPageToken(next)
+		if err != nil {
+			return res, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	res.Collections = make([]*coordinatorpb.DeletedCollection, 0, len(collections))
+	for _, collection := range collections {
+		res.Collections = append(res.Collections, &coordinatorpb.DeletedCollection{
+			Collection: convertCollectionToProto(collection),
+			DeletedAt:  collection.UpdatedAt,
+		})
+	}
+	log.Info("ListDeletedCollections succeeded", zap.String("tenant", req.GetTenant()), zap.String("database", req.GetDatabase()), zap.Int("count", len(res.Collections)))
+	return res, nil
+}
+
+func (s *Server) ForkCollection(ctx context.Context, req *coordinatorpb.ForkCollectionRequest) (*coordinatorpb.ForkCollectionResponse, error) {
+	res := &coordinatorpb.ForkCollectionResponse{}
+	sourceCollectionID, err := types.Parse(req.GetSourceCollectionId())
+	if err != nil {
+		log.Error("ForkCollection failed. source collection id format error", zap.Error(err), zap.String("source_collection_id", req.GetSourceCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	targetCollectionID, err := types.Parse(req.GetTargetCollectionId())
+	if err != nil {
+		log.Error("ForkCollection failed. target collection id format error", zap.Error(err), zap.String("target_collection_id", req.GetTargetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	forkCollection := &model.ForkCollection{
+		SourceCollectionID:   sourceCollectionID,
+		TargetCollectionID:   targetCollectionID,
+		TargetCollectionName: req.GetTargetCollectionName(),
+		TenantID:             req.GetTenant(),
+		DatabaseName:         req.GetDatabase(),
+	}
+	collection, err := s.coordinator.ForkCollection(ctx, forkCollection)
+	if err != nil {
+		log.Error("ForkCollection failed", zap.Error(err), zap.String("source_collection_id", req.GetSourceCollectionId()))
+		var quotaExceeded *common.QuotaExceededError
+		if errors.As(err, &quotaExceeded) {
+			return res, buildQuotaExceededGrpcError(quotaExceeded)
+		}
+		if err == common.ErrCollectionNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		if err == common.ErrCollectionUniqueConstraintViolation {
+			return res, grpcutils.BuildAlreadyExistsGrpcError(err.Error())
+		}
+		if err == common.ErrTenantSuspended || err == common.ErrLogServiceNotConfigured || err == common.ErrCollectionLogPurged || err == common.ErrCollectionLogPositionStale {
+			return res, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Collection = convertCollectionToProto(collection)
+	log.Info("ForkCollection succeeded", zap.String("source_collection_id", req.GetSourceCollectionId()), zap.String("collection_id", collection.ID.String()))
+	return res, nil
+}
+
+func (s *Server) GetSharedFilePaths(ctx context.Context, req *coordinatorpb.GetSharedFilePathsRequest) (*coordinatorpb.GetSharedFilePathsResponse, error) {
+	res := &coordinatorpb.GetSharedFilePathsResponse{}
+	collectionID, err := types.Parse(req.GetCollectionId())
+	if err != nil {
+		log.Error("GetSharedFilePaths failed. collection id format error", zap.Error(err), zap.String("collection_id", req.GetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	filePaths, err := s.coordinator.GetSharedFilePaths(ctx, collectionID, req.GetFilePaths())
+	if err != nil {
+		log.Error("GetSharedFilePaths failed", zap.Error(err), zap.String("collection_id", req.GetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.FilePaths = filePaths
+	return res, nil
+}
+
 func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.UpdateCollectionRequest) (*coordinatorpb.UpdateCollectionResponse, error) {
 	res := &coordinatorpb.UpdateCollectionResponse{}
 
@@ -184,9 +426,13 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 	}
 
 	updateCollection := &model.UpdateCollection{
-		ID:        parsedCollectionID,
-		Name:      req.Name,
-		Dimension: req.Dimension,
+		ID:                   parsedCollectionID,
+		Name:                 req.Name,
+		ConfigurationJsonStr: req.ConfigurationJsonStr,
+		Dimension:            req.Dimension,
+		MergeMetadata:        req.MergeMetadata,
+		NewDatabaseName:      req.NewDatabase,
+		ExpectedRowVersion:   req.ExpectedRowVersion,
 	}
 
 	resetMetadata := req.GetResetMetadata()
@@ -205,7 +451,11 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 		}
 	} else {
 		if metadata != nil {
-			modelMetadata, err := convertCollectionMetadataToModel(metadata)
+			convert := convertCollectionMetadataToModel
+			if req.MergeMetadata {
+				convert = convertCollectionMetadataUpdateToModel
+			}
+			modelMetadata, err := convert(metadata)
 			if err != nil {
 				log.Error("UpdateCollection failed. error converting collection metadata to model", zap.Error(err), zap.String("collection_id", collectionID))
 				return res, grpcutils.BuildInternalGrpcError(err.Error())
@@ -216,20 +466,69 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 		}
 	}
 
-	_, err = s.coordinator.UpdateCollection(ctx, updateCollection)
+	collection, err := s.coordinator.UpdateCollection(ctx, updateCollection)
 
 	if err != nil {
 		log.Error("UpdateCollection failed. error updating collection", zap.Error(err), zap.String("collection_id", collectionID))
+		var mismatch *common.RowVersionMismatchError
+		if errors.As(err, &mismatch) {
+			return res, buildRowVersionMismatchGrpcError(mismatch)
+		}
+		var quotaExceeded *common.QuotaExceededError
+		if errors.As(err, &quotaExceeded) {
+			return res, buildQuotaExceededGrpcError(quotaExceeded)
+		}
+		var invalidConfiguration *common.InvalidCollectionConfigurationError
+		if errors.As(err, &invalidConfiguration) {
+			return res, buildInvalidCollectionConfigurationGrpcError(invalidConfiguration)
+		}
 		if err == common.ErrCollectionUniqueConstraintViolation {
 			return res, grpcutils.BuildAlreadyExistsGrpcError(err.Error())
 		}
+		if err == common.ErrTenantSuspended || err == common.ErrCollectionDimensionImmutable || err == common.ErrCollectionSpaceImmutable {
+			return res, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
+		if err == common.ErrDatabaseNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 
+	res.RowVersion = collection.RowVersion
 	log.Info("UpdateCollection succeeded", zap.String("collection_id", collectionID))
 	return res, nil
 }
 
+func buildRowVersionMismatchGrpcError(mismatch *common.RowVersionMismatchError) error {
+	grpcError, err := grpcutils.BuildAbortedGrpcError(mismatch.Error(), mismatch.CurrentRowVersion)
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
+
+func buildQuotaExceededGrpcError(quotaExceeded *common.QuotaExceededError) error {
+	grpcError, err := grpcutils.BuildResourceExhaustedGrpcError(quotaExceeded.Error(), "tenant:"+quotaExceeded.TenantID, quotaExceeded.Quota, quotaExceeded.Limit, quotaExceeded.Requested)
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
+
+// buildInvalidCollectionConfigurationGrpcError reports the offending
+// configuration field as a field of configuration_json_str.
+func buildInvalidCollectionConfigurationGrpcError(invalidConfiguration *common.InvalidCollectionConfigurationError) error {
+	field := "configuration_json_str"
+	if invalidConfiguration.Field != "" {
+		field += "." + invalidConfiguration.Field
+	}
+	grpcError, err := grpcutils.BuildInvalidArgumentGrpcError(field, invalidConfiguration.Description)
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
+
 func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinatorpb.FlushCollectionCompactionRequest) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
 	_, err := json.Marshal(req)
 	if err != nil {
@@ -269,6 +568,9 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 	flushCollectionInfo, err := s.coordinator.FlushCollectionCompaction(ctx, FlushCollectionCompaction)
 	if err != nil {
 		log.Error("FlushCollectionCompaction failed", zap.Error(err), zap.String("collection_id", req.CollectionId), zap.Int32("collection_version", req.CollectionVersion), zap.Int64("log_position", req.LogPosition))
+		if err == common.ErrTenantSuspended {
+			return nil, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
 		return nil, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 	res := &coordinatorpb.FlushCollectionCompactionResponse{
@@ -279,3 +581,67 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 	log.Info("FlushCollectionCompaction succeeded", zap.String("collection_id", req.CollectionId), zap.Int32("collection_version", req.CollectionVersion), zap.Int64("log_position", req.LogPosition))
 	return res, nil
 }
+
+func (s *Server) ListCollectionVersions(ctx context.Context, req *coordinatorpb.ListCollectionVersionsRequest) (*coordinatorpb.ListCollectionVersionsResponse, error) {
+	res := &coordinatorpb.ListCollectionVersionsResponse{}
+	collectionID, err := types.Parse(req.GetCollectionId())
+	if err != nil {
+		log.Error("ListCollectionVersions failed. collection id format error", zap.Error(err), zap.String("collection_id", req.GetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	versions, err := s.coordinator.ListCollectionVersions(ctx, collectionID, pageSize(req.Limit))
+	if err != nil {
+		log.Error("ListCollectionVersions failed", zap.Error(err), zap.String("collection_id", req.GetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Versions = make([]*coordinatorpb.CollectionVersionInfo, 0, len(versions))
+	for _, version := range versions {
+		res.Versions = append(res.Versions, convertCollectionVersionToProto(version))
+	}
+	return res, nil
+}
+
+func (s *Server) GetSegmentsAtVersion(ctx context.Context, req *coordinatorpb.GetSegmentsAtVersionRequest) (*coordinatorpb.GetSegmentsAtVersionResponse, error) {
+	res := &coordinatorpb.GetSegmentsAtVersionResponse{}
+	collectionID, err := types.Parse(req.GetCollectionId())
+	if err != nil {
+		log.Error("GetSegmentsAtVersion failed. collection id format error", zap.Error(err), zap.String("collection_id", req.GetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	segments, err := s.coordinator.GetSegmentsAtVersion(ctx, collectionID, req.GetVersion())
+	if err != nil {
+		log.Error("GetSegmentsAtVersion failed", zap.Error(err), zap.String("collection_id", req.GetCollectionId()), zap.Int32("version", req.GetVersion()))
+		if err == common.ErrCollectionVersionNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Segments = make([]*coordinatorpb.Segment, 0, len(segments))
+	for _, segment := range segments {
+		res.Segments = append(res.Segments, convertSegmentToProto(segment))
+	}
+	return res, nil
+}
+
+func (s *Server) RollbackCollection(ctx context.Context, req *coordinatorpb.RollbackCollectionRequest) (*coordinatorpb.RollbackCollectionResponse, error) {
+	res := &coordinatorpb.RollbackCollectionResponse{}
+	collectionID, err := types.Parse(req.GetCollectionId())
+	if err != nil {
+		log.Error("RollbackCollection failed. collection id format error", zap.Error(err), zap.String("collection_id", req.GetCollectionId()))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	version, err := s.coordinator.RollbackCollection(ctx, collectionID, req.GetVersion())
+	if err != nil {
+		log.Error("RollbackCollection failed", zap.Error(err), zap.String("collection_id", req.GetCollectionId()), zap.Int32("version", req.GetVersion()))
+		if err == common.ErrCollectionVersionNotFound || err == common.ErrCollectionNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		if err == common.ErrTenantSuspended || err == common.ErrCollectionLogPurged || err == common.ErrCollectionVersionFilesCollected || err == common.ErrLogServiceNotConfigured {
+			return res, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Version = convertCollectionVersionToProto(version)
+	log.Info("RollbackCollection succeeded", zap.String("collection_id", req.GetCollectionId()), zap.Int32("version", req.GetVersion()), zap.Int32("new_version", version.Version))
+	return res, nil
+}
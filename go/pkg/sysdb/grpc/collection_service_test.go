@@ -2,7 +2,9 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,14 +12,19 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/google/uuid"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
@@ -39,8 +46,10 @@ func (suite *CollectionServiceTestSuite) SetupSuite() {
 	log.Info("setup suite")
 	suite.db = dbcore.ConfigDatabaseForTesting()
 	s, err := NewWithGrpcProvider(Config{
-		SystemCatalogProvider: "database",
-		Testing:               true}, grpcutils.Default, suite.db)
+		SystemCatalogProvider:       "database",
+		IdempotencyWindow:           time.Hour,
+		CollectionWatchPollInterval: 10 * time.Millisecond,
+		Testing:                     true}, grpcutils.Default, suite.db)
 	if err != nil {
 		suite.T().Fatalf("error creating server: %v", err)
 	}
@@ -50,16 +59,16 @@ func (suite *CollectionServiceTestSuite) SetupSuite() {
 	suite.catalog = coordinator.NewTableCatalog(txnImpl, metaDomain)
 	suite.tenantName = "tenant_" + suite.T().Name()
 	suite.databaseName = "database_" + suite.T().Name()
-	DbId, err := dao.CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
+	DbId, err := dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), suite.tenantName, suite.databaseName)
 	suite.NoError(err)
 	suite.databaseId = DbId
 }
 
 func (suite *CollectionServiceTestSuite) TearDownSuite() {
 	log.Info("teardown suite")
-	err := dao.CleanUpTestDatabase(suite.db, suite.tenantName, suite.databaseName)
+	err := dao.CleanUpTestDatabase(dao.NewMetaDomain(), suite.tenantName, suite.databaseName)
 	suite.NoError(err)
-	err = dao.CleanUpTestTenant(suite.db, suite.tenantName)
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), suite.tenantName)
 	suite.NoError(err)
 }
 
@@ -88,8 +97,9 @@ func testCollection(t *rapid.T) {
 			getOrCreate := false
 
 			createCollectionRequest := rapid.Custom[*coordinatorpb.CreateCollectionRequest](func(t *rapid.T) *coordinatorpb.CreateCollectionRequest {
+				collectionID := rapid.StringMatching(`[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}`).Draw(t, "collection_id")
 				return &coordinatorpb.CreateCollectionRequest{
-					Id:   rapid.StringMatching(`[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}`).Draw(t, "collection_id"),
+					Id:   collectionID,
 					Name: rapid.String().Draw(t, "collection_name"),
 					Metadata: &coordinatorpb.UpdateMetadata{
 						Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
@@ -99,6 +109,7 @@ func testCollection(t *rapid.T) {
 						},
 					},
 					GetOrCreate: &getOrCreate,
+					Segments:    collectionSegments(collectionID),
 				}
 			}).Draw(t, "create_collection_request")
 
@@ -195,19 +206,32 @@ func validateDatabase(suite *CollectionServiceTestSuite, collectionId string, co
 	}
 }
 
+// collectionSegments returns a segment of each scope a collection needs.
+func collectionSegments(collectionID string) []*coordinatorpb.Segment {
+	scopes := []coordinatorpb.SegmentScope{
+		coordinatorpb.SegmentScope_VECTOR,
+		coordinatorpb.SegmentScope_METADATA,
+		coordinatorpb.SegmentScope_RECORD,
+	}
+	segments := make([]*coordinatorpb.Segment, 0, len(scopes))
+	for _, scope := range scopes {
+		segments = append(segments, &coordinatorpb.Segment{
+			Id:         types.NewUniqueID().String(),
+			Type:       "test_type_" + strings.ToLower(scope.String()),
+			Scope:      scope,
+			Collection: collectionID,
+		})
+	}
+	return segments
+}
+
 func (suite *CollectionServiceTestSuite) TestCreateCollection() {
 	// Create a collection request
 	collectionName := "test_create_collection"
 	collectionID := types.UniqueID(uuid.New())
 	getOrCreate := false
 
-	segments := []*coordinatorpb.Segment{
-		{
-			Id:         types.UniqueID(uuid.New()).String(),
-			Collection: collectionID.String(),
-			Type:       "test_type_a",
-		},
-	}
+	segments := collectionSegments(collectionID.String())
 	req := &coordinatorpb.CreateCollectionRequest{
 		Id:       collectionID.String(),
 		Name:     collectionName,
@@ -259,21 +283,1048 @@ func (suite *CollectionServiceTestSuite) TestCreateCollection() {
 		Collection: collectionID.String(),
 	})
 	suite.NoError(err)
-	suite.Len(getSegmentsResp.Segments, 1)
-	suite.Equal(segments[0].Id, getSegmentsResp.Segments[0].Id)
-	suite.Equal(segments[0].Collection, getSegmentsResp.Segments[0].Collection)
-	suite.Equal(segments[0].Type, getSegmentsResp.Segments[0].Type)
+	suite.Len(getSegmentsResp.Segments, len(segments))
+	for _, segment := range getSegmentsResp.Segments {
+		suite.Equal(collectionID.String(), segment.Collection)
+	}
+
+	// A collection needs exactly one segment per scope.
+	otherCollectionID := types.NewUniqueID().String()
+	_, err = suite.s.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{
+		Id:       otherCollectionID,
+		Name:     "test_create_collection_missing_scope",
+		Database: suite.databaseName,
+		Tenant:   suite.tenantName,
+		Segments: collectionSegments(otherCollectionID)[:2],
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
 
 	// Clean up
-	err = dao.CleanUpTestCollection(suite.db, collectionID.String())
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID.String())
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionsPageToken() {
+	ctx := context.Background()
+	tenantName := "tenant_page_token"
+	databaseName := "database_page_token"
+	_, err := dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), tenantName, databaseName)
+	suite.NoError(err)
+	collectionIDs := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		collectionID := types.NewUniqueID().String()
+		_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     fmt.Sprintf("test_page_token_%d", i),
+			Tenant:   tenantName,
+			Database: databaseName,
+			Segments: collectionSegments(collectionID),
+		})
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	limit := int32(2)
+	res, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:   tenantName,
+		Database: databaseName,
+		Limit:    &limit,
+	})
+	suite.NoError(err)
+	suite.Len(res.Collections, 2)
+	suite.NotEmpty(res.NextPageToken)
+	listedIDs := []string{res.Collections[0].Id, res.Collections[1].Id}
+
+	res, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:    tenantName,
+		Database:  databaseName,
+		Limit:     &limit,
+		PageToken: &res.NextPageToken,
+	})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	suite.Empty(res.NextPageToken)
+	listedIDs = append(listedIDs, res.Collections[0].Id)
+	suite.ElementsMatch(collectionIDs, listedIDs)
+
+	invalidToken := "not a page token"
+	_, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:    tenantName,
+		Database:  databaseName,
+		PageToken: &invalidToken,
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	for _, collectionID := range collectionIDs {
+		err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+	err = dao.CleanUpTestDatabase(dao.NewMetaDomain(), tenantName, databaseName)
+	suite.NoError(err)
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionsWhere() {
+	ctx := context.Background()
+	collectionIDs := make([]string, 0, 2)
+	for i, env := range []string{"prod", "dev"} {
+		collectionID := types.NewUniqueID().String()
+		_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     fmt.Sprintf("test_where_%d", i),
+			Tenant:   suite.tenantName,
+			Database: suite.databaseName,
+			Segments: collectionSegments(collectionID),
+			Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+				"env": {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: env}},
+			}},
+		})
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	res, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Where: &coordinatorpb.Where{Where: &coordinatorpb.Where_DirectComparison{DirectComparison: &coordinatorpb.DirectComparison{
+			Key: "env",
+			Comparison: &coordinatorpb.DirectComparison_StringListOperand{StringListOperand: &coordinatorpb.StringListComparison{
+				Values:       []string{"dev", "staging"},
+				ListOperator: coordinatorpb.ListOperator_IN,
+			}},
+		}}},
+	})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	suite.Equal(collectionIDs[1], res.Collections[0].Id)
+
+	res, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Where: &coordinatorpb.Where{Where: &coordinatorpb.Where_DirectComparison{DirectComparison: &coordinatorpb.DirectComparison{
+			Key: "env",
+			Comparison: &coordinatorpb.DirectComparison_SingleStringOperand{SingleStringOperand: &coordinatorpb.SingleStringComparison{
+				Value:      "dev",
+				Comparator: coordinatorpb.GenericComparator_NE,
+			}},
+		}}},
+	})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	suite.Equal(collectionIDs[0], res.Collections[0].Id)
+
+	_, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Where:    &coordinatorpb.Where{Where: &coordinatorpb.Where_Children{Children: &coordinatorpb.WhereChildren{}}},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	for _, collectionID := range collectionIDs {
+		err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionsCached() {
+	ctx := context.Background()
+	suite.s.coordinator.SetCatalogCache(coordinator.CatalogCacheConfig{Size: 10, TTL: time.Minute})
+	defer suite.s.coordinator.SetCatalogCache(coordinator.CatalogCacheConfig{})
+	collectionID := types.NewUniqueID().String()
+	_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "test_cached",
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(collectionID),
+	})
+	suite.NoError(err)
+	res, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+
+	// A change the catalog does not see is not seen by lookups by id either,
+	// they are served from the cache.
+	err = suite.db.Table("collections").Where("id = ?", collectionID).Update("name", "test_cached_behind").Error
+	suite.NoError(err)
+	res, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	suite.Equal("test_cached", res.Collections[0].Name)
+	res, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID, Tenant: suite.tenantName, Database: "other_database"})
+	suite.NoError(err)
+	suite.Empty(res.Collections)
+
+	// Other listings and changes through the catalog are not.
+	name := "test_cached_behind"
+	res, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Name: &name, Tenant: suite.tenantName, Database: suite.databaseName})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	newName := "test_cached_renamed"
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{Id: collectionID, Name: &newName})
+	suite.NoError(err)
+	res, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	suite.Equal(newName, res.Collections[0].Name)
+
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_CheckCollections() {
+	ctx := context.Background()
+	collectionIDs := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		collectionID := types.NewUniqueID().String()
+		_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     fmt.Sprintf("test_check_collections_%d", i),
+			Tenant:   suite.tenantName,
+			Database: suite.databaseName,
+			Segments: collectionSegments(collectionID),
+		})
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	err := suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           types.MustParse(collectionIDs[1]),
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	}, true)
+	suite.NoError(err)
+
+	res, err := suite.s.CheckCollections(ctx, &coordinatorpb.CheckCollectionsRequest{
+		CollectionIds: []string{collectionIDs[0], collectionIDs[1], types.NewUniqueID().String()},
+	})
+	suite.NoError(err)
+	// Soft deleted collections are kept until they are hard deleted.
+	suite.ElementsMatch([]string{collectionIDs[0], collectionIDs[1]}, res.CollectionIds)
+
+	_, err = suite.s.CheckCollections(ctx, &coordinatorpb.CheckCollectionsRequest{
+		CollectionIds: make([]string, maxCheckCollectionIDs+1),
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	for _, collectionID := range collectionIDs {
+		err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_CountCollections() {
+	ctx := context.Background()
+	collectionIDs := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		collectionID := types.NewUniqueID().String()
+		_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     fmt.Sprintf("test_count_collections_%d", i),
+			Tenant:   suite.tenantName,
+			Database: suite.databaseName,
+			Segments: collectionSegments(collectionID),
+		})
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	err := suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           types.MustParse(collectionIDs[1]),
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	}, true)
+	suite.NoError(err)
+
+	res, err := suite.s.CountCollections(ctx, &coordinatorpb.CountCollectionsRequest{
+		Tenant:   suite.tenantName,
+		Database: &suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.Count)
+
+	for _, collectionID := range collectionIDs {
+		err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_UpdateCollectionMergeMetadata() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID().String()
+	_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "test_merge_metadata",
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(collectionID),
+		Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"owner": {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: "alice"}},
+			"env":   {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: "dev"}},
+		}},
+	})
+	suite.NoError(err)
+
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id: collectionID,
+		MetadataUpdate: &coordinatorpb.UpdateCollectionRequest_Metadata{Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"env":            {},
+			"schema_version": {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: 2}},
+		}}},
+		MergeMetadata: true,
+	})
+	suite.NoError(err)
+
+	res, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(res.Collections, 1)
+	metadata := res.Collections[0].Metadata.Metadata
+	suite.Len(metadata, 2)
+	suite.Equal("alice", metadata["owner"].GetStringValue())
+	suite.Equal(int64(2), metadata["schema_version"].GetIntValue())
+
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_UpdateCollectionExpectedRowVersion() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID().String()
+	_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "test_expected_row_version",
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(collectionID),
+	})
+	suite.NoError(err)
+
+	newName := "test_expected_row_version_renamed"
+	expected := int64(0)
+	res, err := suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:                 collectionID,
+		Name:               &newName,
+		ExpectedRowVersion: &expected,
+	})
+	suite.NoError(err)
+	suite.Equal(int64(1), res.RowVersion)
+
+	// Replaying the same expected row version loses the race.
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:                 collectionID,
+		Name:               &newName,
+		ExpectedRowVersion: &expected,
+	})
+	suite.Equal(codes.Aborted, status.Code(err))
+
+	getRes, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(getRes.Collections, 1)
+	suite.Equal(int64(1), getRes.Collections[0].RowVersion)
+
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_CreateWithIdempotencyKey() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, uuid.NewString()))
+	collectionID := types.NewUniqueID().String()
+	req := &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "test_idempotency_key",
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(collectionID),
+	}
+	res, err := suite.s.CreateCollection(ctx, req)
+	suite.NoError(err)
+	suite.True(res.Created)
+
+	// A retry gets the original response instead of AlreadyExists.
+	retry, err := suite.s.CreateCollection(ctx, req)
+	suite.NoError(err)
+	suite.True(proto.Equal(res, retry))
+
+	_, err = suite.s.CreateCollection(context.Background(), req)
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+
+	// The key cannot be reused for another request.
+	other := proto.Clone(req).(*coordinatorpb.CreateCollectionRequest)
+	other.Id = types.NewUniqueID().String()
+	other.Name = "test_idempotency_key_other"
+	_, err = suite.s.CreateCollection(ctx, other)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// A request runs once even if it is retried while running.
+	pendingCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, uuid.NewString()))
+	pendingReq := proto.Clone(other).(*coordinatorpb.CreateCollectionRequest)
+	requestHash, err := hashRequest(pendingReq)
+	suite.NoError(err)
+	reserved, err := suite.catalog.ReserveIdempotencyKey(ctx, &model.IdempotencyRecord{
+		Method:      "CreateCollection",
+		Key:         idempotencyKeyFromContext(pendingCtx),
+		RequestHash: requestHash,
+		CreatedAt:   time.Now(),
+		ReservedAt:  time.Now(),
+	}, time.Now().Add(-defaultIdempotencyLease))
+	suite.NoError(err)
+	suite.Nil(reserved)
+	_, err = suite.s.CreateCollection(pendingCtx, pendingReq)
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// A reservation left over by a request that did not finish is taken over
+	// once its lease expires.
+	expiredCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, uuid.NewString()))
+	expiredID := types.NewUniqueID().String()
+	expiredReq := &coordinatorpb.CreateCollectionRequest{
+		Id:       expiredID,
+		Name:     "test_idempotency_key_expired",
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(expiredID),
+	}
+	requestHash, err = hashRequest(expiredReq)
+	suite.NoError(err)
+	reservedAt := time.Now().Add(-2 * defaultIdempotencyLease)
+	reserved, err = suite.catalog.ReserveIdempotencyKey(ctx, &model.IdempotencyRecord{
+		Method:      "CreateCollection",
+		Key:         idempotencyKeyFromContext(expiredCtx),
+		RequestHash: requestHash,
+		CreatedAt:   reservedAt,
+		ReservedAt:  reservedAt,
+	}, reservedAt.Add(-defaultIdempotencyLease))
+	suite.NoError(err)
+	suite.Nil(reserved)
+	res, err = suite.s.CreateCollection(expiredCtx, expiredReq)
+	suite.NoError(err)
+	suite.True(res.Created)
+	retry, err = suite.s.CreateCollection(expiredCtx, expiredReq)
+	suite.NoError(err)
+	suite.True(proto.Equal(res, retry))
+
+	// A failed request can be retried with the same key.
+	failedCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, uuid.NewString()))
+	failedID := types.NewUniqueID().String()
+	failedReq := &coordinatorpb.CreateCollectionRequest{
+		Id:       failedID,
+		Name:     "test_idempotency_key_failed",
+		Tenant:   suite.tenantName,
+		Database: "test_idempotency_key_missing_database",
+		Segments: collectionSegments(failedID),
+	}
+	_, err = suite.s.CreateCollection(failedCtx, failedReq)
+	suite.Error(err)
+	failedReq.Database = suite.databaseName
+	res, err = suite.s.CreateCollection(failedCtx, failedReq)
+	suite.NoError(err)
+	suite.True(res.Created)
+
+	databaseCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, uuid.NewString()))
+	databaseReq := &coordinatorpb.CreateDatabaseRequest{
+		Id:     uuid.NewString(),
+		Name:   "test_idempotency_key_database",
+		Tenant: suite.tenantName,
+	}
+	_, err = suite.s.CreateDatabase(databaseCtx, databaseReq)
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(databaseCtx, databaseReq)
+	suite.NoError(err)
+
+	// Once purged, the key no longer replays the response.
+	purged, err := suite.catalog.PurgeIdempotencyKeys(ctx, time.Now().Add(time.Hour))
+	suite.NoError(err)
+	suite.GreaterOrEqual(purged, 4)
+	_, err = suite.s.CreateCollection(ctx, req)
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), req.Id)
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), failedReq.Id)
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), expiredReq.Id)
+	suite.NoError(err)
+	_, err = suite.s.DeleteDatabase(context.Background(), &coordinatorpb.DeleteDatabaseRequest{
+		Name:   databaseReq.Name,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+}
+
+// watchCollectionsStream collects the events sent to a watcher and cancels
+// the watch once the expected number of events is received.
+type watchCollectionsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	want   int
+	events []*coordinatorpb.CollectionEvent
+}
+
+func newWatchCollectionsStream(want int) *watchCollectionsStream {
+	return newWatchCollectionsStreamWithTimeout(want, 5*time.Second)
+}
+
+func newWatchCollectionsStreamWithTimeout(want int, timeout time.Duration) *watchCollectionsStream {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	return &watchCollectionsStream{ctx: ctx, cancel: cancel, want: want}
+}
+
+func (w *watchCollectionsStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchCollectionsStream) Send(res *coordinatorpb.WatchCollectionsResponse) error {
+	w.events = append(w.events, res.Events...)
+	if len(w.events) >= w.want {
+		w.cancel()
+	}
+	return nil
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_WatchCollections() {
+	ctx := context.Background()
+	databaseName := "test_watch_collections"
+	otherDatabaseName := "test_watch_collections_other"
+	for _, name := range []string{databaseName, otherDatabaseName} {
+		_, err := suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+			Id:     uuid.NewString(),
+			Name:   name,
+			Tenant: suite.tenantName,
+		})
+		suite.NoError(err)
+	}
+	_, sinceRevision, err := suite.catalog.GetNotificationRevisionRange(ctx)
+	suite.NoError(err)
+
+	collectionID := types.NewUniqueID().String()
+	_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "test_watch_collections",
+		Tenant:   suite.tenantName,
+		Database: databaseName,
+		Segments: collectionSegments(collectionID),
+	})
+	suite.NoError(err)
+	newName := "test_watch_collections_renamed"
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:   collectionID,
+		Name: &newName,
+	})
+	suite.NoError(err)
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:          collectionID,
+		NewDatabase: &otherDatabaseName,
+	})
+	suite.NoError(err)
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{
+		Id:       collectionID,
+		Tenant:   suite.tenantName,
+		Database: otherDatabaseName,
+	})
+	suite.NoError(err)
+
+	stream := newWatchCollectionsStream(3)
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant:        &suite.tenantName,
+		Database:      &databaseName,
+		SinceRevision: &sinceRevision,
+	}, stream)
+	suite.NoError(err)
+	suite.Len(stream.events, 3)
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_CREATED, stream.events[0].Type)
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_UPDATED, stream.events[1].Type)
+	// Moving the collection out of the database deletes it from the database.
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_DELETED, stream.events[2].Type)
+	for _, event := range stream.events {
+		suite.Equal(collectionID, event.CollectionId)
+		suite.Equal(suite.tenantName, event.Tenant)
+		suite.Equal(databaseName, event.Database)
+	}
+
+	// Moving the collection into the database creates it in the database.
+	otherStream := newWatchCollectionsStream(2)
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant:        &suite.tenantName,
+		Database:      &otherDatabaseName,
+		SinceRevision: &sinceRevision,
+	}, otherStream)
+	suite.NoError(err)
+	suite.Len(otherStream.events, 2)
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_CREATED, otherStream.events[0].Type)
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_DELETED, otherStream.events[1].Type)
+	suite.Equal(stream.events[2].Revision+1, otherStream.events[0].Revision)
+	for _, event := range otherStream.events {
+		suite.Equal(otherDatabaseName, event.Database)
+	}
+
+	// Resuming from a revision only replays the later events.
+	resumed := newWatchCollectionsStream(2)
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant:        &suite.tenantName,
+		Database:      &databaseName,
+		SinceRevision: &stream.events[0].Revision,
+	}, resumed)
+	suite.NoError(err)
+	suite.Len(resumed.events, 2)
+	for i, event := range resumed.events {
+		suite.True(proto.Equal(stream.events[i+1], event))
+	}
+
+	// Without a revision, the watch starts from the latest one.
+	latest := newWatchCollectionsStreamWithTimeout(1, 100*time.Millisecond)
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant: &suite.tenantName,
+	}, latest)
+	suite.NoError(err)
+	suite.Empty(latest.events)
+
+	// A database is only unique within its tenant.
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Database: &databaseName,
+	}, newWatchCollectionsStream(1))
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	missing := "test_watch_collections_missing"
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant:   &suite.tenantName,
+		Database: &missing,
+	}, newWatchCollectionsStream(1))
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// Purged revisions cannot be watched from, the latest one is kept.
+	_, err = suite.catalog.PurgeNotifications(ctx, time.Now().Add(time.Hour))
+	suite.NoError(err)
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant:        &suite.tenantName,
+		SinceRevision: &sinceRevision,
+	}, newWatchCollectionsStream(1))
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	lastRevision := otherStream.events[1].Revision
+	err = suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+		Tenant:        &suite.tenantName,
+		SinceRevision: &lastRevision,
+	}, newWatchCollectionsStreamWithTimeout(1, 100*time.Millisecond))
+	suite.NoError(err)
+
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+	suite.NoError(err)
+	for _, name := range []string{databaseName, otherDatabaseName} {
+		_, err = suite.s.DeleteDatabase(ctx, &coordinatorpb.DeleteDatabaseRequest{
+			Name:   name,
+			Tenant: suite.tenantName,
+		})
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_WatchCollectionsLateCommit() {
+	watchGapTimeout := suite.s.watchGapTimeout
+	suite.s.watchGapTimeout = 50 * time.Millisecond
+	defer func() { suite.s.watchGapTimeout = watchGapTimeout }()
+	_, sinceRevision, err := suite.catalog.GetNotificationRevisionRange(context.Background())
+	suite.NoError(err)
+
+	// A slow change allocates its revision before a change that commits first.
+	collectionID := types.NewUniqueID().String()
+	slow := &dbmodel.Notification{
+		CollectionID: collectionID,
+		TenantID:     suite.tenantName,
+		DatabaseID:   suite.databaseId,
+		DatabaseName: suite.databaseName,
+		Type:         model.NotificationTypeUpdateCollection,
+	}
+	fast := *slow
+	suite.NoError(suite.db.Create(slow).Error)
+	suite.NoError(suite.db.Create(&fast).Error)
+	suite.NoError(suite.db.Delete(&dbmodel.Notification{}, slow.ID).Error)
+
+	stream := newWatchCollectionsStream(2)
+	done := make(chan error)
+	go func() {
+		done <- suite.s.WatchCollections(&coordinatorpb.WatchCollectionsRequest{
+			Tenant:        &suite.tenantName,
+			Database:      &suite.databaseName,
+			SinceRevision: &sinceRevision,
+		}, stream)
+	}()
+	// The slow change commits after the watch gave up on its revision.
+	time.Sleep(300 * time.Millisecond)
+	suite.NoError(suite.db.Create(slow).Error)
+
+	suite.NoError(<-done)
+	suite.Len(stream.events, 2)
+	suite.Equal(fast.ID, stream.events[0].Revision)
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_UPDATED, stream.events[0].Type)
+	suite.Equal(slow.ID, stream.events[1].Revision)
+	suite.Equal(coordinatorpb.CollectionEventType_COLLECTION_RESET, stream.events[1].Type)
+	suite.Equal(collectionID, stream.events[1].CollectionId)
+	suite.Equal(suite.databaseName, stream.events[1].Database)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_ListAuditEvents() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(coordinator.AuditActorHeader, "auditor"))
+	tenantName := "test_list_audit_events"
+	databaseName := "test_list_audit_events"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     uuid.NewString(),
+		Name:   databaseName,
+		Tenant: tenantName,
+	})
+	suite.NoError(err)
+
+	limit := int32(1)
+	req := &coordinatorpb.ListAuditEventsRequest{Tenant: &tenantName, Limit: &limit}
+	operations := make([]string, 0)
+	for {
+		res, err := suite.s.ListAuditEvents(ctx, req)
+		suite.NoError(err)
+		for _, event := range res.Events {
+			suite.Equal("auditor", event.Actor)
+			suite.Equal(tenantName, event.TenantId)
+			suite.Nil(event.Before)
+			suite.NotNil(event.After)
+			operations = append(operations, event.Operation)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = &res.NextPageToken
+	}
+	suite.Equal([]string{model.AuditOperationCreateTenant, model.AuditOperationCreateDatabase}, operations)
+
+	invalidToken := "not a page token"
+	_, err = suite.s.ListAuditEvents(ctx, &coordinatorpb.ListAuditEventsRequest{PageToken: &invalidToken})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	startTime, endTime := time.Now().Unix(), time.Now().Add(-time.Hour).Unix()
+	_, err = suite.s.ListAuditEvents(ctx, &coordinatorpb.ListAuditEventsRequest{StartTime: &startTime, EndTime: &endTime})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	err = dao.CleanUpTestDatabase(dao.NewMetaDomain(), tenantName, databaseName)
+	suite.NoError(err)
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_TenantQuota() {
+	ctx := context.Background()
+	tenantName := "test_tenant_quota"
+	databaseName := "test_tenant_quota"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+	suite.NoError(err)
+
+	getRes, err := suite.s.GetTenantQuota(ctx, &coordinatorpb.GetTenantQuotaRequest{Tenant: tenantName})
+	suite.NoError(err)
+	suite.Nil(getRes.Quota)
+	suite.Equal(int32(0), getRes.EffectiveQuota.GetMaxDatabases())
+	_, err = suite.s.GetTenantQuota(ctx, &coordinatorpb.GetTenantQuotaRequest{Tenant: "missing_tenant"})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	maxDatabases := int32(1)
+	setRes, err := suite.s.SetTenantQuota(ctx, &coordinatorpb.SetTenantQuotaRequest{
+		Tenant: tenantName,
+		Quota:  &coordinatorpb.TenantQuota{MaxDatabases: &maxDatabases},
+	})
+	suite.NoError(err)
+	suite.Equal(maxDatabases, setRes.EffectiveQuota.GetMaxDatabases())
+	getRes, err = suite.s.GetTenantQuota(ctx, &coordinatorpb.GetTenantQuotaRequest{Tenant: tenantName})
+	suite.NoError(err)
+	suite.Equal(maxDatabases, getRes.Quota.GetMaxDatabases())
+	suite.Nil(getRes.Quota.MaxCollectionDimension)
+
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     uuid.NewString(),
+		Name:   databaseName,
+		Tenant: tenantName,
+	})
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     uuid.NewString(),
+		Name:   "test_tenant_quota_over",
+		Tenant: tenantName,
+	})
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(codes.ResourceExhausted, st.Code())
+	var quotaFailure *errdetails.QuotaFailure
+	var errorInfo *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.QuotaFailure:
+			quotaFailure = d
+		case *errdetails.ErrorInfo:
+			errorInfo = d
+		}
+	}
+	suite.Require().NotNil(quotaFailure)
+	suite.Require().Len(quotaFailure.Violations, 1)
+	suite.Equal("tenant:"+tenantName, quotaFailure.Violations[0].Subject)
+	suite.Require().NotNil(errorInfo)
+	suite.Equal(common.QuotaMaxDatabases, errorInfo.Metadata["quota"])
+	suite.Equal("1", errorInfo.Metadata["limit"])
+	suite.Equal("2", errorInfo.Metadata["requested"])
+
+	err = dao.CleanUpTestDatabase(dao.NewMetaDomain(), tenantName, databaseName)
+	suite.NoError(err)
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_CollectionConfiguration() {
+	ctx := context.Background()
+	requireFieldViolation := func(err error, field string) {
+		st, ok := status.FromError(err)
+		suite.True(ok)
+		suite.Equal(codes.InvalidArgument, st.Code())
+		var badRequest *errdetails.BadRequest
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.BadRequest); ok {
+				badRequest = d
+			}
+		}
+		suite.Require().NotNil(badRequest)
+		suite.Require().Len(badRequest.FieldViolations, 1)
+		suite.Equal(field, badRequest.FieldViolations[0].Field)
+	}
+
+	collectionID := types.NewUniqueID()
+	_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:                   collectionID.String(),
+		Name:                 "collection_service_test_configuration",
+		ConfigurationJsonStr: `{"hnsw_configuration": {"space": "l2", "ef_search": 0}}`,
+		Tenant:               suite.tenantName,
+		Database:             suite.databaseName,
+		Segments:             collectionSegments(collectionID.String()),
+	})
+	requireFieldViolation(err, "configuration_json_str.hnsw_configuration.ef_search")
+
+	_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:                   collectionID.String(),
+		Name:                 "collection_service_test_configuration",
+		ConfigurationJsonStr: `{"hnsw_configuration": {"space": "l2", "ef_search": 10}}`,
+		Tenant:               suite.tenantName,
+		Database:             suite.databaseName,
+		Segments:             collectionSegments(collectionID.String()),
+	})
+	suite.NoError(err)
+
+	configurationUpdate := `{"hnsw_configuration": {"ef_search": 50}}`
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:                   collectionID.String(),
+		ConfigurationJsonStr: &configurationUpdate,
+	})
+	suite.NoError(err)
+	configurationUpdate = `{"hnsw_configuration": {"M": 32}}`
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:                   collectionID.String(),
+		ConfigurationJsonStr: &configurationUpdate,
+	})
+	requireFieldViolation(err, "configuration_json_str.hnsw_configuration.M")
+	configurationUpdate = `{"hnsw_configuration": {"space": "ip"}}`
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:                   collectionID.String(),
+		ConfigurationJsonStr: &configurationUpdate,
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// The dimension can only go from unset to set.
+	dimension := int32(3)
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:        collectionID.String(),
+		Dimension: &dimension,
+	})
+	suite.NoError(err)
+	dimension = 4
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{
+		Id:        collectionID.String(),
+		Dimension: &dimension,
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	collectionIDStr := collectionID.String()
+	getRes, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Id:       &collectionIDStr,
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Require().Len(getRes.Collections, 1)
+	suite.JSONEq(`{"hnsw_configuration": {"space": "l2", "ef_search": 50}}`, getRes.Collections[0].ConfigurationJsonStr)
+
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{
+		Id:       collectionIDStr,
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+	})
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_RestoreCollection() {
+	ctx := context.Background()
+	collectionName := "collection_service_test_restore_collection"
+	collectionID := types.NewUniqueID()
+	_, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID.String(),
+		Name:     collectionName,
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(collectionID.String()),
+	})
+	suite.NoError(err)
+	// The test server hard deletes, soft delete through the catalog instead.
+	err = suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           collectionID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	}, true)
+	suite.NoError(err)
+
+	// The deleted collection shows up in the trash bin.
+	listResp, err := suite.s.ListDeletedCollections(ctx, &coordinatorpb.ListDeletedCollectionsRequest{
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+	})
+	suite.NoError(err)
+	found := false
+	for _, deleted := range listResp.Collections {
+		if deleted.Collection.Id == collectionID.String() {
+			found = true
+			suite.Equal(collectionName, deleted.Collection.Name)
+			suite.Less(int64(0), deleted.DeletedAt)
+		}
+	}
+	suite.True(found)
+
+	// Take the name with a new collection, restoring under it conflicts.
+	otherID := types.NewUniqueID()
+	_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       otherID.String(),
+		Name:     collectionName,
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Segments: collectionSegments(otherID.String()),
+	})
 	suite.NoError(err)
+	_, err = suite.s.RestoreCollection(ctx, &coordinatorpb.RestoreCollectionRequest{
+		Id:       collectionID.String(),
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Name:     &collectionName,
+	})
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+	// The conflict is checked in the database of the collection, even when
+	// the request leaves it out.
+	_, err = suite.s.RestoreCollection(ctx, &coordinatorpb.RestoreCollectionRequest{
+		Id:   collectionID.String(),
+		Name: &collectionName,
+	})
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+
+	newName := collectionName + "_restored"
+	// A suspended tenant cannot restore collections.
+	err = suite.catalog.SetTenantSuspended(ctx, suite.tenantName, true)
+	suite.NoError(err)
+	_, err = suite.s.RestoreCollection(ctx, &coordinatorpb.RestoreCollectionRequest{
+		Id:       collectionID.String(),
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Name:     &newName,
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	err = suite.catalog.SetTenantSuspended(ctx, suite.tenantName, false)
+	suite.NoError(err)
+
+	resp, err := suite.s.RestoreCollection(ctx, &coordinatorpb.RestoreCollectionRequest{
+		Id:       collectionID.String(),
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+		Name:     &newName,
+	})
+	suite.NoError(err)
+	suite.Equal(collectionID.String(), resp.Collection.Id)
+	suite.Equal(newName, resp.Collection.Name)
+
+	// A collection that is not soft deleted cannot be restored.
+	_, err = suite.s.RestoreCollection(ctx, &coordinatorpb.RestoreCollectionRequest{
+		Id:       collectionID.String(),
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID.String())
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), otherID.String())
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_ListDeletedCollections() {
+	ctx := context.Background()
+	_, err := suite.s.ListDeletedCollections(ctx, &coordinatorpb.ListDeletedCollectionsRequest{
+		Database: suite.databaseName,
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	collectionIDs := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		collectionID := types.NewUniqueID()
+		_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID.String(),
+			Name:     fmt.Sprintf("collection_service_test_list_deleted_collections_%d", i),
+			Tenant:   suite.tenantName,
+			Database: suite.databaseName,
+			Segments: collectionSegments(collectionID.String()),
+		})
+		suite.NoError(err)
+		err = suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+			ID:           collectionID,
+			TenantID:     suite.tenantName,
+			DatabaseName: suite.databaseName,
+		}, true)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID.String())
+	}
+
+	listed := make([]string, 0, len(collectionIDs))
+	limit := int32(2)
+	var pageToken *string
+	for {
+		res, err := suite.s.ListDeletedCollections(ctx, &coordinatorpb.ListDeletedCollectionsRequest{
+			Tenant:    suite.tenantName,
+			Database:  suite.databaseName,
+			Limit:     &limit,
+			PageToken: pageToken,
+		})
+		suite.NoError(err)
+		suite.LessOrEqual(len(res.Collections), int(limit))
+		for _, deleted := range res.Collections {
+			listed = append(listed, deleted.Collection.Id)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = &res.NextPageToken
+	}
+	suite.ElementsMatch(collectionIDs, listed)
+
+	invalidToken := "not a page token"
+	_, err = suite.s.ListDeletedCollections(ctx, &coordinatorpb.ListDeletedCollectionsRequest{
+		Tenant:    suite.tenantName,
+		PageToken: &invalidToken,
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	for _, collectionID := range collectionIDs {
+		err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
 }
 
 func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction() {
 	log.Info("TestServer_FlushCollectionCompaction")
 	// create test collection
 	collectionName := "collection_service_test_flush_collection_compaction"
-	collectionID, err := dao.CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	collectionID, err := dao.CreateTestCollection(dao.NewMetaDomain(), collectionName, 128, suite.databaseId)
 	suite.NoError(err)
 
 	// flush collection compaction
@@ -421,7 +1472,7 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 	validateDatabase(suite, collectionID, collection, filePaths)
 
 	// clean up
-	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	err = dao.CleanUpTestCollection(dao.NewMetaDomain(), collectionID)
 	suite.NoError(err)
 }
 
@@ -0,0 +1,247 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultWatchPollInterval  = time.Second
+	defaultWatchGapTimeout    = 10 * time.Second
+	watchCollectionsBatchSize = 100
+	notificationPurgeInterval = time.Minute
+)
+
+// WatchCollections streams the changes to collections read from the
+// notification outbox. The outbox is polled, a full batch is followed by the
+// next one right away so that a watcher catches up quickly.
+//
+// Revisions are allocated before the changes commit, so a change can become
+// visible after one with a higher revision. Changes are only streamed up to
+// the settled revision, below which every revision is committed or was given
+// up on after the gap timeout. A revision given up on is still looked for
+// until it is purged, if it commits late a COLLECTION_RESET event tells the
+// watcher to read the collection again.
+func (s *Server) WatchCollections(req *coordinatorpb.WatchCollectionsRequest, stream grpc.ServerStreamingServer[coordinatorpb.WatchCollectionsResponse]) error {
+	ctx := stream.Context()
+	listNotifications := &model.ListNotifications{
+		TenantID: req.GetTenant(),
+		Limit:    watchCollectionsBatchSize,
+	}
+	if req.Database != nil {
+		if req.GetTenant() == "" {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("database", "a database can only be watched with its tenant")
+			if err != nil {
+				return err
+			}
+			return grpcError
+		}
+		// Resolve the database once, it is renamed if soft deleted while watched.
+		database, err := s.coordinator.GetDatabase(ctx, &model.GetDatabase{
+			Name:   req.GetDatabase(),
+			Tenant: req.GetTenant(),
+		})
+		if err != nil {
+			log.Error("WatchCollections failed", zap.String("request", req.String()), zap.Error(err))
+			if errors.Is(err, common.ErrDatabaseNotFound) || errors.Is(err, common.ErrTenantNotFound) {
+				return grpcutils.BuildNotFoundGrpcError(err.Error())
+			}
+			return grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		listNotifications.DatabaseID = database.ID
+	}
+
+	oldest, latest, err := s.coordinator.GetNotificationRevisionRange(ctx)
+	if err != nil {
+		log.Error("WatchCollections failed", zap.String("request", req.String()), zap.Error(err))
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if req.SinceRevision == nil {
+		listNotifications.AfterRevision = latest
+	} else {
+		// The revisions before the oldest one kept may have been purged.
+		if req.GetSinceRevision()+1 < oldest {
+			log.Info("WatchCollections from a purged revision", zap.String("request", req.String()), zap.Int64("oldest", oldest))
+			return grpcutils.BuildFailedPreconditionGrpcError(common.ErrRevisionTooOld.Error())
+		}
+		listNotifications.AfterRevision = req.GetSinceRevision()
+	}
+
+	settled := listNotifications.AfterRevision
+	var gapSince time.Time
+	var skipped []int64
+	ticker := time.NewTicker(s.watchPollInterval)
+	defer ticker.Stop()
+	for {
+		revisions, err := s.coordinator.ListNotificationRevisions(ctx, settled, watchCollectionsBatchSize)
+		if err != nil {
+			log.Error("WatchCollections failed", zap.String("request", req.String()), zap.Error(err))
+			return grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		previouslySettled := settled
+		var newlySkipped []int64
+		settled, gapSince, newlySkipped = settleRevisions(settled, revisions, gapSince, time.Now(), s.watchGapTimeout)
+		if len(skipped) > 0 {
+			skipped, err = s.resetLateRevisions(ctx, stream, listNotifications, skipped)
+			if err != nil {
+				log.Error("WatchCollections failed", zap.String("request", req.String()), zap.Error(err))
+				return err
+			}
+		}
+		skipped = append(skipped, newlySkipped...)
+		listNotifications.UpToRevision = settled
+		for listNotifications.AfterRevision < settled {
+			notifications, err := s.coordinator.ListNotifications(ctx, listNotifications)
+			if err != nil {
+				log.Error("WatchCollections failed", zap.String("request", req.String()), zap.Error(err))
+				return grpcutils.BuildInternalGrpcError(err.Error())
+			}
+			if len(notifications) > 0 {
+				res := &coordinatorpb.WatchCollectionsResponse{
+					Events: make([]*coordinatorpb.CollectionEvent, 0, len(notifications)),
+				}
+				for _, notification := range notifications {
+					res.Events = append(res.Events, convertNotificationToProto(notification))
+				}
+				if err := stream.Send(res); err != nil {
+					return err
+				}
+			}
+			if len(notifications) < watchCollectionsBatchSize {
+				listNotifications.AfterRevision = settled
+			} else {
+				listNotifications.AfterRevision = notifications[len(notifications)-1].ID
+			}
+		}
+		if len(revisions) == watchCollectionsBatchSize && settled > previouslySettled {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// resetLateRevisions looks for the skipped revisions that committed since
+// they were given up on, and sends a COLLECTION_RESET event for those in the
+// scope of the watch. It returns the revisions still missing, without those
+// older than the oldest revision kept, which can no longer commit.
+func (s *Server) resetLateRevisions(ctx context.Context, stream grpc.ServerStreamingServer[coordinatorpb.WatchCollectionsResponse], listNotifications *model.ListNotifications, skipped []int64) ([]int64, error) {
+	oldest, _, err := s.coordinator.GetNotificationRevisionRange(ctx)
+	if err != nil {
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	missing := skipped[:0]
+	var events []*coordinatorpb.CollectionEvent
+	for _, revision := range skipped {
+		if revision < oldest {
+			continue
+		}
+		revisions, err := s.coordinator.ListNotificationRevisions(ctx, revision-1, 1)
+		if err != nil {
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		if len(revisions) == 0 || revisions[0] != revision {
+			missing = append(missing, revision)
+			continue
+		}
+		notifications, err := s.coordinator.ListNotifications(ctx, &model.ListNotifications{
+			TenantID:      listNotifications.TenantID,
+			DatabaseID:    listNotifications.DatabaseID,
+			AfterRevision: revision - 1,
+			UpToRevision:  revision,
+			Limit:         1,
+		})
+		if err != nil {
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		for _, notification := range notifications {
+			event := convertNotificationToProto(notification)
+			event.Type = coordinatorpb.CollectionEventType_COLLECTION_RESET
+			events = append(events, event)
+		}
+	}
+	if len(events) > 0 {
+		if err := stream.Send(&coordinatorpb.WatchCollectionsResponse{Events: events}); err != nil {
+			return nil, err
+		}
+	}
+	return missing, nil
+}
+
+// settleRevisions advances the settled revision over the committed revisions
+// after it, given in order. A missing revision is either not committed yet or
+// rolled back, the settled revision stops before it until it has been missing
+// for the gap timeout. It returns the settled revision, since when the
+// revision after it has been missing, if it is, and the missing revisions
+// given up on.
+func settleRevisions(settled int64, revisions []int64, gapSince time.Time, now time.Time, gapTimeout time.Duration) (int64, time.Time, []int64) {
+	var skipped []int64
+	for _, revision := range revisions {
+		if revision != settled+1 {
+			if gapSince.IsZero() {
+				gapSince = now
+			}
+			if now.Sub(gapSince) < gapTimeout {
+				return settled, gapSince, skipped
+			}
+			for missing := settled + 1; missing < revision; missing++ {
+				skipped = append(skipped, missing)
+			}
+		}
+		settled = revision
+		gapSince = time.Time{}
+	}
+	return settled, gapSince, skipped
+}
+
+// NotificationPurger deletes the changes older than the retention period from
+// the notification outbox.
+type NotificationPurger struct {
+	coordinator coordinator.Coordinator
+	ticker      *time.Ticker
+	retention   time.Duration
+}
+
+func NewNotificationPurger(coordinator coordinator.Coordinator, retention time.Duration) *NotificationPurger {
+	return &NotificationPurger{
+		coordinator: coordinator,
+		retention:   retention,
+	}
+}
+
+func (p *NotificationPurger) Start() error {
+	p.ticker = time.NewTicker(notificationPurgeInterval)
+	go p.run()
+	return nil
+}
+
+func (p *NotificationPurger) run() {
+	for range p.ticker.C {
+		purged, err := p.coordinator.PurgeNotifications(context.Background(), time.Now().Add(-p.retention))
+		if err != nil {
+			log.Error("Error while purging notifications", zap.Error(err))
+			continue
+		}
+		if purged > 0 {
+			log.Info("Purged notifications", zap.Int("count", purged))
+		}
+	}
+}
+
+func (p *NotificationPurger) Stop() error {
+	p.ticker.Stop()
+	return nil
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettleRevisions(t *testing.T) {
+	now := time.Now()
+
+	// Committed revisions without gaps are settled.
+	settled, gapSince, skipped := settleRevisions(2, []int64{3, 4, 5}, time.Time{}, now, time.Second)
+	assert.Equal(t, int64(5), settled)
+	assert.True(t, gapSince.IsZero())
+	assert.Empty(t, skipped)
+
+	// A missing revision holds back the later ones.
+	settled, gapSince, skipped = settleRevisions(2, []int64{3, 5, 6}, time.Time{}, now, time.Second)
+	assert.Equal(t, int64(3), settled)
+	assert.Equal(t, now, gapSince)
+	assert.Empty(t, skipped)
+
+	// The missing revision commits.
+	settled, gapSince, skipped = settleRevisions(3, []int64{4, 5, 6}, gapSince, now.Add(time.Millisecond), time.Second)
+	assert.Equal(t, int64(6), settled)
+	assert.True(t, gapSince.IsZero())
+
+	// A revision missing for the gap timeout was rolled back.
+	settled, gapSince, skipped = settleRevisions(3, []int64{5, 6}, now, now.Add(time.Millisecond), time.Second)
+	assert.Equal(t, int64(3), settled)
+	assert.Equal(t, now, gapSince)
+	settled, gapSince, skipped = settleRevisions(3, []int64{5, 6}, gapSince, now.Add(time.Second), time.Second)
+	assert.Equal(t, int64(6), settled)
+	assert.True(t, gapSince.IsZero())
+	assert.Equal(t, []int64{4}, skipped)
+
+	// Every revision of a gap given up on is skipped.
+	settled, gapSince, skipped = settleRevisions(3, []int64{7, 8}, now, now.Add(time.Second), time.Second)
+	assert.Equal(t, int64(8), settled)
+	assert.True(t, gapSince.IsZero())
+	assert.Equal(t, []int64{4, 5, 6}, skipped)
+}
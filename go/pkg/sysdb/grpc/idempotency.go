@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyKeyHeader is the gRPC metadata key clients use to make a
+// mutating request safe to retry.
+const idempotencyKeyHeader = "idempotency-key"
+
+const (
+	defaultIdempotencyLease     = time.Minute
+	idempotencyKeyPurgeInterval = time.Minute
+)
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func hashRequest(req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// withIdempotencyKey runs handle, unless the request carries an idempotency key
+// already used within the idempotency window. The key is reserved before handle
+// runs, so concurrent requests with it do not both run. A retry of the same
+// request then gets the recorded response, or an error while the first one is
+// still running, while a different request is rejected. Failed requests release
+// the key, so they can be retried with it. A key still pending after the lease
+// was left over by a request that crashed or could not record its response,
+// and is reserved again by the next request with it.
+func withIdempotencyKey[Req proto.Message, Res proto.Message](ctx context.Context, s *Server, method string, req Req, handle func(context.Context, Req) (Res, error)) (Res, error) {
+	key := idempotencyKeyFromContext(ctx)
+	if key == "" || s.idempotencyWindow <= 0 {
+		return handle(ctx, req)
+	}
+
+	var empty Res
+	requestHash, err := hashRequest(req)
+	if err != nil {
+		return empty, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	now := time.Now()
+	record, err := s.coordinator.ReserveIdempotencyKey(ctx, &model.IdempotencyRecord{
+		Method:      method,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ReservedAt:  now,
+	}, now.Add(-s.idempotencyLease))
+	if err != nil {
+		log.Error("error reserving idempotency key", zap.String("method", method), zap.Error(err))
+		return empty, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if record != nil {
+		if record.RequestHash != requestHash {
+			log.Error("idempotency key reused", zap.String("method", method), zap.String("key", key))
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError(idempotencyKeyHeader, common.ErrIdempotencyKeyReused.Error())
+			if err != nil {
+				return empty, err
+			}
+			return empty, grpcError
+		}
+		if record.Pending {
+			log.Info("idempotent request in progress", zap.String("method", method), zap.String("key", key))
+			return empty, grpcutils.BuildFailedPreconditionGrpcError(common.ErrIdempotencyKeyPending.Error())
+		}
+		res := empty.ProtoReflect().New().Interface().(Res)
+		if err := proto.Unmarshal(record.Response, res); err != nil {
+			return empty, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		log.Info("replaying idempotent response", zap.String("method", method), zap.String("key", key))
+		return res, nil
+	}
+
+	res, err := handle(ctx, req)
+	if err != nil {
+		if releaseErr := s.coordinator.ReleaseIdempotencyKey(ctx, method, key); releaseErr != nil {
+			// The key stays pending until its lease expires.
+			log.Error("error releasing idempotency key", zap.String("method", method), zap.Error(releaseErr))
+		}
+		return res, err
+	}
+	response, err := proto.Marshal(res)
+	if err == nil {
+		err = s.coordinator.CompleteIdempotencyKey(ctx, method, key, response)
+	}
+	if err != nil {
+		// The request succeeded, retries of it are rejected as in progress
+		// until the lease expires and then run again.
+		log.Error("error completing idempotency key", zap.String("method", method), zap.Error(err))
+	}
+	return res, nil
+}
+
+// IdempotencyKeyPurger deletes the idempotency keys older than the idempotency
+// window.
+type IdempotencyKeyPurger struct {
+	coordinator coordinator.Coordinator
+	ticker      *time.Ticker
+	window      time.Duration
+}
+
+func NewIdempotencyKeyPurger(coordinator coordinator.Coordinator, window time.Duration) *IdempotencyKeyPurger {
+	return &IdempotencyKeyPurger{
+		coordinator: coordinator,
+		window:      window,
+	}
+}
+
+func (p *IdempotencyKeyPurger) Start() error {
+	p.ticker = time.NewTicker(idempotencyKeyPurgeInterval)
+	go p.run()
+	return nil
+}
+
+func (p *IdempotencyKeyPurger) run() {
+	for range p.ticker.C {
+		purged, err := p.coordinator.PurgeIdempotencyKeys(context.Background(), time.Now().Add(-p.window))
+		if err != nil {
+			log.Error("Error while purging idempotency keys", zap.Error(err))
+			continue
+		}
+		if purged > 0 {
+			log.Info("Purged idempotency keys", zap.Int("count", purged))
+		}
+	}
+}
+
+func (p *IdempotencyKeyPurger) Stop() error {
+	p.ticker.Stop()
+	return nil
+}
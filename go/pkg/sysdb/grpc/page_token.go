@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// Page tokens are opaque to clients. They carry the sort key of the last row
+// of a page as base64 encoded JSON, so the next page starts right after it.
+func encodePageToken(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageToken(token string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errInvalidPageToken
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errInvalidPageToken
+	}
+	return nil
+}
+
+// namePageToken is the page token of listings ordered by name.
+type namePageToken struct {
+	After string `json:"after"`
+}
+
+func decodeNamePageToken(token *string) (string, error) {
+	if token == nil || *token == "" {
+		return "", nil
+	}
+	pageToken := &namePageToken{}
+	if err := decodePageToken(*token, pageToken); err != nil {
+		return "", err
+	}
+	return pageToken.After, nil
+}
+
+// collectionPageToken is the page token of collection listings, ordered by
+// (created_at, id). The timestamp keeps the location it was read with so it
+// compares equal to the stored value.
+type collectionPageToken struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+func encodeCollectionPageToken(cursor *model.CollectionCursor) (string, error) {
+	return encodePageToken(&collectionPageToken{CreatedAt: cursor.CreatedAt, ID: cursor.ID})
+}
+
+func decodeCollectionPageToken(token *string) (*model.CollectionCursor, error) {
+	if token == nil || *token == "" {
+		return nil, nil
+	}
+	pageToken := &collectionPageToken{}
+	if err := decodePageToken(*token, pageToken); err != nil {
+		return nil, err
+	}
+	if pageToken.ID == "" {
+		return nil, errInvalidPageToken
+	}
+	return &model.CollectionCursor{CreatedAt: pageToken.CreatedAt, ID: pageToken.ID}, nil
+}
+
+// idPageToken is the page token of listings ordered by a numeric id.
+type idPageToken struct {
+	After int64 `json:"after"`
+}
+
+func decodeIDPageToken(token *string) (int64, error) {
+	if token == nil || *token == "" {
+		return 0, nil
+	}
+	pageToken := &idPageToken{}
+	if err := decodePageToken(*token, pageToken); err != nil {
+		return 0, err
+	}
+	return pageToken.After, nil
+}
+
+// pageSize returns the number of rows to return for the requested limit.
+func pageSize(limit *int32) int32 {
+	if limit == nil || *limit <= 0 {
+		return defaultPageSize
+	}
+	if *limit > maxPageSize {
+		return maxPageSize
+	}
+	return *limit
+}
@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"sort"
+
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
@@ -34,6 +36,134 @@ func convertCollectionMetadataToModel(collectionMetadata *coordinatorpb.UpdateMe
 	return metadata, nil
 }
 
+// convertCollectionMetadataUpdateToModel converts the metadata of a merge
+// update, where a key without a value is deleted and kept with a nil value.
+func convertCollectionMetadataUpdateToModel(collectionMetadata *coordinatorpb.UpdateMetadata) (*model.CollectionMetadata[model.CollectionMetadataValueType], error) {
+	if collectionMetadata == nil {
+		return nil, nil
+	}
+
+	values := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{}}
+	deletedKeys := make([]string, 0)
+	for key, value := range collectionMetadata.Metadata {
+		if value.GetValue() == nil {
+			deletedKeys = append(deletedKeys, key)
+			continue
+		}
+		values.Metadata[key] = value
+	}
+	metadata, err := convertCollectionMetadataToModel(values)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range deletedKeys {
+		metadata.Add(key, nil)
+	}
+	return metadata, nil
+}
+
+func convertWhereToModel(where *coordinatorpb.Where) (*model.CollectionMetadataFilter, error) {
+	switch w := where.GetWhere().(type) {
+	case *coordinatorpb.Where_DirectComparison:
+		return convertDirectComparisonToModel(w.DirectComparison)
+	case *coordinatorpb.Where_Children:
+		filter := &model.CollectionMetadataFilter{Operator: model.CollectionMetadataFilterAnd}
+		if w.Children.Operator == coordinatorpb.BooleanOperator_OR {
+			filter.Operator = model.CollectionMetadataFilterOr
+		}
+		for _, child := range w.Children.Children {
+			childFilter, err := convertWhereToModel(child)
+			if err != nil {
+				return nil, err
+			}
+			filter.Children = append(filter.Children, childFilter)
+		}
+		return filter, nil
+	}
+	return nil, common.ErrInvalidMetadataFilter
+}
+
+func convertDirectComparisonToModel(comparison *coordinatorpb.DirectComparison) (*model.CollectionMetadataFilter, error) {
+	filter := &model.CollectionMetadataFilter{Key: comparison.Key}
+	switch c := comparison.Comparison.(type) {
+	case *coordinatorpb.DirectComparison_SingleStringOperand:
+		filter.Operator = convertGenericComparatorToModel(c.SingleStringOperand.Comparator)
+		filter.Values = append(filter.Values, &model.CollectionMetadataValueStringType{Value: c.SingleStringOperand.Value})
+	case *coordinatorpb.DirectComparison_SingleBoolOperand:
+		filter.Operator = convertGenericComparatorToModel(c.SingleBoolOperand.Comparator)
+		filter.Values = append(filter.Values, &model.CollectionMetadataValueBoolType{Value: c.SingleBoolOperand.Value})
+	case *coordinatorpb.DirectComparison_SingleIntOperand:
+		switch comparator := c.SingleIntOperand.Comparator.(type) {
+		case *coordinatorpb.SingleIntComparison_GenericComparator:
+			filter.Operator = convertGenericComparatorToModel(comparator.GenericComparator)
+		case *coordinatorpb.SingleIntComparison_NumberComparator:
+			filter.Operator = convertNumberComparatorToModel(comparator.NumberComparator)
+		default:
+			return nil, common.ErrInvalidMetadataFilter
+		}
+		filter.Values = append(filter.Values, &model.CollectionMetadataValueInt64Type{Value: c.SingleIntOperand.Value})
+	case *coordinatorpb.DirectComparison_SingleDoubleOperand:
+		switch comparator := c.SingleDoubleOperand.Comparator.(type) {
+		case *coordinatorpb.SingleDoubleComparison_GenericComparator:
+			filter.Operator = convertGenericComparatorToModel(comparator.GenericComparator)
+		case *coordinatorpb.SingleDoubleComparison_NumberComparator:
+			filter.Operator = convertNumberComparatorToModel(comparator.NumberComparator)
+		default:
+			return nil, common.ErrInvalidMetadataFilter
+		}
+		filter.Values = append(filter.Values, &model.CollectionMetadataValueFloat64Type{Value: c.SingleDoubleOperand.Value})
+	case *coordinatorpb.DirectComparison_StringListOperand:
+		filter.Operator = convertListOperatorToModel(c.StringListOperand.ListOperator)
+		for _, value := range c.StringListOperand.Values {
+			filter.Values = append(filter.Values, &model.CollectionMetadataValueStringType{Value: value})
+		}
+	case *coordinatorpb.DirectComparison_BoolListOperand:
+		filter.Operator = convertListOperatorToModel(c.BoolListOperand.ListOperator)
+		for _, value := range c.BoolListOperand.Values {
+			filter.Values = append(filter.Values, &model.CollectionMetadataValueBoolType{Value: value})
+		}
+	case *coordinatorpb.DirectComparison_IntListOperand:
+		filter.Operator = convertListOperatorToModel(c.IntListOperand.ListOperator)
+		for _, value := range c.IntListOperand.Values {
+			filter.Values = append(filter.Values, &model.CollectionMetadataValueInt64Type{Value: value})
+		}
+	case *coordinatorpb.DirectComparison_DoubleListOperand:
+		filter.Operator = convertListOperatorToModel(c.DoubleListOperand.ListOperator)
+		for _, value := range c.DoubleListOperand.Values {
+			filter.Values = append(filter.Values, &model.CollectionMetadataValueFloat64Type{Value: value})
+		}
+	default:
+		return nil, common.ErrInvalidMetadataFilter
+	}
+	return filter, nil
+}
+
+func convertGenericComparatorToModel(comparator coordinatorpb.GenericComparator) model.CollectionMetadataFilterOperator {
+	if comparator == coordinatorpb.GenericComparator_NE {
+		return model.CollectionMetadataFilterNe
+	}
+	return model.CollectionMetadataFilterEq
+}
+
+func convertNumberComparatorToModel(comparator coordinatorpb.NumberComparator) model.CollectionMetadataFilterOperator {
+	switch comparator {
+	case coordinatorpb.NumberComparator_GTE:
+		return model.CollectionMetadataFilterGte
+	case coordinatorpb.NumberComparator_LT:
+		return model.CollectionMetadataFilterLt
+	case coordinatorpb.NumberComparator_LTE:
+		return model.CollectionMetadataFilterLte
+	}
+	return model.CollectionMetadataFilterGt
+}
+
+func convertListOperatorToModel(operator coordinatorpb.ListOperator) model.CollectionMetadataFilterOperator {
+	if operator == coordinatorpb.ListOperator_NIN {
+		return model.CollectionMetadataFilterNin
+	}
+	return model.CollectionMetadataFilterIn
+}
+
 func convertCollectionToProto(collection *model.Collection) *coordinatorpb.Collection {
 	if collection == nil {
 		return nil
@@ -48,6 +178,7 @@ func convertCollectionToProto(collection *model.Collection) *coordinatorpb.Colle
 		Database:             collection.DatabaseName,
 		LogPosition:          collection.LogPosition,
 		Version:              collection.Version,
+		RowVersion:           collection.RowVersion,
 	}
 	if collection.Metadata == nil {
 		return collectionpb
@@ -152,6 +283,65 @@ func convertSegmentMetadataToModel(segmentMetadata *coordinatorpb.UpdateMetadata
 	return metadata, nil
 }
 
+func convertCollectionVersionToProto(collectionVersion *model.CollectionVersion) *coordinatorpb.CollectionVersionInfo {
+	segmentIDs := make([]string, 0, len(collectionVersion.SegmentFilePaths))
+	for segmentID := range collectionVersion.SegmentFilePaths {
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	sort.Strings(segmentIDs)
+	segments := make([]*coordinatorpb.SegmentFilePaths, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		filePaths := make(map[string]*coordinatorpb.FilePaths)
+		for t, paths := range collectionVersion.SegmentFilePaths[segmentID] {
+			filePaths[t] = &coordinatorpb.FilePaths{
+				Paths: paths,
+			}
+		}
+		segments = append(segments, &coordinatorpb.SegmentFilePaths{
+			SegmentId: segmentID,
+			FilePaths: filePaths,
+		})
+	}
+	return &coordinatorpb.CollectionVersionInfo{
+		Version:     collectionVersion.Version,
+		LogPosition: collectionVersion.LogPosition,
+		Segments:    segments,
+		CreatedAt:   int64(collectionVersion.CreatedAt),
+	}
+}
+
+var collectionEventTypes = map[string]coordinatorpb.CollectionEventType{
+	model.NotificationTypeCreateCollection: coordinatorpb.CollectionEventType_COLLECTION_CREATED,
+	model.NotificationTypeUpdateCollection: coordinatorpb.CollectionEventType_COLLECTION_UPDATED,
+	model.NotificationTypeDeleteCollection: coordinatorpb.CollectionEventType_COLLECTION_DELETED,
+	model.NotificationTypeFlushCollection:  coordinatorpb.CollectionEventType_COLLECTION_FLUSHED,
+}
+
+func convertNotificationToProto(notification *model.Notification) *coordinatorpb.CollectionEvent {
+	return &coordinatorpb.CollectionEvent{
+		Revision:     notification.ID,
+		Type:         collectionEventTypes[notification.Type],
+		CollectionId: notification.CollectionID,
+		Tenant:       notification.TenantID,
+		Database:     notification.DatabaseName,
+		CreatedAt:    int64(notification.CreatedAt),
+	}
+}
+
+func convertAuditEventToProto(auditEvent *model.AuditEvent) *coordinatorpb.AuditEvent {
+	return &coordinatorpb.AuditEvent{
+		Id:           auditEvent.ID,
+		Actor:        auditEvent.Actor,
+		Operation:    auditEvent.Operation,
+		TenantId:     auditEvent.TenantID,
+		DatabaseId:   auditEvent.DatabaseID,
+		CollectionId: auditEvent.CollectionID,
+		Before:       auditEvent.Before,
+		After:        auditEvent.After,
+		CreatedAt:    int64(auditEvent.CreatedAt),
+	}
+}
+
 func convertSegmentToProto(segment *model.Segment) *coordinatorpb.Segment {
 	if segment == nil {
 		return nil
@@ -171,6 +361,7 @@ func convertSegmentToProto(segment *model.Segment) *coordinatorpb.Segment {
 		Collection: segment.CollectionID.String(),
 		Metadata:   nil,
 		FilePaths:  filePaths,
+		RowVersion: segment.RowVersion,
 	}
 
 	if segment.Metadata == nil {
@@ -241,3 +432,58 @@ func convertSegmentToModel(segmentpb *coordinatorpb.Segment) (*model.CreateSegme
 		Metadata:     metadata,
 	}, nil
 }
+
+func convertTenantToProto(tenant *model.Tenant) *coordinatorpb.Tenant {
+	return &coordinatorpb.Tenant{
+		Name:      tenant.Name,
+		CreatedAt: tenant.CreatedAt,
+		UpdatedAt: tenant.UpdatedAt,
+		Metadata:  convertCollectionMetadataToProto(tenant.Metadata),
+	}
+}
+
+func convertDatabaseToProto(database *model.Database) *coordinatorpb.Database {
+	return &coordinatorpb.Database{
+		Id:        database.ID,
+		Name:      database.Name,
+		Tenant:    database.Tenant,
+		CreatedAt: database.CreatedAt,
+		UpdatedAt: database.UpdatedAt,
+		Metadata:  convertCollectionMetadataToProto(database.Metadata),
+	}
+}
+
+func convertTenantQuotaToProto(tenantQuota *model.TenantQuota) *coordinatorpb.TenantQuota {
+	return &coordinatorpb.TenantQuota{
+		MaxDatabases:                 tenantQuota.MaxDatabases,
+		MaxCollectionsPerDatabase:    tenantQuota.MaxCollectionsPerDatabase,
+		MaxMetadataKeysPerCollection: tenantQuota.MaxMetadataKeysPerCollection,
+		MaxMetadataValueSize:         tenantQuota.MaxMetadataValueSize,
+		MaxCollectionDimension:       tenantQuota.MaxCollectionDimension,
+	}
+}
+
+func convertQuotaToProto(quota model.Quota) *coordinatorpb.TenantQuota {
+	return &coordinatorpb.TenantQuota{
+		MaxDatabases:                 &quota.MaxDatabases,
+		MaxCollectionsPerDatabase:    &quota.MaxCollectionsPerDatabase,
+		MaxMetadataKeysPerCollection: &quota.MaxMetadataKeysPerCollection,
+		MaxMetadataValueSize:         &quota.MaxMetadataValueSize,
+		MaxCollectionDimension:       &quota.MaxCollectionDimension,
+	}
+}
+
+// convertTenantQuotaToModel reads the limits set in tenantQuota, a nil
+// tenantQuota clears them all.
+func convertTenantQuotaToModel(tenantID string, tenantQuota *coordinatorpb.TenantQuota) *model.TenantQuota {
+	result := &model.TenantQuota{TenantID: tenantID}
+	if tenantQuota == nil {
+		return result
+	}
+	result.MaxDatabases = tenantQuota.MaxDatabases
+	result.MaxCollectionsPerDatabase = tenantQuota.MaxCollectionsPerDatabase
+	result.MaxMetadataKeysPerCollection = tenantQuota.MaxMetadataKeysPerCollection
+	result.MaxMetadataValueSize = tenantQuota.MaxMetadataValueSize
+	result.MaxCollectionDimension = tenantQuota.MaxCollectionDimension
+	return result
+}
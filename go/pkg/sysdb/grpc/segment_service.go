@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
@@ -29,6 +30,9 @@ func (s *Server) CreateSegment(ctx context.Context, req *coordinatorpb.CreateSeg
 		if err == common.ErrSegmentUniqueConstraintViolation {
 			return res, grpcutils.BuildAlreadyExistsGrpcError(err.Error())
 		}
+		if err == common.ErrCollectionSegmentScopes {
+			return res, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 
@@ -36,6 +40,7 @@ func (s *Server) CreateSegment(ctx context.Context, req *coordinatorpb.CreateSeg
 }
 
 func (s *Server) GetSegments(ctx context.Context, req *coordinatorpb.GetSegmentsRequest) (*coordinatorpb.GetSegmentsResponse, error) {
+	ctx = replicaReadContext(ctx)
 	segmentID := req.Id
 	segmentType := req.Type
 	scope := req.Scope
@@ -105,8 +110,9 @@ func (s *Server) DeleteSegment(ctx context.Context, req *coordinatorpb.DeleteSeg
 func (s *Server) UpdateSegment(ctx context.Context, req *coordinatorpb.UpdateSegmentRequest) (*coordinatorpb.UpdateSegmentResponse, error) {
 	res := &coordinatorpb.UpdateSegmentResponse{}
 	updateSegment := &model.UpdateSegment{
-		ID:            types.MustParse(req.Id),
-		ResetMetadata: req.GetResetMetadata(),
+		ID:                 types.MustParse(req.Id),
+		ResetMetadata:      req.GetResetMetadata(),
+		ExpectedRowVersion: req.ExpectedRowVersion,
 	}
 
 	collection := req.GetCollection()
@@ -126,11 +132,16 @@ func (s *Server) UpdateSegment(ctx context.Context, req *coordinatorpb.UpdateSeg
 		}
 		updateSegment.Metadata = modelMetadata
 	}
-	_, err := s.coordinator.UpdateSegment(ctx, updateSegment)
+	segment, err := s.coordinator.UpdateSegment(ctx, updateSegment)
 	if err != nil {
 		log.Error("UpdateSegment failed", zap.Error(err), zap.String("request", req.String()))
+		var mismatch *common.RowVersionMismatchError
+		if errors.As(err, &mismatch) {
+			return res, buildRowVersionMismatchGrpcError(mismatch)
+		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
+	res.RowVersion = segment.RowVersion
 	log.Info("UpdateSegment success", zap.String("request", req.String()))
 	return res, nil
 }
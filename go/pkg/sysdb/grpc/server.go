@@ -10,12 +10,15 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/memberlist_manager"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/logservice"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/utils"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 	"gorm.io/gorm"
 )
 
@@ -29,6 +32,13 @@ type Config struct {
 	// MetaTable config
 	DBConfig dbcore.DBConfig
 
+	// Read replica of the MetaTable, disabled when its address is empty. The
+	// fields left empty are the ones of DBConfig.
+	ReplicaDBConfig dbcore.DBConfig
+
+	// Embedded SQLite catalog path for the memory provider, in memory when empty
+	SqlitePath string
+
 	// Kubernetes config
 	KubernetesNamespace string
 
@@ -53,6 +63,26 @@ type Config struct {
 	SoftDeleteMaxAge           time.Duration
 	SoftDeleteCleanupBatchSize uint
 
+	// How often WatchCollections polls the notification outbox
+	CollectionWatchPollInterval time.Duration
+	// How long the notification outbox keeps changes, forever when zero.
+	CollectionWatchRetention time.Duration
+
+	// How long the response to a request sent with an idempotency key is
+	// remembered. Idempotency keys are ignored when zero.
+	IdempotencyWindow time.Duration
+
+	// Quota of the tenants that have none of their own.
+	DefaultQuota model.Quota
+
+	// Read-through cache of collections and segments, disabled when its size
+	// is zero.
+	CatalogCache coordinator.CatalogCacheConfig
+
+	// Log service the records of forked collections are copied through,
+	// forking is disabled when empty.
+	LogServiceAddress string
+
 	// Config for testing
 	Testing bool
 }
@@ -63,21 +93,37 @@ type Config struct {
 // convenient for end-to-end property based testing.
 type Server struct {
 	coordinatorpb.UnimplementedSysDBServer
-	coordinator       coordinator.Coordinator
-	grpcServer        grpcutils.GrpcServer
-	healthServer      *health.Server
-	softDeleteCleaner *SoftDeleteCleaner
+	coordinator        coordinator.Coordinator
+	grpcServer         grpcutils.GrpcServer
+	healthServer       *health.Server
+	softDeleteCleaner  *SoftDeleteCleaner
+	notificationPurger *NotificationPurger
+	idempotencyPurger  *IdempotencyKeyPurger
+	idempotencyWindow  time.Duration
+	idempotencyLease   time.Duration
+	watchPollInterval  time.Duration
+	watchGapTimeout    time.Duration
 }
 
 func New(config Config) (*Server, error) {
 	if config.SystemCatalogProvider == "memory" {
-		return NewWithGrpcProvider(config, grpcutils.Default, nil)
+		db, err := dbcore.ConnectSqlite(config.SqlitePath)
+		if err != nil {
+			return nil, err
+		}
+		return NewWithGrpcProvider(config, grpcutils.Default, db)
 	} else if config.SystemCatalogProvider == "database" {
 		dBConfig := config.DBConfig
 		db, err := dbcore.ConnectPostgres(dBConfig)
 		if err != nil {
 			return nil, err
 		}
+		if config.ReplicaDBConfig.Address != "" {
+			_, err = dbcore.ConnectPostgresReplica(replicaDBConfig(config))
+			if err != nil {
+				return nil, err
+			}
+		}
 		return NewWithGrpcProvider(config, grpcutils.Default, db)
 	} else {
 		return nil, errors.New("invalid system catalog provider, only memory and database are supported")
@@ -87,7 +133,14 @@ func New(config Config) (*Server, error) {
 func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gorm.DB) (*Server, error) {
 	ctx := context.Background()
 	s := &Server{
-		healthServer: health.NewServer(),
+		healthServer:      health.NewServer(),
+		idempotencyWindow: config.IdempotencyWindow,
+		idempotencyLease:  defaultIdempotencyLease,
+		watchPollInterval: config.CollectionWatchPollInterval,
+		watchGapTimeout:   defaultWatchGapTimeout,
+	}
+	if s.watchPollInterval <= 0 {
+		s.watchPollInterval = defaultWatchPollInterval
 	}
 
 	var deleteMode coordinator.DeleteMode
@@ -101,6 +154,11 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	if err != nil {
 		return nil, err
 	}
+	coordinator.SetDefaultQuota(config.DefaultQuota)
+	coordinator.SetCatalogCache(config.CatalogCache)
+	if config.LogServiceAddress != "" {
+		coordinator.SetLogService(logservice.NewLogService(config.LogServiceAddress))
+	}
 	s.coordinator = *coordinator
 	s.softDeleteCleaner = NewSoftDeleteCleaner(*coordinator, config.SoftDeleteCleanupInterval, config.SoftDeleteMaxAge, config.SoftDeleteCleanupBatchSize)
 	if !config.Testing {
@@ -136,10 +194,62 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 		}
 
 		s.softDeleteCleaner.Start()
+		if config.CollectionWatchRetention > 0 {
+			s.notificationPurger = NewNotificationPurger(*coordinator, config.CollectionWatchRetention)
+			s.notificationPurger.Start()
+		}
+		if config.IdempotencyWindow > 0 {
+			s.idempotencyPurger = NewIdempotencyKeyPurger(*coordinator, config.IdempotencyWindow)
+			s.idempotencyPurger.Start()
+		}
 	}
 	return s, nil
 }
 
+// replicaDBConfig completes the config of the read replica with the config of
+// the primary.
+func replicaDBConfig(config Config) dbcore.DBConfig {
+	replica := config.ReplicaDBConfig
+	primary := config.DBConfig
+	if replica.Username == "" {
+		replica.Username = primary.Username
+		replica.Password = primary.Password
+	}
+	if replica.Port == 0 {
+		replica.Port = primary.Port
+	}
+	if replica.DBName == "" {
+		replica.DBName = primary.DBName
+	}
+	if replica.MaxIdleConns == 0 {
+		replica.MaxIdleConns = primary.MaxIdleConns
+	}
+	if replica.MaxOpenConns == 0 {
+		replica.MaxOpenConns = primary.MaxOpenConns
+	}
+	if replica.SslMode == "" {
+		replica.SslMode = primary.SslMode
+	}
+	return replica
+}
+
+// readYourWritesHeader is the gRPC metadata key clients set to "true" to read
+// from the primary, e.g. right after a change that a read replica may not have
+// replicated yet. The compactor and query nodes set it on GetCollections and
+// GetSegments, they flush and query the file paths they read.
+const readYourWritesHeader = "x-chroma-read-your-writes"
+
+// replicaReadContext lets the reads of a read RPC be served by the read
+// replica, unless the client asks to read its own writes.
+func replicaReadContext(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(readYourWritesHeader); len(values) > 0 && values[0] == "true" {
+			return ctx
+		}
+	}
+	return dbcore.CtxWithReplicaRead(ctx, true)
+}
+
 func createMemberlistManager(namespace string, memberlistName string, podLabel string, watchInterval time.Duration, reconcileInterval time.Duration, reconcileCount uint) (*memberlist_manager.MemberlistManager, error) {
 	log.Info("Creating memberlist manager for {}", zap.String("memberlist", memberlistName))
 	clientset, err := utils.GetKubernetesInterface()
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_MemoryCatalogProvider(t *testing.T) {
+	s, err := New(Config{
+		SystemCatalogProvider: "memory",
+		Testing:               true,
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	// The default tenant and database are seeded.
+	_, err = s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{
+		Name:   common.DefaultDatabase,
+		Tenant: common.DefaultTenant,
+	})
+	assert.NoError(t, err)
+
+	collectionID := types.NewUniqueID().String()
+	res, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "memory_collection",
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+		Segments: collectionSegments(collectionID),
+	})
+	assert.NoError(t, err)
+	assert.True(t, res.Created)
+
+	// Creating the same collection again violates the unique constraint.
+	otherCollectionID := types.NewUniqueID().String()
+	_, err = s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       otherCollectionID,
+		Name:     "memory_collection",
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+		Segments: collectionSegments(otherCollectionID),
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	getRes, err := s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Id:       &collectionID,
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, getRes.Collections, 1)
+
+	segRes, err := s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{
+		Collection: collectionID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, segRes.Segments, 3)
+
+	_, err = s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{
+		Id:       collectionID,
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+	})
+	assert.NoError(t, err)
+}
@@ -15,18 +15,32 @@ import (
 )
 
 func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDatabaseRequest) (*coordinatorpb.CreateDatabaseResponse, error) {
+	return withIdempotencyKey(ctx, s, "CreateDatabase", req, s.createDatabase)
+}
+
+func (s *Server) createDatabase(ctx context.Context, req *coordinatorpb.CreateDatabaseRequest) (*coordinatorpb.CreateDatabaseResponse, error) {
 	res := &coordinatorpb.CreateDatabaseResponse{}
+	metadata, err := convertCollectionMetadataToModel(req.Metadata)
+	if err != nil {
+		log.Error("error CreateDatabase", zap.String("request", req.String()), zap.Error(err))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
 	createDatabase := &model.CreateDatabase{
-		ID:     req.GetId(),
-		Name:   req.GetName(),
-		Tenant: req.GetTenant(),
+		ID:       req.GetId(),
+		Name:     req.GetName(),
+		Tenant:   req.GetTenant(),
+		Metadata: metadata,
 	}
-	_, err := s.coordinator.CreateDatabase(ctx, createDatabase)
+	_, err = s.coordinator.CreateDatabase(ctx, createDatabase)
 	if err != nil {
 		log.Error("error CreateDatabase", zap.String("request", req.String()), zap.Error(err))
 		if errors.Is(err, common.ErrDatabaseUniqueConstraintViolation) {
 			return res, grpcutils.BuildAlreadyExistsGrpcError(err.Error())
 		}
+		var quotaExceeded *common.QuotaExceededError
+		if errors.As(err, &quotaExceeded) {
+			return res, buildQuotaExceededGrpcError(quotaExceeded)
+		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 	log.Info("CreateDatabase success", zap.String("request", req.String()))
@@ -34,6 +48,7 @@ func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDa
 }
 
 func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabaseRequest) (*coordinatorpb.GetDatabaseResponse, error) {
+	ctx = replicaReadContext(ctx)
 	res := &coordinatorpb.GetDatabaseResponse{}
 	getDatabase := &model.GetDatabase{
 		Name:   req.GetName(),
@@ -47,21 +62,119 @@ func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabase
 		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
-	res.Database = &coordinatorpb.Database{
-		Id:     database.ID,
-		Name:   database.Name,
-		Tenant: database.Tenant,
-	}
+	res.Database = convertDatabaseToProto(database)
 	log.Info("GetDatabase success", zap.String("request", req.String()))
 	return res, nil
 }
 
+func (s *Server) ListDatabases(ctx context.Context, req *coordinatorpb.ListDatabasesRequest) (*coordinatorpb.ListDatabasesResponse, error) {
+	res := &coordinatorpb.ListDatabasesResponse{}
+	startAfter, err := decodeNamePageToken(req.PageToken)
+	if err != nil {
+		log.Error("error ListDatabases", zap.String("request", req.String()), zap.Error(err))
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", err.Error())
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	limit := pageSize(req.Limit)
+	// Fetch one extra row to know whether there is a next page.
+	databases, err := s.coordinator.ListDatabases(ctx, &model.ListDatabases{
+		Tenant:     req.GetTenant(),
+		NamePrefix: req.GetNamePrefix(),
+		StartAfter: startAfter,
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		log.Error("error ListDatabases", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if len(databases) > int(limit) {
+		databases = databases[:limit]
+		res.NextPageToken, err = encodePageToken(&namePageToken{After: databases[limit-1].Name})
+		if err != nil {
+			return res, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	res.Databases = make([]*coordinatorpb.Database, 0, len(databases))
+	for _, database := range databases {
+		res.Databases = append(res.Databases, convertDatabaseToProto(database))
+	}
+	log.Info("ListDatabases success", zap.String("request", req.String()), zap.Int("count", len(res.Databases)))
+	return res, nil
+}
+
+func (s *Server) UpdateDatabase(ctx context.Context, req *coordinatorpb.UpdateDatabaseRequest) (*coordinatorpb.UpdateDatabaseResponse, error) {
+	res := &coordinatorpb.UpdateDatabaseResponse{}
+	metadata, err := convertMetadataUpdateToModel(req.GetMetadata(), req.MergeMetadata)
+	if err != nil {
+		log.Error("error UpdateDatabase", zap.String("request", req.String()), zap.Error(err))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	database, err := s.coordinator.UpdateDatabase(ctx, &model.UpdateDatabase{
+		Name:          req.GetName(),
+		Tenant:        req.GetTenant(),
+		Metadata:      metadata,
+		ResetMetadata: req.GetResetMetadata(),
+		MergeMetadata: req.MergeMetadata,
+	})
+	if err != nil {
+		log.Error("error UpdateDatabase", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrDatabaseNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		if err == common.ErrInvalidMetadataUpdate {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("merge_metadata", err.Error())
+			if err != nil {
+				return res, err
+			}
+			return res, grpcError
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Database = convertDatabaseToProto(database)
+	log.Info("UpdateDatabase success", zap.String("request", req.String()))
+	return res, nil
+}
+
+func (s *Server) DeleteDatabase(ctx context.Context, req *coordinatorpb.DeleteDatabaseRequest) (*coordinatorpb.DeleteDatabaseResponse, error) {
+	res := &coordinatorpb.DeleteDatabaseResponse{}
+	deleteDatabase := &model.DeleteDatabase{
+		Name:   req.GetName(),
+		Tenant: req.GetTenant(),
+	}
+	err := s.coordinator.DeleteDatabase(ctx, deleteDatabase)
+	if err != nil {
+		log.Error("error DeleteDatabase", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrDatabaseNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	log.Info("DeleteDatabase success", zap.String("request", req.String()))
+	return res, nil
+}
+
 func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTenantRequest) (*coordinatorpb.CreateTenantResponse, error) {
+	return withIdempotencyKey(ctx, s, "CreateTenant", req, s.createTenant)
+}
+
+func (s *Server) createTenant(ctx context.Context, req *coordinatorpb.CreateTenantRequest) (*coordinatorpb.CreateTenantResponse, error) {
 	res := &coordinatorpb.CreateTenantResponse{}
+	metadata, err := convertCollectionMetadataToModel(req.Metadata)
+	if err != nil {
+		log.Error("error CreateTenant", zap.String("request", req.String()), zap.Error(err))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
 	createTenant := &model.CreateTenant{
-		Name: req.GetName(),
+		Name:     req.GetName(),
+		Metadata: metadata,
 	}
-	_, err := s.coordinator.CreateTenant(ctx, createTenant)
+	_, err = s.coordinator.CreateTenant(ctx, createTenant)
 	if err != nil {
 		log.Error("error CreateTenant", zap.String("request", req.String()), zap.Error(err))
 		if err == common.ErrTenantUniqueConstraintViolation {
@@ -74,6 +187,7 @@ func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTena
 }
 
 func (s *Server) GetTenant(ctx context.Context, req *coordinatorpb.GetTenantRequest) (*coordinatorpb.GetTenantResponse, error) {
+	ctx = replicaReadContext(ctx)
 	res := &coordinatorpb.GetTenantResponse{}
 	getTenant := &model.GetTenant{
 		Name: req.GetName(),
@@ -86,13 +200,166 @@ func (s *Server) GetTenant(ctx context.Context, req *coordinatorpb.GetTenantRequ
 		}
 		return res, grpcutils.BuildInternalGrpcError(err.Error())
 	}
-	res.Tenant = &coordinatorpb.Tenant{
-		Name: tenant.Name,
-	}
+	res.Tenant = convertTenantToProto(tenant)
 	log.Info("GetTenant success", zap.String("request", req.String()))
 	return res, nil
 }
 
+func (s *Server) ListTenants(ctx context.Context, req *coordinatorpb.ListTenantsRequest) (*coordinatorpb.ListTenantsResponse, error) {
+	res := &coordinatorpb.ListTenantsResponse{}
+	startAfter, err := decodeNamePageToken(req.PageToken)
+	if err != nil {
+		log.Error("error ListTenants", zap.String("request", req.String()), zap.Error(err))
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", err.Error())
+		if err != nil {
+			return res, err
+		}
+		return res, grpcError
+	}
+	limit := pageSize(req.Limit)
+	// Fetch one extra row to know whether there is a next page.
+	tenants, err := s.coordinator.ListTenants(ctx, &model.ListTenants{
+		NamePrefix: req.GetNamePrefix(),
+		StartAfter: startAfter,
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		log.Error("error ListTenants", zap.String("request", req.String()), zap.Error(err))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if len(tenants) > int(limit) {
+		tenants = tenants[:limit]
+		res.NextPageToken, err = encodePageToken(&namePageToken{After: tenants[limit-1].Name})
+		if err != nil {
+			return res, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	res.Tenants = make([]*coordinatorpb.Tenant, 0, len(tenants))
+	for _, tenant := range tenants {
+		res.Tenants = append(res.Tenants, convertTenantToProto(tenant))
+	}
+	log.Info("ListTenants success", zap.String("request", req.String()), zap.Int("count", len(res.Tenants)))
+	return res, nil
+}
+
+func (s *Server) UpdateTenant(ctx context.Context, req *coordinatorpb.UpdateTenantRequest) (*coordinatorpb.UpdateTenantResponse, error) {
+	res := &coordinatorpb.UpdateTenantResponse{}
+	metadata, err := convertMetadataUpdateToModel(req.GetMetadata(), req.MergeMetadata)
+	if err != nil {
+		log.Error("error UpdateTenant", zap.String("request", req.String()), zap.Error(err))
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	tenant, err := s.coordinator.UpdateTenant(ctx, &model.UpdateTenant{
+		Name:          req.GetName(),
+		Metadata:      metadata,
+		ResetMetadata: req.GetResetMetadata(),
+		MergeMetadata: req.MergeMetadata,
+	})
+	if err != nil {
+		log.Error("error UpdateTenant", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		if err == common.ErrInvalidMetadataUpdate {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("merge_metadata", err.Error())
+			if err != nil {
+				return res, err
+			}
+			return res, grpcError
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.Tenant = convertTenantToProto(tenant)
+	log.Info("UpdateTenant success", zap.String("request", req.String()))
+	return res, nil
+}
+
+// convertMetadataUpdateToModel converts the metadata of a tenant or database
+// update, keeping the keys a merge deletes.
+func convertMetadataUpdateToModel(metadata *coordinatorpb.UpdateMetadata, merge bool) (*model.CollectionMetadata[model.CollectionMetadataValueType], error) {
+	if merge {
+		return convertCollectionMetadataUpdateToModel(metadata)
+	}
+	return convertCollectionMetadataToModel(metadata)
+}
+
+func (s *Server) DeleteTenant(ctx context.Context, req *coordinatorpb.DeleteTenantRequest) (*coordinatorpb.DeleteTenantResponse, error) {
+	res := &coordinatorpb.DeleteTenantResponse{}
+	deleteTenant := &model.DeleteTenant{
+		Name: req.GetName(),
+	}
+	err := s.coordinator.DeleteTenant(ctx, deleteTenant)
+	if err != nil {
+		log.Error("error DeleteTenant", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	log.Info("DeleteTenant success", zap.String("request", req.String()))
+	return res, nil
+}
+
+func (s *Server) SuspendTenant(ctx context.Context, req *coordinatorpb.SuspendTenantRequest) (*coordinatorpb.SuspendTenantResponse, error) {
+	res := &coordinatorpb.SuspendTenantResponse{}
+	err := s.coordinator.SuspendTenant(ctx, req.GetName())
+	if err != nil {
+		log.Error("error SuspendTenant", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	log.Info("SuspendTenant success", zap.String("request", req.String()))
+	return res, nil
+}
+
+func (s *Server) ResumeTenant(ctx context.Context, req *coordinatorpb.ResumeTenantRequest) (*coordinatorpb.ResumeTenantResponse, error) {
+	res := &coordinatorpb.ResumeTenantResponse{}
+	err := s.coordinator.ResumeTenant(ctx, req.GetName())
+	if err != nil {
+		log.Error("error ResumeTenant", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	log.Info("ResumeTenant success", zap.String("request", req.String()))
+	return res, nil
+}
+
+func (s *Server) GetTenantQuota(ctx context.Context, req *coordinatorpb.GetTenantQuotaRequest) (*coordinatorpb.GetTenantQuotaResponse, error) {
+	res := &coordinatorpb.GetTenantQuotaResponse{}
+	tenantQuota, effectiveQuota, err := s.coordinator.GetTenantQuota(ctx, req.GetTenant())
+	if err != nil {
+		log.Error("error GetTenantQuota", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	if tenantQuota != nil {
+		res.Quota = convertTenantQuotaToProto(tenantQuota)
+	}
+	res.EffectiveQuota = convertQuotaToProto(effectiveQuota)
+	return res, nil
+}
+
+func (s *Server) SetTenantQuota(ctx context.Context, req *coordinatorpb.SetTenantQuotaRequest) (*coordinatorpb.SetTenantQuotaResponse, error) {
+	res := &coordinatorpb.SetTenantQuotaResponse{}
+	effectiveQuota, err := s.coordinator.SetTenantQuota(ctx, convertTenantQuotaToModel(req.GetTenant(), req.GetQuota()))
+	if err != nil {
+		log.Error("error SetTenantQuota", zap.String("request", req.String()), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			return res, grpcutils.BuildNotFoundGrpcError(err.Error())
+		}
+		return res, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res.EffectiveQuota = convertQuotaToProto(effectiveQuota)
+	log.Info("SetTenantQuota success", zap.String("request", req.String()))
+	return res, nil
+}
+
 func (s *Server) SetLastCompactionTimeForTenant(ctx context.Context, req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
 	err := s.coordinator.SetTenantLastCompactionTime(ctx, req.TenantLastCompactionTime.TenantId, req.TenantLastCompactionTime.LastCompactionTime)
 	if err != nil {
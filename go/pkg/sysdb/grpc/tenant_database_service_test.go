@@ -12,10 +12,12 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
@@ -95,7 +97,310 @@ func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantLastCompactionTime
 	suite.Equal(int64(1), tenants.TenantLastCompactionTime[0].LastCompactionTime)
 
 	// clean up
-	err = dao.CleanUpTestTenant(suite.db, tenantId)
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantId)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_DeleteDatabase() {
+	log.Info("TestServer_DeleteDatabase")
+	tenantId := "TestDeleteDatabase"
+	databaseName := "TestDeleteDatabase"
+	_, err := suite.s.DeleteDatabase(context.Background(), &coordinatorpb.DeleteDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	_, err = dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), tenantId, databaseName)
+	suite.NoError(err)
+	_, err = suite.s.DeleteDatabase(context.Background(), &coordinatorpb.DeleteDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+	})
+	suite.NoError(err)
+	_, err = suite.s.GetDatabase(context.Background(), &coordinatorpb.GetDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantId)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_SuspendResumeDeleteTenant() {
+	log.Info("TestServer_SuspendResumeDeleteTenant")
+	tenantId := "TestSuspendResumeDeleteTenant"
+	databaseName := "TestSuspendResumeDeleteTenant"
+	_, err := suite.s.SuspendTenant(context.Background(), &coordinatorpb.SuspendTenantRequest{Name: tenantId})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	_, err = dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), tenantId, databaseName)
+	suite.NoError(err)
+	_, err = suite.s.SuspendTenant(context.Background(), &coordinatorpb.SuspendTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	suspendedCollectionID := types.NewUniqueID().String()
+	_, err = suite.s.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{
+		Id:       suspendedCollectionID,
+		Name:     "suspended_collection",
+		Tenant:   tenantId,
+		Database: databaseName,
+		Segments: collectionSegments(suspendedCollectionID),
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = suite.s.ResumeTenant(context.Background(), &coordinatorpb.ResumeTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	resumedCollectionID := types.NewUniqueID().String()
+	_, err = suite.s.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{
+		Id:       resumedCollectionID,
+		Name:     "resumed_collection",
+		Tenant:   tenantId,
+		Database: databaseName,
+		Segments: collectionSegments(resumedCollectionID),
+	})
+	suite.NoError(err)
+
+	_, err = suite.s.DeleteTenant(context.Background(), &coordinatorpb.DeleteTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	_, err = suite.s.GetTenant(context.Background(), &coordinatorpb.GetTenantRequest{Name: tenantId})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// clean up
+	err = suite.s.coordinator.CleanupSoftDeletedTenant(context.Background(), tenantId)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantAndDatabaseMetadata() {
+	log.Info("TestServer_TenantAndDatabaseMetadata")
+	ctx := context.Background()
+	tenantId := "TestTenantAndDatabaseMetadata"
+	databaseName := "TestTenantAndDatabaseMetadata"
+	stringValue := func(s string) *coordinatorpb.UpdateMetadataValue {
+		return &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: s}}
+	}
+
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{
+		Name: tenantId,
+		Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"plan":  stringValue("pro"),
+			"owner": stringValue("search"),
+		}},
+	})
+	suite.NoError(err)
+	tenantRes, err := suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	suite.Equal("pro", tenantRes.Tenant.Metadata.Metadata["plan"].GetStringValue())
+	suite.Equal("search", tenantRes.Tenant.Metadata.Metadata["owner"].GetStringValue())
+
+	// A merge upserts the given keys and deletes those without a value.
+	updateTenantRes, err := suite.s.UpdateTenant(ctx, &coordinatorpb.UpdateTenantRequest{
+		Name: tenantId,
+		MetadataUpdate: &coordinatorpb.UpdateTenantRequest_Metadata{Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"plan":   {},
+			"region": stringValue("eu"),
+		}}},
+		MergeMetadata: true,
+	})
+	suite.NoError(err)
+	suite.Len(updateTenantRes.Tenant.Metadata.Metadata, 2)
+	tenantRes, err = suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	suite.Len(tenantRes.Tenant.Metadata.Metadata, 2)
+	suite.Equal("eu", tenantRes.Tenant.Metadata.Metadata["region"].GetStringValue())
+	suite.NotContains(tenantRes.Tenant.Metadata.Metadata, "plan")
+
+	_, err = suite.s.UpdateTenant(ctx, &coordinatorpb.UpdateTenantRequest{
+		Name:           tenantId,
+		MetadataUpdate: &coordinatorpb.UpdateTenantRequest_ResetMetadata{ResetMetadata: true},
+	})
+	suite.NoError(err)
+	tenantRes, err = suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	suite.Nil(tenantRes.Tenant.Metadata)
+	_, err = suite.s.UpdateTenant(ctx, &coordinatorpb.UpdateTenantRequest{
+		Name:           "missing_tenant",
+		MetadataUpdate: &coordinatorpb.UpdateTenantRequest_ResetMetadata{ResetMetadata: true},
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     types.NewUniqueID().String(),
+		Name:   databaseName,
+		Tenant: tenantId,
+		Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"owner": stringValue("search"),
+		}},
+	})
+	suite.NoError(err)
+	databaseRes, err := suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantId})
+	suite.NoError(err)
+	suite.Equal("search", databaseRes.Database.Metadata.Metadata["owner"].GetStringValue())
+
+	// Without a merge the metadata is replaced.
+	_, err = suite.s.UpdateDatabase(ctx, &coordinatorpb.UpdateDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+		MetadataUpdate: &coordinatorpb.UpdateDatabaseRequest_Metadata{Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"tier": {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: 2}},
+		}}},
+	})
+	suite.NoError(err)
+	databaseRes, err = suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantId})
+	suite.NoError(err)
+	suite.Len(databaseRes.Database.Metadata.Metadata, 1)
+	suite.Equal(int64(2), databaseRes.Database.Metadata.Metadata["tier"].GetIntValue())
+	_, err = suite.s.UpdateDatabase(ctx, &coordinatorpb.UpdateDatabaseRequest{
+		Name:           "missing_database",
+		Tenant:         tenantId,
+		MetadataUpdate: &coordinatorpb.UpdateDatabaseRequest_ResetMetadata{ResetMetadata: true},
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// clean up
+	_, err = suite.s.DeleteTenant(ctx, &coordinatorpb.DeleteTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	err = suite.s.coordinator.CleanupSoftDeletedTenant(ctx, tenantId)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_ListTenantsAndDatabases() {
+	log.Info("TestServer_ListTenantsAndDatabases")
+	ctx := context.Background()
+	tenantIds := []string{"TestListTenants_a", "TestListTenants_b", "TestListTenants_c", "TestListTenantsX"}
+	for _, tenantId := range tenantIds {
+		_, err := dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), tenantId, "database_"+tenantId)
+		suite.NoError(err)
+	}
+	_, err := dao.CreateTestTenantAndDatabase(dao.NewMetaDomain(), "TestListDatabases", "database_a")
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     types.NewUniqueID().String(),
+		Name:   "database_b",
+		Tenant: "TestListDatabases",
+	})
+	suite.NoError(err)
+
+	// The prefix is matched literally, "_" is not a wildcard.
+	namePrefix := "TestListTenants_"
+	limit := int32(2)
+	res, err := suite.s.ListTenants(ctx, &coordinatorpb.ListTenantsRequest{
+		NamePrefix: &namePrefix,
+		Limit:      &limit,
+	})
+	suite.NoError(err)
+	suite.Len(res.Tenants, 2)
+	suite.Equal("TestListTenants_a", res.Tenants[0].Name)
+	suite.Equal("TestListTenants_b", res.Tenants[1].Name)
+	suite.NotZero(res.Tenants[0].CreatedAt)
+	suite.NotZero(res.Tenants[0].UpdatedAt)
+	suite.NotEmpty(res.NextPageToken)
+
+	res, err = suite.s.ListTenants(ctx, &coordinatorpb.ListTenantsRequest{
+		NamePrefix: &namePrefix,
+		Limit:      &limit,
+		PageToken:  &res.NextPageToken,
+	})
+	suite.NoError(err)
+	suite.Len(res.Tenants, 1)
+	suite.Equal("TestListTenants_c", res.Tenants[0].Name)
+	suite.Empty(res.NextPageToken)
+
+	invalidToken := "not a page token"
+	_, err = suite.s.ListTenants(ctx, &coordinatorpb.ListTenantsRequest{PageToken: &invalidToken})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{Tenant: "TestListDatabasesMissing"})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	limit = 1
+	databasesRes, err := suite.s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{
+		Tenant: "TestListDatabases",
+		Limit:  &limit,
+	})
+	suite.NoError(err)
+	suite.Len(databasesRes.Databases, 1)
+	suite.Equal("database_a", databasesRes.Databases[0].Name)
+	suite.Equal("TestListDatabases", databasesRes.Databases[0].Tenant)
+	suite.NotZero(databasesRes.Databases[0].CreatedAt)
+	databasesRes, err = suite.s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{
+		Tenant:    "TestListDatabases",
+		Limit:     &limit,
+		PageToken: &databasesRes.NextPageToken,
+	})
+	suite.NoError(err)
+	suite.Len(databasesRes.Databases, 1)
+	suite.Equal("database_b", databasesRes.Databases[0].Name)
+	suite.Empty(databasesRes.NextPageToken)
+
+	// clean up
+	for _, tenantId := range append(tenantIds, "TestListDatabases") {
+		err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantId)
+		suite.NoError(err)
+	}
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_ReadReplica() {
+	log.Info("TestServer_ReadReplica")
+	ctx := context.Background()
+	// An empty catalog stands in for a read replica lagging behind the primary.
+	replica := dbcore.ConfigDatabaseForTesting()
+	dbcore.SetGlobalDB(suite.db)
+	dbcore.SetReplicaDB(replica)
+	defer dbcore.SetReplicaDB(nil)
+
+	tenantName := "TestReadReplica"
+	databaseName := "database_TestReadReplica"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     types.NewUniqueID().String(),
+		Name:   databaseName,
+		Tenant: tenantName,
+	})
+	suite.NoError(err)
+	collectionID := types.NewUniqueID().String()
+	getOrCreate := false
+	_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:          collectionID,
+		Name:        "collection_TestReadReplica",
+		Tenant:      tenantName,
+		Database:    databaseName,
+		GetOrCreate: &getOrCreate,
+		Segments:    collectionSegments(collectionID),
+	})
+	suite.NoError(err)
+
+	// Reads are served by the replica, which has not seen the changes yet.
+	_, err = suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantName})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantName})
+	suite.Equal(codes.NotFound, status.Code(err))
+	collections, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Empty(collections.Collections)
+	segments, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: collectionID})
+	suite.NoError(err)
+	suite.Empty(segments.Segments)
+
+	// Reads that ask to read their own writes are served by the primary.
+	primaryCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(readYourWritesHeader, "true"))
+	tenant, err := suite.s.GetTenant(primaryCtx, &coordinatorpb.GetTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	suite.Equal(tenantName, tenant.Tenant.Name)
+	database, err := suite.s.GetDatabase(primaryCtx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	suite.Equal(databaseName, database.Database.Name)
+	collections, err = suite.s.GetCollections(primaryCtx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 1)
+	segments, err = suite.s.GetSegments(primaryCtx, &coordinatorpb.GetSegmentsRequest{Collection: collectionID})
+	suite.NoError(err)
+	suite.Len(segments.Segments, 3)
+
+	// clean up
+	err = dao.CleanUpTestTenant(dao.NewMetaDomain(), tenantName)
 	suite.NoError(err)
 }
 
@@ -0,0 +1,110 @@
+package logservice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// ILogService fails with common.ErrCollectionLogPurged when records it needs
+// were already purged from the log.
+type ILogService interface {
+	// ForkLogs copies the records of a collection after startAfterOffset to
+	// the log of a new collection, numbered from 1, and returns their count.
+	ForkLogs(ctx context.Context, sourceCollectionId string, targetCollectionId string, startAfterOffset int64) (int64, error)
+	// ResetCollectionLogOffset moves the compaction offset of a collection back
+	// to logOffset, so that the records after it are compacted again.
+	ResetCollectionLogOffset(ctx context.Context, collectionId string, logOffset int64) error
+	// UpdateCollectionLogOffset marks the records of a collection up to
+	// logOffset as compacted. It undoes a ResetCollectionLogOffset whose
+	// rollback could not be recorded in the catalog.
+	UpdateCollectionLogOffset(ctx context.Context, collectionId string, logOffset int64) error
+	// DeleteLogs deletes the log of a collection. It undoes a ForkLogs whose
+	// fork could not be created in the catalog.
+	DeleteLogs(ctx context.Context, collectionId string) error
+}
+
+type LogService struct {
+	client logservicepb.LogServiceClient
+}
+
+func NewLogService(conn string) *LogService {
+	backoffConfig := backoff.Config{
+		BaseDelay:  1 * time.Second, // Initial delay before retrying
+		Multiplier: 1.5,             // Factor to increase delay each retry
+		MaxDelay:   5 * time.Second, // Maximum delay between retries
+	}
+
+	grpcClient, err := grpc.NewClient(conn,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoffConfig,
+			MinConnectTimeout: 5 * time.Second,
+		}),
+	)
+
+	if err != nil {
+		log.Fatalf("Failed to connect to the log service: %v", err)
+	}
+
+	client := logservicepb.NewLogServiceClient(grpcClient)
+	return &LogService{
+		client: client,
+	}
+}
+
+func (s *LogService) ForkLogs(ctx context.Context, sourceCollectionId string, targetCollectionId string, startAfterOffset int64) (int64, error) {
+	request := &logservicepb.ForkLogsRequest{
+		SourceCollectionId: sourceCollectionId,
+		TargetCollectionId: targetCollectionId,
+		StartAfterOffset:   startAfterOffset,
+	}
+	response, err := s.client.ForkLogs(ctx, request)
+	if err != nil {
+		return 0, convertError(err)
+	}
+	return response.RecordCount, nil
+}
+
+func (s *LogService) ResetCollectionLogOffset(ctx context.Context, collectionId string, logOffset int64) error {
+	request := &logservicepb.ResetCollectionLogOffsetRequest{
+		CollectionId: collectionId,
+		LogOffset:    logOffset,
+	}
+	_, err := s.client.ResetCollectionLogOffset(ctx, request)
+	return convertError(err)
+}
+
+func (s *LogService) UpdateCollectionLogOffset(ctx context.Context, collectionId string, logOffset int64) error {
+	request := &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: collectionId,
+		LogOffset:    logOffset,
+	}
+	_, err := s.client.UpdateCollectionLogOffset(ctx, request)
+	return err
+}
+
+func (s *LogService) DeleteLogs(ctx context.Context, collectionId string) error {
+	request := &logservicepb.DeleteLogsRequest{
+		CollectionId: collectionId,
+	}
+	_, err := s.client.DeleteLogs(ctx, request)
+	return err
+}
+
+// convertError converts the failed preconditions the log service reports for
+// purged records to common.ErrCollectionLogPurged.
+func convertError(err error) error {
+	if status.Code(err) == codes.FailedPrecondition {
+		return common.ErrCollectionLogPurged
+	}
+	return err
+}
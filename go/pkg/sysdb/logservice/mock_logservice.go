@@ -0,0 +1,107 @@
+package logservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+)
+
+// MockLogService keeps the enumeration, compaction and purge offsets of the
+// logs of collections, without their records.
+type MockLogService struct {
+	mu                 sync.Mutex
+	enumerationOffsets map[string]int64
+	compactionOffsets  map[string]int64
+	purgeOffsets       map[string]int64
+}
+
+func NewMockLogService() *MockLogService {
+	return &MockLogService{
+		enumerationOffsets: make(map[string]int64),
+		compactionOffsets:  make(map[string]int64),
+		purgeOffsets:       make(map[string]int64),
+	}
+}
+
+// PushLogs appends count records to the log of a collection.
+func (s *MockLogService) PushLogs(collectionId string, count int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enumerationOffsets[collectionId] += count
+}
+
+// PurgeLogs purges the compacted records of a collection.
+func (s *MockLogService) PurgeLogs(collectionId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purgeOffsets[collectionId] = s.compactionOffsets[collectionId]
+}
+
+// CompactionOffset returns the offset of the last compacted record of a
+// collection.
+func (s *MockLogService) CompactionOffset(collectionId string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compactionOffsets[collectionId]
+}
+
+// EnumerationOffset returns the offset of the last record of a collection.
+func (s *MockLogService) EnumerationOffset(collectionId string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enumerationOffsets[collectionId]
+}
+
+// HasLog returns whether a collection has a log.
+func (s *MockLogService) HasLog(collectionId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.enumerationOffsets[collectionId]
+	return ok
+}
+
+func (s *MockLogService) ForkLogs(ctx context.Context, sourceCollectionId string, targetCollectionId string, startAfterOffset int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if startAfterOffset < s.compactionOffsets[sourceCollectionId] {
+		return 0, common.ErrCollectionLogPurged
+	}
+	if _, ok := s.enumerationOffsets[targetCollectionId]; ok {
+		return 0, errors.New("collection already has a log")
+	}
+	count := max(s.enumerationOffsets[sourceCollectionId]-startAfterOffset, 0)
+	s.enumerationOffsets[targetCollectionId] = count
+	return count, nil
+}
+
+func (s *MockLogService) ResetCollectionLogOffset(ctx context.Context, collectionId string, logOffset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if logOffset < s.purgeOffsets[collectionId] {
+		return common.ErrCollectionLogPurged
+	}
+	if logOffset < s.compactionOffsets[collectionId] {
+		s.compactionOffsets[collectionId] = logOffset
+	}
+	return nil
+}
+
+// UpdateCollectionLogOffset marks the records of a collection up to logOffset
+// as compacted, which allows them to be purged.
+func (s *MockLogService) UpdateCollectionLogOffset(ctx context.Context, collectionId string, logOffset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.compactionOffsets[collectionId] = logOffset
+	return nil
+}
+
+func (s *MockLogService) DeleteLogs(ctx context.Context, collectionId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.enumerationOffsets, collectionId)
+	delete(s.compactionOffsets, collectionId)
+	delete(s.purgeOffsets, collectionId)
+	return nil
+}
@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type auditEventDb struct {
+	db *gorm.DB
+}
+
+func (s *auditEventDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.AuditEvent{}).Error
+}
+
+func (s *auditEventDb) Insert(in *dbmodel.AuditEvent) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert audit event failed", zap.String("operation", in.Operation), zap.Error(err))
+	}
+	return err
+}
+
+func (s *auditEventDb) ListAuditEvents(query *dbmodel.ListAuditEventsQuery) ([]*dbmodel.AuditEvent, error) {
+	var auditEvents []*dbmodel.AuditEvent
+	tx := s.db.Where("id > ?", query.AfterID)
+	if query.TenantID != "" {
+		tx = tx.Where("tenant_id = ?", query.TenantID)
+	}
+	if !query.Start.IsZero() {
+		tx = tx.Where("created_at >= ?", query.Start)
+	}
+	if !query.End.IsZero() {
+		tx = tx.Where("created_at < ?", query.End)
+	}
+	err := tx.Order("id").Limit(int(query.Limit)).Find(&auditEvents).Error
+	if err != nil {
+		log.Error("list audit events failed", zap.Error(err))
+		return nil, err
+	}
+	return auditEvents, nil
+}
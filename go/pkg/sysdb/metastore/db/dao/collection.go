@@ -3,6 +3,7 @@ package dao
 import (
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -41,35 +42,60 @@ func (s *collectionDb) GetCollectionEntry(collectionID *string, databaseName *st
 }
 
 func (s *collectionDb) GetCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*dbmodel.CollectionAndMetadata, error) {
-	return s.getCollections(id, name, tenantID, databaseName, limit, offset, false)
+	return s.getCollections(&dbmodel.ListCollectionsQuery{
+		ID:           id,
+		Name:         name,
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		Limit:        limit,
+		Offset:       offset,
+	}, false)
 }
 
-func (s *collectionDb) getCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32, is_deleted bool) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
+func (s *collectionDb) ListCollections(query *dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(query, false)
+}
+
+func (s *collectionDb) ListSoftDeletedCollections(query *dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(query, true)
+}
+
+func (s *collectionDb) getCollections(collectionQuery *dbmodel.ListCollectionsQuery, is_deleted bool) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
 	var collections []*dbmodel.Collection
 	query := s.db.Table("collections").
-		Select("collections.id, collections.log_position, collections.version, collections.name, collections.configuration_json_str, collections.dimension, collections.database_id, collections.is_deleted, databases.name, databases.tenant_id").
+		Select("collections.id, collections.log_position, collections.version, collections.name, collections.configuration_json_str, collections.dimension, collections.database_id, collections.is_deleted, collections.created_at, collections.updated_at, collections.row_version, databases.name, databases.tenant_id").
 		Joins("INNER JOIN databases ON collections.database_id = databases.id").
-		Order("collections.created_at ASC")
+		Order("collections.created_at ASC, collections.id ASC")
 
-	if databaseName != "" {
-		query = query.Where("databases.name = ?", databaseName)
+	if collectionQuery.DatabaseName != "" {
+		query = query.Where("databases.name = ?", collectionQuery.DatabaseName)
 	}
-	if tenantID != "" {
-		query = query.Where("databases.tenant_id = ?", tenantID)
+	if collectionQuery.TenantID != "" {
+		query = query.Where("databases.tenant_id = ?", collectionQuery.TenantID)
 	}
-	if id != nil {
-		query = query.Where("collections.id = ?", *id)
+	if collectionQuery.ID != nil {
+		query = query.Where("collections.id = ?", *collectionQuery.ID)
 	}
-	if name != nil {
-		query = query.Where("collections.name = ?", *name)
+	if collectionQuery.Name != nil {
+		query = query.Where("collections.name = ?", *collectionQuery.Name)
 	}
 	query = query.Where("collections.is_deleted = ?", is_deleted)
+	if collectionQuery.Where != nil {
+		condition, args, err := collectionMetadataFilterSQL(collectionQuery.Where)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("("+condition+")", args...)
+	}
 
-	if limit != nil {
-		query = query.Limit(int(*limit))
+	if after := collectionQuery.After; after != nil {
+		query = query.Where("(collections.created_at, collections.id) > (?, ?)", after.CreatedAt, after.ID)
 	}
-	if offset != nil {
-		query = query.Offset(int(*offset))
+	if collectionQuery.Limit != nil {
+		query = query.Limit(int(*collectionQuery.Limit))
+	}
+	if collectionQuery.Offset != nil {
+		query = query.Offset(int(*collectionQuery.Offset))
 
 	}
 	rows, err := query.Rows()
@@ -88,11 +114,13 @@ func (s *collectionDb) getCollections(id *string, name *string, tenantID string,
 			collectionDatabaseID           string
 			collectionIsDeleted            bool
 			collectionCreatedAt            sql.NullTime
+			collectionUpdatedAt            sql.NullTime
+			collectionRowVersion           sql.NullInt64
 			databaseName                   string
 			databaseTenantID               string
 		)
 
-		err := rows.Scan(&collectionID, &logPosition, &version, &collectionName, &collectionConfigurationJsonStr, &collectionDimension, &collectionDatabaseID, &collectionIsDeleted, &databaseName, &databaseTenantID)
+		err := rows.Scan(&collectionID, &logPosition, &version, &collectionName, &collectionConfigurationJsonStr, &collectionDimension, &collectionDatabaseID, &collectionIsDeleted, &collectionCreatedAt, &collectionUpdatedAt, &collectionRowVersion, &databaseName, &databaseTenantID)
 		if err != nil {
 			log.Error("scan collection failed", zap.Error(err))
 			return nil, err
@@ -106,6 +134,7 @@ func (s *collectionDb) getCollections(id *string, name *string, tenantID string,
 			LogPosition:          logPosition,
 			Version:              version,
 			IsDeleted:            collectionIsDeleted,
+			RowVersion:           collectionRowVersion.Int64,
 		}
 		if collectionDimension.Valid {
 			collection.Dimension = &collectionDimension.Int32
@@ -113,6 +142,9 @@ func (s *collectionDb) getCollections(id *string, name *string, tenantID string,
 		if collectionCreatedAt.Valid {
 			collection.CreatedAt = collectionCreatedAt.Time
 		}
+		if collectionUpdatedAt.Valid {
+			collection.UpdatedAt = collectionUpdatedAt.Time
+		}
 
 		collectionWithMetdata = append(collectionWithMetdata, &dbmodel.CollectionAndMetadata{
 			Collection:   collection,
@@ -121,21 +153,97 @@ func (s *collectionDb) getCollections(id *string, name *string, tenantID string,
 		})
 	}
 	rows.Close()
-	for _, collection := range collectionWithMetdata {
+	err = s.getCollectionMetadata(collectionWithMetdata)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// collectionMetadataBatchSize bounds the number of collection ids bound to a
+// single metadata query, to stay below the parameter limits of the databases.
+const collectionMetadataBatchSize = 1000
+
+// getCollectionMetadata fills the metadata of collections with one query per
+// batch of collections, rather than one query per collection.
+func (s *collectionDb) getCollectionMetadata(collections []*dbmodel.CollectionAndMetadata) error {
+	collectionByID := make(map[string]*dbmodel.CollectionAndMetadata, len(collections))
+	collectionIDs := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		collection.CollectionMetadata = []*dbmodel.CollectionMetadata{}
+		collectionByID[collection.Collection.ID] = collection
+		collectionIDs = append(collectionIDs, collection.Collection.ID)
+	}
+	for start := 0; start < len(collectionIDs); start += collectionMetadataBatchSize {
+		end := min(start+collectionMetadataBatchSize, len(collectionIDs))
 		var metadata []*dbmodel.CollectionMetadata
-		err = s.db.Where("collection_id = ?", collection.Collection.ID).Find(&metadata).Error
+		err := s.db.Where("collection_id IN ?", collectionIDs[start:end]).Find(&metadata).Error
 		if err != nil {
 			log.Error("get collection metadata failed", zap.Error(err))
-			return nil, err
+			return err
+		}
+		for _, m := range metadata {
+			collection := collectionByID[m.CollectionID]
+			collection.CollectionMetadata = append(collection.CollectionMetadata, m)
 		}
-		collection.CollectionMetadata = metadata
 	}
-
-	return
+	return nil
 }
 
 func (s *collectionDb) GetSoftDeletedCollections(collectionID *string, tenantID string, databaseName string, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
-	return s.getCollections(collectionID, nil, tenantID, databaseName, &limit, nil, true)
+	return s.getCollections(&dbmodel.ListCollectionsQuery{
+		ID:           collectionID,
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		Limit:        &limit,
+	}, true)
+}
+
+// GetCollectionsByDatabaseID returns every collection of a database, including
+// soft deleted ones, without their metadata.
+func (s *collectionDb) GetCollectionsByDatabaseID(databaseID string) ([]*dbmodel.Collection, error) {
+	var collections []*dbmodel.Collection
+	err := s.db.Where("database_id = ?", databaseID).Find(&collections).Error
+	if err != nil {
+		log.Error("get collections by database id failed", zap.Error(err))
+		return nil, err
+	}
+	return collections, nil
+}
+
+// GetExistingCollectionIDs returns the collection ids, among collectionIDs,
+// of collections that exist, soft deleted ones included.
+func (s *collectionDb) GetExistingCollectionIDs(collectionIDs []string) ([]string, error) {
+	existingCollectionIDs := []string{}
+	if len(collectionIDs) == 0 {
+		return existingCollectionIDs, nil
+	}
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("id IN ?", collectionIDs).
+		Pluck("id", &existingCollectionIDs).Error
+	if err != nil {
+		log.Error("get existing collection ids failed", zap.Error(err))
+		return nil, err
+	}
+	return existingCollectionIDs, nil
+}
+
+// CountCollections counts the collections of a tenant, or of one of its
+// databases, that are not soft deleted.
+func (s *collectionDb) CountCollections(tenantID string, databaseName *string) (uint64, error) {
+	var count int64
+	query := s.db.Table("collections").
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("databases.tenant_id = ? AND collections.is_deleted = ?", tenantID, false)
+	if databaseName != nil {
+		query = query.Where("databases.name = ?", *databaseName)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		log.Error("count collections failed", zap.Error(err))
+		return 0, err
+	}
+	return uint64(count), nil
 }
 
 // NOTE: This is the only method to do a hard delete of a single collection.
@@ -146,6 +254,11 @@ func (s *collectionDb) DeleteCollectionByID(collectionID string) (int, error) {
 }
 
 func (s *collectionDb) Insert(in *dbmodel.Collection) error {
+	// Set created_at here rather than through the column default, which sqlite
+	// stores at second precision, so that page cursors compare equal to it.
+	if in.CreatedAt.IsZero() {
+		in.CreatedAt = time.Now()
+	}
 	err := s.db.Create(&in).Error
 	if err != nil {
 		log.Error("create collection failed", zap.Error(err))
@@ -161,6 +274,10 @@ func (s *collectionDb) Insert(in *dbmodel.Collection) error {
 				return err
 			}
 		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Error("collection already exists")
+			return common.ErrCollectionUniqueConstraintViolation
+		}
 		return err
 	}
 	return nil
@@ -171,12 +288,19 @@ func generateCollectionUpdatesWithoutID(in *dbmodel.Collection) map[string]inter
 	if in.Name != nil {
 		ret["name"] = *in.Name
 	}
+	if in.ConfigurationJsonStr != nil {
+		ret["configuration_json_str"] = *in.ConfigurationJsonStr
+	}
 	if in.Dimension != nil {
 		ret["dimension"] = *in.Dimension
 	}
+	if in.DatabaseID != "" {
+		ret["database_id"] = in.DatabaseID
+	}
 	if in.IsDeleted {
 		ret["is_deleted"] = true
 	}
+	ret["row_version"] = gorm.Expr("row_version + 1")
 	return ret
 }
 
@@ -198,11 +322,40 @@ func (s *collectionDb) Update(in *dbmodel.Collection) error {
 				return err
 			}
 		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Error("collection already exists")
+			return common.ErrCollectionUniqueConstraintViolation
+		}
 		return err
 	}
 	return nil
 }
 
+// Restore clears the soft delete flag of a collection and gives it the given name.
+func (s *collectionDb) Restore(collectionID string, name string) error {
+	log.Info("restore collection", zap.String("collectionID", collectionID), zap.String("name", name))
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ?", collectionID).
+		Where("is_deleted = ?", true).
+		Updates(map[string]interface{}{"name": name, "is_deleted": false, "row_version": gorm.Expr("row_version + 1")})
+	if result.Error != nil {
+		log.Error("restore collection failed", zap.Error(result.Error))
+		var pgErr *pgconn.PgError
+		ok := errors.As(result.Error, &pgErr)
+		if ok && pgErr.Code == "23505" {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrCollectionNotFound
+	}
+	return nil
+}
+
 func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
 	log.Info("update log position and version", zap.String("collectionID", collectionID), zap.Int64("logPosition", logPosition), zap.Int32("currentCollectionVersion", currentCollectionVersion))
 	var collection dbmodel.Collection
@@ -224,13 +377,50 @@ func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosit
 	}
 
 	version := currentCollectionVersion + 1
-	err = s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{"log_position": logPosition, "version": version}).Error
+	err = s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{"log_position": logPosition, "version": version, "row_version": gorm.Expr("row_version + 1")}).Error
 	if err != nil {
 		return 0, err
 	}
 	return version, nil
 }
 
+// ResetLogPosition moves the log position of a collection, backwards if need
+// be, and bumps its version. It returns the new version.
+func (s *collectionDb) ResetLogPosition(collectionID string, logPosition int64) (int32, error) {
+	log.Info("reset log position", zap.String("collectionID", collectionID), zap.Int64("logPosition", logPosition))
+	var collection dbmodel.Collection
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND is_deleted = ?", collectionID, false).First(&collection).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, common.ErrCollectionNotFound
+		}
+		return 0, err
+	}
+	version := collection.Version + 1
+	err = s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{"log_position": logPosition, "version": version, "row_version": gorm.Expr("row_version + 1")}).Error
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// CheckRowVersion locks the collection row for the rest of the transaction and
+// checks that its row version is the expected one.
+func (s *collectionDb) CheckRowVersion(collectionID string, expectedRowVersion int64) error {
+	var collection dbmodel.Collection
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "row_version").Where("id = ?", collectionID).First(&collection).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.ErrCollectionNotFound
+		}
+		return err
+	}
+	if collection.RowVersion != expectedRowVersion {
+		return &common.RowVersionMismatchError{CurrentRowVersion: collection.RowVersion}
+	}
+	return nil
+}
+
 func (s *collectionDb) CheckCollectionIsSoftDeleted(collectionName string, tenantID string, databaseName string) (bool, string, error) {
 	var collection dbmodel.Collection
 	query := s.db.Select("collections.is_deleted, collections.id").
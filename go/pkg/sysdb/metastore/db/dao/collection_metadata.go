@@ -25,6 +25,18 @@ func (s *collectionMetadataDb) DeleteByCollectionID(collectionID string) (int, e
 	return len(metadata), err
 }
 
+func (s *collectionMetadataDb) DeleteByCollectionIDAndKeys(collectionID string, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	var metadata []dbmodel.CollectionMetadata
+	err := s.db.Clauses(clause.Returning{}).
+		Where("collection_id = ?", collectionID).
+		Where("key IN ?", keys).
+		Delete(&metadata).Error
+	return len(metadata), err
+}
+
 func (s *collectionMetadataDb) Insert(in []*dbmodel.CollectionMetadata) error {
 	return s.db.Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "collection_id"}, {Name: "key"}},
@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+)
+
+// collectionMetadataFilterSQL translates a collection metadata filter to a
+// condition on collections.id. Each leaf becomes a subquery on
+// collection_metadata, so a collection matches a leaf only through the row of
+// its key.
+func collectionMetadataFilterSQL(filter *model.CollectionMetadataFilter) (string, []interface{}, error) {
+	switch filter.Operator {
+	case model.CollectionMetadataFilterAnd, model.CollectionMetadataFilterOr:
+		operator := " AND "
+		if filter.Operator == model.CollectionMetadataFilterOr {
+			operator = " OR "
+		}
+		conditions := make([]string, 0, len(filter.Children))
+		var args []interface{}
+		for _, child := range filter.Children {
+			condition, childArgs, err := collectionMetadataFilterSQL(child)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions = append(conditions, "("+condition+")")
+			args = append(args, childArgs...)
+		}
+		if len(conditions) == 0 {
+			return "", nil, common.ErrInvalidMetadataFilter
+		}
+		return strings.Join(conditions, operator), args, nil
+	}
+
+	valueCondition, valueArgs, err := collectionMetadataValueSQL(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	// Ne and Nin exclude the collections whose value matches, so that
+	// collections without the key are kept.
+	membership := "IN"
+	if filter.Operator == model.CollectionMetadataFilterNe || filter.Operator == model.CollectionMetadataFilterNin {
+		membership = "NOT IN"
+	}
+	condition := "collections.id " + membership + " (SELECT collection_metadata.collection_id FROM collection_metadata WHERE collection_metadata.key = ? AND (" + valueCondition + "))"
+	return condition, append([]interface{}{filter.Key}, valueArgs...), nil
+}
+
+// collectionMetadataValueSQL returns the condition on the value columns of a
+// collection_metadata row for a leaf filter. The values of an In or Nin list
+// are compared to the same columns, so they must be of a single type, ints and
+// floats aside.
+func collectionMetadataValueSQL(filter *model.CollectionMetadataFilter) (string, []interface{}, error) {
+	if len(filter.Values) == 0 {
+		return "", nil, common.ErrInvalidMetadataFilter
+	}
+	var comparator string
+	switch filter.Operator {
+	case model.CollectionMetadataFilterEq, model.CollectionMetadataFilterNe:
+		comparator = "="
+	case model.CollectionMetadataFilterGt:
+		comparator = ">"
+	case model.CollectionMetadataFilterGte:
+		comparator = ">="
+	case model.CollectionMetadataFilterLt:
+		comparator = "<"
+	case model.CollectionMetadataFilterLte:
+		comparator = "<="
+	case model.CollectionMetadataFilterIn, model.CollectionMetadataFilterNin:
+		comparator = "IN"
+	default:
+		return "", nil, common.ErrInvalidMetadataFilter
+	}
+
+	var columns []string
+	values := make([]interface{}, 0, len(filter.Values))
+	for _, value := range filter.Values {
+		var valueColumns []string
+		switch v := value.(type) {
+		case *model.CollectionMetadataValueStringType:
+			valueColumns = []string{"str_value"}
+			values = append(values, v.Value)
+		case *model.CollectionMetadataValueBoolType:
+			valueColumns = []string{"bool_value"}
+			values = append(values, v.Value)
+		case *model.CollectionMetadataValueInt64Type:
+			valueColumns = []string{"int_value", "float_value"}
+			values = append(values, v.Value)
+		case *model.CollectionMetadataValueFloat64Type:
+			valueColumns = []string{"int_value", "float_value"}
+			values = append(values, v.Value)
+		default:
+			return "", nil, common.ErrInvalidMetadataFilter
+		}
+		if columns != nil && columns[0] != valueColumns[0] {
+			return "", nil, common.ErrInvalidMetadataFilter
+		}
+		columns = valueColumns
+	}
+
+	var arg interface{} = values
+	if comparator != "IN" {
+		arg = values[0]
+	}
+	conditions := make([]string, 0, len(columns))
+	args := make([]interface{}, 0, len(columns))
+	for _, column := range columns {
+		conditions = append(conditions, "collection_metadata."+column+" "+comparator+" ?")
+		args = append(args, arg)
+	}
+	return strings.Join(conditions, " OR "), args, nil
+}
@@ -2,14 +2,18 @@ package dao
 
 import (
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"gorm.io/gorm"
 )
 
@@ -30,22 +34,55 @@ func (suite *CollectionDbTestSuite) SetupSuite() {
 	}
 	suite.tenantName = "test_collection_tenant"
 	suite.databaseName = "test_collection_database"
-	DbId, err := CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
+	DbId, err := CreateTestTenantAndDatabase(NewMetaDomain(), suite.tenantName, suite.databaseName)
 	suite.NoError(err)
 	suite.databaseId = DbId
 }
 
 func (suite *CollectionDbTestSuite) TearDownSuite() {
 	log.Info("teardown suite")
-	err := CleanUpTestDatabase(suite.db, suite.tenantName, suite.databaseName)
+	err := CleanUpTestDatabase(NewMetaDomain(), suite.tenantName, suite.databaseName)
 	suite.NoError(err)
-	err = CleanUpTestTenant(suite.db, suite.tenantName)
+	err = CleanUpTestTenant(NewMetaDomain(), suite.tenantName)
 	suite.NoError(err)
 }
 
+func (suite *CollectionDbTestSuite) TestCollectionDb_CountCollections() {
+	count, err := suite.collectionDb.CountCollections(suite.tenantName, &suite.databaseName)
+	suite.NoError(err)
+	suite.Equal(uint64(0), count)
+
+	collectionID1, err := CreateTestCollection(NewMetaDomain(), "test_collection_count1", 128, suite.databaseId)
+	suite.NoError(err)
+	collectionID2, err := CreateTestCollection(NewMetaDomain(), "test_collection_count2", 128, suite.databaseId)
+	suite.NoError(err)
+	err = suite.collectionDb.Update(&dbmodel.Collection{
+		ID:        collectionID2,
+		IsDeleted: true,
+		UpdatedAt: time.Now(),
+	})
+	suite.NoError(err)
+
+	count, err = suite.collectionDb.CountCollections(suite.tenantName, &suite.databaseName)
+	suite.NoError(err)
+	suite.Equal(uint64(1), count)
+	count, err = suite.collectionDb.CountCollections(suite.tenantName, nil)
+	suite.NoError(err)
+	suite.Equal(uint64(1), count)
+	otherDatabaseName := "other_database"
+	count, err = suite.collectionDb.CountCollections(suite.tenantName, &otherDatabaseName)
+	suite.NoError(err)
+	suite.Equal(uint64(0), count)
+
+	for _, collectionID := range []string{collectionID1, collectionID2} {
+		err = CleanUpTestCollection(NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+}
+
 func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	collectionName := "test_collection_get_collections"
-	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	collectionID, err := CreateTestCollection(NewMetaDomain(), collectionName, 128, suite.databaseId)
 	suite.NoError(err)
 
 	testKey := "test"
@@ -89,7 +126,7 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	suite.Equal(collectionID, collections[0].Collection.ID)
 
 	// Test limit and offset
-	collectionID2, err := CreateTestCollection(suite.db, "test_collection_get_collections2", 128, suite.databaseId)
+	collectionID2, err := CreateTestCollection(NewMetaDomain(), "test_collection_get_collections2", 128, suite.databaseId)
 	suite.NoError(err)
 
 	allCollections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
@@ -114,15 +151,15 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	suite.Equal(len(collections), 0)
 
 	// clean up
-	err = CleanUpTestCollection(suite.db, collectionID)
+	err = CleanUpTestCollection(NewMetaDomain(), collectionID)
 	suite.NoError(err)
-	err = CleanUpTestCollection(suite.db, collectionID2)
+	err = CleanUpTestCollection(NewMetaDomain(), collectionID2)
 	suite.NoError(err)
 }
 
 func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion() {
 	collectionName := "test_collection_get_collections"
-	collectionID, _ := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	collectionID, _ := CreateTestCollection(NewMetaDomain(), collectionName, 128, suite.databaseId)
 	// verify default values
 	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
 	suite.NoError(err)
@@ -150,10 +187,150 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	suite.Error(err, "collection version invalid")
 
 	//clean up
-	err = CleanUpTestCollection(suite.db, collectionID)
+	err = CleanUpTestCollection(NewMetaDomain(), collectionID)
 	suite.NoError(err)
 }
 
+func (suite *CollectionDbTestSuite) TestCollectionDb_ListCollectionsKeyset() {
+	collectionIDs := make([]string, 0, 4)
+	for i := 0; i < 3; i++ {
+		collectionID, err := CreateTestCollection(NewMetaDomain(), fmt.Sprintf("test_collection_keyset_%d", i), 128, suite.databaseId)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	limit := int32(2)
+	query := &dbmodel.ListCollectionsQuery{
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+		Limit:        &limit,
+	}
+	page, err := suite.collectionDb.ListCollections(query)
+	suite.NoError(err)
+	suite.Len(page, 2)
+	suite.Equal(collectionIDs[0], page[0].Collection.ID)
+	suite.Equal(collectionIDs[1], page[1].Collection.ID)
+
+	// Deleting a listed collection and creating a new one must neither skip
+	// nor repeat collections on the next page.
+	err = CleanUpTestCollection(NewMetaDomain(), collectionIDs[0])
+	suite.NoError(err)
+	collectionID, err := CreateTestCollection(NewMetaDomain(), "test_collection_keyset_3", 128, suite.databaseId)
+	suite.NoError(err)
+	collectionIDs = append(collectionIDs, collectionID)
+
+	last := page[len(page)-1].Collection
+	query.After = &dbmodel.CollectionCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	page, err = suite.collectionDb.ListCollections(query)
+	suite.NoError(err)
+	suite.Len(page, 2)
+	suite.Equal(collectionIDs[2], page[0].Collection.ID)
+	suite.Equal(collectionIDs[3], page[1].Collection.ID)
+
+	for _, collectionID := range collectionIDs[1:] {
+		err = CleanUpTestCollection(NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_ListCollectionsWhere() {
+	collectionMetadataDb := &collectionMetadataDb{db: suite.db}
+	str := func(v string) *string { return &v }
+	i64 := func(v int64) *int64 { return &v }
+	f64 := func(v float64) *float64 { return &v }
+	metadata := []map[string]*dbmodel.CollectionMetadata{
+		{"owner": {StrValue: str("alice")}, "env": {StrValue: str("prod")}, "schema_version": {IntValue: i64(1)}},
+		{"owner": {StrValue: str("bob")}, "env": {StrValue: str("dev")}, "schema_version": {FloatValue: f64(2.5)}},
+		{"owner": {StrValue: str("alice")}, "schema_version": {IntValue: i64(3)}},
+	}
+	collectionIDs := make([]string, 0, len(metadata))
+	for i, m := range metadata {
+		collectionID, err := CreateTestCollection(NewMetaDomain(), fmt.Sprintf("test_collection_where_%d", i), 128, suite.databaseId)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+		rows := make([]*dbmodel.CollectionMetadata, 0, len(m))
+		for key, row := range m {
+			row.CollectionID = collectionID
+			row.Key = str(key)
+			rows = append(rows, row)
+		}
+		suite.NoError(collectionMetadataDb.Insert(rows))
+	}
+
+	leaf := func(operator model.CollectionMetadataFilterOperator, key string, values ...model.CollectionMetadataValueType) *model.CollectionMetadataFilter {
+		return &model.CollectionMetadataFilter{Operator: operator, Key: key, Values: values}
+	}
+	alice := &model.CollectionMetadataValueStringType{Value: "alice"}
+	tests := []struct {
+		name     string
+		where    *model.CollectionMetadataFilter
+		expected []string
+	}{
+		{"eq", leaf(model.CollectionMetadataFilterEq, "owner", alice), []string{collectionIDs[0], collectionIDs[2]}},
+		{"ne keeps missing keys", leaf(model.CollectionMetadataFilterNe, "env", &model.CollectionMetadataValueStringType{Value: "prod"}), []string{collectionIDs[1], collectionIDs[2]}},
+		{"gte compares ints and floats", leaf(model.CollectionMetadataFilterGte, "schema_version", &model.CollectionMetadataValueInt64Type{Value: 2}), []string{collectionIDs[1], collectionIDs[2]}},
+		{"in", leaf(model.CollectionMetadataFilterIn, "owner", &model.CollectionMetadataValueStringType{Value: "bob"}, &model.CollectionMetadataValueStringType{Value: "carol"}), []string{collectionIDs[1]}},
+		{"nin", leaf(model.CollectionMetadataFilterNin, "env", &model.CollectionMetadataValueStringType{Value: "dev"}), []string{collectionIDs[0], collectionIDs[2]}},
+		{"in mixes ints and floats", leaf(model.CollectionMetadataFilterIn, "schema_version", &model.CollectionMetadataValueInt64Type{Value: 1}, &model.CollectionMetadataValueFloat64Type{Value: 2.5}), []string{collectionIDs[0], collectionIDs[1]}},
+		{"and", &model.CollectionMetadataFilter{Operator: model.CollectionMetadataFilterAnd, Children: []*model.CollectionMetadataFilter{
+			leaf(model.CollectionMetadataFilterEq, "owner", alice),
+			leaf(model.CollectionMetadataFilterLt, "schema_version", &model.CollectionMetadataValueFloat64Type{Value: 2}),
+		}}, []string{collectionIDs[0]}},
+		{"or", &model.CollectionMetadataFilter{Operator: model.CollectionMetadataFilterOr, Children: []*model.CollectionMetadataFilter{
+			leaf(model.CollectionMetadataFilterEq, "env", &model.CollectionMetadataValueStringType{Value: "dev"}),
+			leaf(model.CollectionMetadataFilterGt, "schema_version", &model.CollectionMetadataValueFloat64Type{Value: 2.9}),
+		}}, []string{collectionIDs[1], collectionIDs[2]}},
+	}
+	for _, test := range tests {
+		collections, err := suite.collectionDb.ListCollections(&dbmodel.ListCollectionsQuery{
+			TenantID:     suite.tenantName,
+			DatabaseName: suite.databaseName,
+			Where:        test.where,
+		})
+		suite.NoError(err, test.name)
+		ids := make([]string, 0, len(collections))
+		for _, collection := range collections {
+			ids = append(ids, collection.Collection.ID)
+		}
+		suite.ElementsMatch(test.expected, ids, test.name)
+	}
+
+	// The values of a list are compared to the same columns, other types can
+	// not be mixed in.
+	_, err := suite.collectionDb.ListCollections(&dbmodel.ListCollectionsQuery{
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+		Where:        leaf(model.CollectionMetadataFilterIn, "owner", alice, &model.CollectionMetadataValueInt64Type{Value: 1}),
+	})
+	suite.ErrorIs(err, common.ErrInvalidMetadataFilter)
+
+	// The metadata of every listed collection is loaded with a single query.
+	collections, err := suite.collectionDb.ListCollections(&dbmodel.ListCollectionsQuery{
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Len(collections, len(collectionIDs))
+	for i, collection := range collections {
+		suite.Equal(collectionIDs[i], collection.Collection.ID)
+		keys := make([]string, 0, len(collection.CollectionMetadata))
+		for _, m := range collection.CollectionMetadata {
+			suite.Equal(collectionIDs[i], m.CollectionID)
+			keys = append(keys, *m.Key)
+		}
+		expectedKeys := make([]string, 0, len(metadata[i]))
+		for key := range metadata[i] {
+			expectedKeys = append(expectedKeys, key)
+		}
+		suite.ElementsMatch(expectedKeys, keys)
+	}
+
+	for _, collectionID := range collectionIDs {
+		err := CleanUpTestCollection(NewMetaDomain(), collectionID)
+		suite.NoError(err)
+	}
+}
+
 func (suite *CollectionDbTestSuite) TestCollectionDb_SoftDelete() {
 	// Ensure there are no collections from before.
 	collections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
@@ -171,9 +348,9 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_SoftDelete() {
 	// Create 2 collections.
 	collectionName1 := "test_collection_soft_delete1"
 	collectionName2 := "test_collection_soft_delete2"
-	collectionID1, err := CreateTestCollection(suite.db, collectionName1, 128, suite.databaseId)
+	collectionID1, err := CreateTestCollection(NewMetaDomain(), collectionName1, 128, suite.databaseId)
 	suite.NoError(err)
-	collectionID2, err := CreateTestCollection(suite.db, collectionName2, 128, suite.databaseId)
+	collectionID2, err := CreateTestCollection(NewMetaDomain(), collectionName2, 128, suite.databaseId)
 	suite.NoError(err)
 
 	// Soft delete collection 1 by Updating the is_deleted column
@@ -200,9 +377,9 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_SoftDelete() {
 	suite.Equal(collectionName1, *collections[0].Collection.Name)
 
 	// Clean up
-	err = CleanUpTestCollection(suite.db, collectionID1)
+	err = CleanUpTestCollection(NewMetaDomain(), collectionID1)
 	suite.NoError(err)
-	err = CleanUpTestCollection(suite.db, collectionID2)
+	err = CleanUpTestCollection(NewMetaDomain(), collectionID2)
 	suite.NoError(err)
 }
 
@@ -210,3 +387,77 @@ func TestCollectionDbTestSuiteSuite(t *testing.T) {
 	testSuite := new(CollectionDbTestSuite)
 	suite.Run(t, testSuite)
 }
+
+// countQueries counts the queries run on db, for benchmarks to report the
+// number of round trips of an operation.
+func countQueries(b *testing.B, db *gorm.DB) *atomic.Int64 {
+	queries := &atomic.Int64{}
+	count := func(*gorm.DB) { queries.Add(1) }
+	if err := db.Callback().Query().After("gorm:query").Register("benchmark:count_queries", count); err != nil {
+		b.Fatal(err)
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("benchmark:count_rows", count); err != nil {
+		b.Fatal(err)
+	}
+	return queries
+}
+
+func BenchmarkCollectionDb_GetCollections(b *testing.B) {
+	db := dbcore.ConfigDatabaseForTesting()
+	tenantName := "benchmark_collection_tenant"
+	databaseName := "benchmark_collection_database"
+	databaseID, err := CreateTestTenantAndDatabase(NewMetaDomain(), tenantName, databaseName)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		if err := CleanUpTestTenant(NewMetaDomain(), tenantName); err != nil {
+			b.Fatal(err)
+		}
+	}()
+	collectionDb := &collectionDb{db: db}
+	collectionMetadataDb := &collectionMetadataDb{db: db}
+	queries := countQueries(b, db)
+
+	configurationJsonStr := "{}"
+	created := 0
+	for _, count := range []int{10, 100, 1000} {
+		for ; created < count; created++ {
+			collectionID := types.NewUniqueID().String()
+			name := fmt.Sprintf("benchmark_collection_%d", created)
+			err := collectionDb.Insert(&dbmodel.Collection{
+				ID:                   collectionID,
+				Name:                 &name,
+				ConfigurationJsonStr: &configurationJsonStr,
+				DatabaseID:           databaseID,
+				CreatedAt:            time.Now(),
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+			metadata := make([]*dbmodel.CollectionMetadata, 0, 3)
+			for _, key := range []string{"owner", "env", "team"} {
+				key, value := key, fmt.Sprintf("value_%d", created)
+				metadata = append(metadata, &dbmodel.CollectionMetadata{CollectionID: collectionID, Key: &key, StrValue: &value})
+			}
+			if err := collectionMetadataDb.Insert(metadata); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.Run(fmt.Sprintf("collections=%d", count), func(b *testing.B) {
+			queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(collections) != count {
+					b.Fatalf("expected %d collections, got %d", count, len(collections))
+				}
+			}
+			b.ReportMetric(float64(queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
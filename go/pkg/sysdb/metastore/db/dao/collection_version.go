@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionVersionDb struct {
+	db *gorm.DB
+}
+
+func (s *collectionVersionDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionVersion{}).Error
+}
+
+func (s *collectionVersionDb) Insert(in *dbmodel.CollectionVersion) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert collection version failed", zap.Error(err))
+	}
+	return err
+}
+
+func (s *collectionVersionDb) ListByCollectionID(collectionID string, limit int32) ([]*dbmodel.CollectionVersion, error) {
+	var versions []*dbmodel.CollectionVersion
+	err := s.db.Where("collection_id = ?", collectionID).
+		Order("version DESC").
+		Limit(int(limit)).
+		Find(&versions).Error
+	if err != nil {
+		log.Error("list collection versions failed", zap.Error(err))
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (s *collectionVersionDb) GetByCollectionIDAndVersion(collectionID string, version int32) (*dbmodel.CollectionVersion, error) {
+	var collectionVersion dbmodel.CollectionVersion
+	err := s.db.Where("collection_id = ? AND version = ?", collectionID, version).First(&collectionVersion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get collection version failed", zap.Error(err))
+		return nil, err
+	}
+	return &collectionVersion, nil
+}
+
+func (s *collectionVersionDb) DeleteByCollectionID(collectionID string) (int, error) {
+	var versions []dbmodel.CollectionVersion
+	err := s.db.Clauses(clause.Returning{}).Where("collection_id = ?", collectionID).Delete(&versions).Error
+	return len(versions), err
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"strings"
 
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
@@ -36,3 +37,43 @@ func (*MetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
 func (*MetaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetadataDb {
 	return &segmentMetadataDb{dbcore.GetDB(ctx)}
 }
+
+func (*MetaDomain) FileReferenceDb(ctx context.Context) dbmodel.IFileReferenceDb {
+	return &fileReferenceDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) CollectionVersionDb(ctx context.Context) dbmodel.ICollectionVersionDb {
+	return &collectionVersionDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) IdempotencyKeyDb(ctx context.Context) dbmodel.IIdempotencyKeyDb {
+	return &idempotencyKeyDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
+	return &notificationDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) TenantMetadataDb(ctx context.Context) dbmodel.ITenantMetadataDb {
+	return &tenantMetadataDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) DatabaseMetadataDb(ctx context.Context) dbmodel.IDatabaseMetadataDb {
+	return &databaseMetadataDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) TenantQuotaDb(ctx context.Context) dbmodel.ITenantQuotaDb {
+	return &tenantQuotaDb{dbcore.GetDB(ctx)}
+}
+
+func (*MetaDomain) AuditEventDb(ctx context.Context) dbmodel.IAuditEventDb {
+	return &auditEventDb{dbcore.GetDB(ctx)}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// prefixPattern returns a LIKE pattern, to be used with ESCAPE '\', that
+// matches the strings starting with prefix.
+func prefixPattern(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
@@ -41,9 +41,10 @@ func (s *databaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmodel.Database, error) {
 	var databases []*dbmodel.Database
 	query := s.db.Table("databases").
-		Select("databases.id, databases.name, databases.tenant_id").
+		Select("databases.id, databases.name, databases.tenant_id, databases.ts, databases.created_at, databases.updated_at").
 		Where("databases.name = ?", databaseName).
-		Where("databases.tenant_id = ?", tenantID)
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false)
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabases", zap.Error(err))
@@ -52,6 +53,75 @@ func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmo
 	return databases, nil
 }
 
+func (s *databaseDb) GetDatabaseByID(databaseID string) ([]*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	query := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id, databases.ts, databases.is_deleted, databases.created_at, databases.updated_at").
+		Where("databases.id = ?", databaseID)
+
+	if err := query.Find(&databases).Error; err != nil {
+		log.Error("GetDatabaseByID", zap.Error(err))
+		return nil, err
+	}
+	return databases, nil
+}
+
+func (s *databaseDb) ListDatabases(tenantID string, namePrefix string, startAfter string, limit int32) ([]*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	query := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id, databases.ts, databases.created_at, databases.updated_at").
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false).
+		Order("databases.name ASC").
+		Limit(int(limit))
+	if namePrefix != "" {
+		query = query.Where(`databases.name LIKE ? ESCAPE '\'`, prefixPattern(namePrefix))
+	}
+	if startAfter != "" {
+		query = query.Where("databases.name > ?", startAfter)
+	}
+
+	if err := query.Find(&databases).Error; err != nil {
+		log.Error("ListDatabases", zap.Error(err))
+		return nil, err
+	}
+	return databases, nil
+}
+
+func (s *databaseDb) CountDatabases(tenantID string) (uint64, error) {
+	var count int64
+	err := s.db.Table("databases").
+		Where("tenant_id = ? AND is_deleted = ?", tenantID, false).
+		Count(&count).Error
+	if err != nil {
+		log.Error("count databases failed", zap.Error(err))
+		return 0, err
+	}
+	return uint64(count), nil
+}
+
+func (s *databaseDb) GetSoftDeletedDatabases(limit int32) ([]*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	query := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id, databases.ts, databases.is_deleted, databases.updated_at").
+		Where("databases.is_deleted = ?", true).
+		Order("databases.updated_at ASC").
+		Limit(int(limit))
+
+	if err := query.Find(&databases).Error; err != nil {
+		log.Error("GetSoftDeletedDatabases", zap.Error(err))
+		return nil, err
+	}
+	return databases, nil
+}
+
+// NOTE: This is the only method to do a hard delete of a single database.
+func (s *databaseDb) DeleteByID(databaseID string) (int, error) {
+	var databases []dbmodel.Database
+	err := s.db.Clauses(clause.Returning{}).Where("id = ?", databaseID).Delete(&databases).Error
+	return len(databases), err
+}
+
 func (s *databaseDb) Insert(database *dbmodel.Database) error {
 	err := s.db.Create(database).Error
 	if err != nil {
@@ -68,11 +138,56 @@ func (s *databaseDb) Insert(database *dbmodel.Database) error {
 				return err
 			}
 		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Error("database already exists")
+			return common.ErrDatabaseUniqueConstraintViolation
+		}
 		return err
 	}
 	return err
 }
 
+func generateDatabaseUpdatesWithoutID(in *dbmodel.Database) map[string]interface{} {
+	ret := map[string]interface{}{}
+	if in.Name != "" {
+		ret["name"] = in.Name
+	}
+	if in.IsDeleted {
+		ret["is_deleted"] = true
+	}
+	if in.Ts != 0 {
+		ret["ts"] = in.Ts
+	}
+	return ret
+}
+
+func (s *databaseDb) Update(in *dbmodel.Database) error {
+	log.Info("update database", zap.Any("database", in))
+	updates := generateDatabaseUpdatesWithoutID(in)
+	err := s.db.Model(&dbmodel.Database{}).Where("id = ?", in.ID).Updates(updates).Error
+	if err != nil {
+		log.Error("update database failed", zap.Error(err))
+		var pgErr *pgconn.PgError
+		ok := errors.As(err, &pgErr)
+		if ok {
+			log.Error("Postgres Error")
+			switch pgErr.Code {
+			case "23505":
+				log.Error("database already exists")
+				return common.ErrDatabaseUniqueConstraintViolation
+			default:
+				return err
+			}
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Error("database already exists")
+			return common.ErrDatabaseUniqueConstraintViolation
+		}
+		return err
+	}
+	return nil
+}
+
 func (s *databaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
 	var databases []*dbmodel.Database
 	query := s.db.Table("databases").
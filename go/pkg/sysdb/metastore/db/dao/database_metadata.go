@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type databaseMetadataDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IDatabaseMetadataDb = &databaseMetadataDb{}
+
+func (s *databaseMetadataDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.DatabaseMetadata{}).Error
+}
+
+func (s *databaseMetadataDb) GetByDatabaseID(databaseID string) ([]*dbmodel.DatabaseMetadata, error) {
+	var metadata []*dbmodel.DatabaseMetadata
+	err := s.db.Where("database_id = ?", databaseID).Order("key").Find(&metadata).Error
+	return metadata, err
+}
+
+func (s *databaseMetadataDb) DeleteByDatabaseID(databaseID string) (int, error) {
+	var metadata []dbmodel.DatabaseMetadata
+	err := s.db.Clauses(clause.Returning{}).Where("database_id = ?", databaseID).Delete(&metadata).Error
+	return len(metadata), err
+}
+
+func (s *databaseMetadataDb) DeleteByDatabaseIDAndKeys(databaseID string, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	var metadata []dbmodel.DatabaseMetadata
+	err := s.db.Clauses(clause.Returning{}).
+		Where("database_id = ?", databaseID).
+		Where("key IN ?", keys).
+		Delete(&metadata).Error
+	return len(metadata), err
+}
+
+func (s *databaseMetadataDb) Insert(in []*dbmodel.DatabaseMetadata) error {
+	return s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "database_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "bool_value", "updated_at"}),
+	}).Create(in).Error
+}
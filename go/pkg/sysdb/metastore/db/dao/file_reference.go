@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type fileReferenceDb struct {
+	db *gorm.DB
+}
+
+func (s *fileReferenceDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.FileReference{}).Error
+}
+
+// Insert ignores references that are already registered.
+func (s *fileReferenceDb) Insert(in []*dbmodel.FileReference) error {
+	if len(in) == 0 {
+		return nil
+	}
+	err := s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(in, 1000).Error
+	if err != nil {
+		log.Error("insert file references failed", zap.Error(err))
+	}
+	return err
+}
+
+// GetSharedFilePaths returns the file paths, among filePaths, that are
+// referenced by a collection other than collectionID.
+func (s *fileReferenceDb) GetSharedFilePaths(collectionID string, filePaths []string) ([]string, error) {
+	shared := []string{}
+	if len(filePaths) == 0 {
+		return shared, nil
+	}
+	err := s.db.Model(&dbmodel.FileReference{}).
+		Distinct("file_path").
+		Where("file_path IN ?", filePaths).
+		Where("collection_id <> ?", collectionID).
+		Pluck("file_path", &shared).Error
+	if err != nil {
+		log.Error("get shared file paths failed", zap.Error(err))
+		return nil, err
+	}
+	return shared, nil
+}
+
+func (s *fileReferenceDb) DeleteByCollectionID(collectionID string) (int, error) {
+	var references []dbmodel.FileReference
+	err := s.db.Clauses(clause.Returning{}).Where("collection_id = ?", collectionID).Delete(&references).Error
+	return len(references), err
+}
+
+// DeleteUnusedByCollectionID drops the references of a collection to the files
+// it no longer uses.
+func (s *fileReferenceDb) DeleteUnusedByCollectionID(collectionID string, usedFilePaths []string) (int, error) {
+	if len(usedFilePaths) == 0 {
+		return s.DeleteByCollectionID(collectionID)
+	}
+	var references []dbmodel.FileReference
+	err := s.db.Clauses(clause.Returning{}).
+		Where("collection_id = ?", collectionID).
+		Where("file_path NOT IN ?", usedFilePaths).
+		Delete(&references).Error
+	return len(references), err
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type idempotencyKeyDb struct {
+	db *gorm.DB
+}
+
+func (s *idempotencyKeyDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.IdempotencyKey{}).Error
+}
+
+func (s *idempotencyKeyDb) Get(method string, key string) (*dbmodel.IdempotencyKey, error) {
+	var idempotencyKey dbmodel.IdempotencyKey
+	err := s.db.Where("method = ? AND key = ?", method, key).First(&idempotencyKey).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get idempotency key failed", zap.Error(err))
+		return nil, err
+	}
+	return &idempotencyKey, nil
+}
+
+func (s *idempotencyKeyDb) Insert(in *dbmodel.IdempotencyKey) (bool, error) {
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(in)
+	if result.Error != nil {
+		log.Error("insert idempotency key failed", zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *idempotencyKeyDb) Complete(method string, key string, response []byte) error {
+	err := s.db.Model(&dbmodel.IdempotencyKey{}).
+		Where("method = ? AND key = ? AND pending = ?", method, key, true).
+		Updates(map[string]interface{}{"response": response, "pending": false}).Error
+	if err != nil {
+		log.Error("complete idempotency key failed", zap.Error(err))
+	}
+	return err
+}
+
+func (s *idempotencyKeyDb) TakeOver(in *dbmodel.IdempotencyKey, reservedBefore time.Time) (bool, error) {
+	result := s.db.Model(&dbmodel.IdempotencyKey{}).
+		Where("method = ? AND key = ? AND pending = ? AND reserved_at < ?", in.Method, in.Key, true, reservedBefore).
+		Updates(map[string]interface{}{"request_hash": in.RequestHash, "reserved_at": in.ReservedAt})
+	if result.Error != nil {
+		log.Error("take over idempotency key failed", zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *idempotencyKeyDb) DeletePending(method string, key string) error {
+	err := s.db.Where("method = ? AND key = ? AND pending = ?", method, key, true).Delete(&dbmodel.IdempotencyKey{}).Error
+	if err != nil {
+		log.Error("delete pending idempotency key failed", zap.Error(err))
+	}
+	return err
+}
+
+func (s *idempotencyKeyDb) DeleteCreatedBefore(before time.Time) (int, error) {
+	result := s.db.Where("created_at < ?", before).Delete(&dbmodel.IdempotencyKey{})
+	return int(result.RowsAffected), result.Error
+}
@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type notificationDb struct {
+	db *gorm.DB
+}
+
+func (s *notificationDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.Notification{}).Error
+}
+
+// Insert must run inside the transaction of the change it records.
+func (s *notificationDb) Insert(in *dbmodel.Notification) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert notification failed", zap.Error(err))
+	}
+	return err
+}
+
+func (s *notificationDb) ListNotifications(query *dbmodel.ListNotificationsQuery) ([]*dbmodel.Notification, error) {
+	var notifications []*dbmodel.Notification
+	tx := s.db.Where("id > ? AND id <= ?", query.AfterRevision, query.UpToRevision)
+	if query.TenantID != "" {
+		tx = tx.Where("tenant_id = ?", query.TenantID)
+	}
+	if query.DatabaseID != "" {
+		tx = tx.Where("database_id = ?", query.DatabaseID)
+	}
+	err := tx.Order("id").Limit(int(query.Limit)).Find(&notifications).Error
+	if err != nil {
+		log.Error("list notifications failed", zap.Error(err))
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (s *notificationDb) ListRevisions(afterRevision int64, limit int32) ([]int64, error) {
+	var revisions []int64
+	err := s.db.Model(&dbmodel.Notification{}).Where("id > ?", afterRevision).Order("id").Limit(int(limit)).Pluck("id", &revisions).Error
+	if err != nil {
+		log.Error("list notification revisions failed", zap.Error(err))
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (s *notificationDb) GetRevisionRange() (int64, int64, error) {
+	var revisionRange struct {
+		Oldest int64
+		Latest int64
+	}
+	err := s.db.Model(&dbmodel.Notification{}).Select("COALESCE(MIN(id), 0) AS oldest, COALESCE(MAX(id), 0) AS latest").Scan(&revisionRange).Error
+	if err != nil {
+		log.Error("get notification revision range failed", zap.Error(err))
+		return 0, 0, err
+	}
+	return revisionRange.Oldest, revisionRange.Latest, nil
+}
+
+func (s *notificationDb) DeleteCreatedBefore(before time.Time) (int, error) {
+	result := s.db.Where("created_at < ? AND id < (SELECT MAX(id) FROM notifications)", before).Delete(&dbmodel.Notification{})
+	return int(result.RowsAffected), result.Error
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type segmentDb struct {
@@ -45,6 +46,10 @@ func (s *segmentDb) Insert(in *dbmodel.Segment) error {
 				return err
 			}
 		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Error("segment already exists")
+			return common.ErrSegmentUniqueConstraintViolation
+		}
 		return err
 	}
 	return nil
@@ -58,7 +63,7 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 	var segments []*dbmodel.SegmentAndMetadata
 
 	query := s.db.Table("segments").
-		Select("segments.id, segments.collection_id, segments.type, segments.scope, segments.file_paths, segment_metadata.key, segment_metadata.str_value, segment_metadata.int_value, segment_metadata.float_value, segment_metadata.bool_value").
+		Select("segments.id, segments.collection_id, segments.type, segments.scope, segments.file_paths, segments.row_version, segment_metadata.key, segment_metadata.str_value, segment_metadata.int_value, segment_metadata.float_value, segment_metadata.bool_value").
 		Joins("LEFT JOIN segment_metadata ON segments.id = segment_metadata.segment_id").
 		Where("segments.collection_id = ?", collectionID.String()).
 		Order("segments.id")
@@ -106,6 +111,7 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 			segmentType   string
 			scope         string
 			filePathsJson string
+			rowVersion    sql.NullInt64
 			key           sql.NullString
 			strValue      sql.NullString
 			intValue      sql.NullInt64
@@ -113,7 +119,7 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 			boolValue     sql.NullBool
 		)
 
-		err := rows.Scan(&segmentID, &collectionID, &segmentType, &scope, &filePathsJson, &key, &strValue, &intValue, &floatValue, &boolValue)
+		err := rows.Scan(&segmentID, &collectionID, &segmentType, &scope, &filePathsJson, &rowVersion, &key, &strValue, &intValue, &floatValue, &boolValue)
 		if err != nil {
 			log.Error("scan segment failed", zap.Error(err))
 		}
@@ -128,10 +134,11 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 			}
 			currentSegment = &dbmodel.SegmentAndMetadata{
 				Segment: &dbmodel.Segment{
-					ID:        segmentID,
-					Type:      segmentType,
-					Scope:     scope,
-					FilePaths: filePaths,
+					ID:         segmentID,
+					Type:       segmentType,
+					Scope:      scope,
+					FilePaths:  filePaths,
+					RowVersion: rowVersion.Int64,
 				},
 				SegmentMetadata: metadata,
 			}
@@ -201,13 +208,14 @@ func generateSegmentUpdatesWithoutID(in *dbmodel.UpdateSegment) map[string]inter
 	//	}
 	//}
 	//log.Info("generate segment updates without id", zap.Any("updates", ret))
+	ret["row_version"] = gorm.Expr("row_version + 1")
 	return ret
 }
 
 func (s *segmentDb) Update(in *dbmodel.UpdateSegment) error {
 	updates := generateSegmentUpdatesWithoutID(in)
 	return s.db.Model(&dbmodel.Segment{}).
-		Where("collection_id = ?", &in.Collection).
+		Where("collection_id = ?", in.Collection).
 		Where("id = ?", in.ID).Updates(updates).Error
 }
 
@@ -220,8 +228,8 @@ func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegm
 			return err
 		}
 		err = s.db.Model(&dbmodel.Segment{}).
-			Where("id = ?", flushSegmentCompaction.ID).
-			Update("file_paths", filePaths).Error
+			Where("id = ?", flushSegmentCompaction.ID.String()).
+			Updates(map[string]interface{}{"file_paths": filePaths, "row_version": gorm.Expr("row_version + 1")}).Error
 		if err != nil {
 			log.Error("register file path failed", zap.Error(err))
 			return err
@@ -230,6 +238,23 @@ func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegm
 	return nil
 }
 
+// CheckRowVersion locks the segment row for the rest of the transaction and
+// checks that its row version is the expected one.
+func (s *segmentDb) CheckRowVersion(segmentID string, expectedRowVersion int64) error {
+	var segment dbmodel.Segment
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "row_version").Where("id = ?", segmentID).First(&segment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.ErrSegmentUpdateNonExistingSegment
+		}
+		return err
+	}
+	if segment.RowVersion != expectedRowVersion {
+		return &common.RowVersionMismatchError{CurrentRowVersion: segment.RowVersion}
+	}
+	return nil
+}
+
 func (s *segmentDb) GetSegmentsByCollectionID(collectionID string) ([]*dbmodel.Segment, error) {
 	var segments []*dbmodel.Segment
 	err := s.db.Where("collection_id = ?", collectionID).Find(&segments).Error
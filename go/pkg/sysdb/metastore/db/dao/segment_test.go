@@ -84,7 +84,7 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	// create a collection for testing
 	databaseId := types.NewUniqueID().String()
 	collectionName := "test_segment_register_file_paths"
-	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, databaseId)
+	collectionID, err := CreateTestCollection(NewMetaDomain(), collectionName, 128, databaseId)
 	suite.NoError(err)
 
 	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID))
@@ -126,10 +126,59 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	}
 
 	// clean up
-	err = CleanUpTestCollection(suite.db, collectionID)
+	err = CleanUpTestCollection(NewMetaDomain(), collectionID)
 	suite.NoError(err)
 }
 
+func BenchmarkSegmentDb_GetSegments(b *testing.B) {
+	db := dbcore.ConfigDatabaseForTesting()
+	segmentDb := &segmentDb{db: db}
+	segmentMetadataDb := &segmentMetadataDb{db: db}
+	queries := countQueries(b, db)
+
+	collectionID := types.NewUniqueID()
+	scopes := []string{"VECTOR", "METADATA", "RECORD"}
+	for _, scope := range scopes {
+		collectionIDStr := collectionID.String()
+		segmentID := types.NewUniqueID().String()
+		err := segmentDb.Insert(&dbmodel.Segment{
+			ID:           segmentID,
+			CollectionID: &collectionIDStr,
+			Type:         SegmentType,
+			Scope:        scope,
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		metadata := make([]*dbmodel.SegmentMetadata, 0, 10)
+		for i := 0; i < 10; i++ {
+			key, value := "key_"+strconv.Itoa(i), int64(i)
+			metadata = append(metadata, &dbmodel.SegmentMetadata{SegmentID: segmentID, Key: &key, IntValue: &value})
+		}
+		if err := segmentMetadataDb.Insert(metadata); err != nil {
+			b.Fatal(err)
+		}
+	}
+	defer func() {
+		if err := CleanUpTestCollection(NewMetaDomain(), collectionID.String()); err != nil {
+			b.Fatal(err)
+		}
+	}()
+
+	queries.Store(0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		segments, err := segmentDb.GetSegments(types.NilUniqueID(), nil, nil, collectionID)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(segments) != len(scopes) {
+			b.Fatalf("expected %d segments, got %d", len(scopes), len(segments))
+		}
+	}
+	b.ReportMetric(float64(queries.Load())/float64(b.N), "queries/op")
+}
+
 func TestSegmentDbTestSuiteSuite(t *testing.T) {
 	testSuite := new(SegmentDbTestSuite)
 	suite.Run(t, testSuite)
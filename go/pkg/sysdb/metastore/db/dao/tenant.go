@@ -5,6 +5,7 @@ import (
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
@@ -40,7 +41,43 @@ func (s *tenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 func (s *tenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	var tenants []*dbmodel.Tenant
 
-	if err := s.db.Where("id = ?", tenantID).Find(&tenants).Error; err != nil {
+	if err := s.db.Where("id = ?", tenantID).Where("is_deleted = ?", false).Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (s *tenantDb) GetTenantsForUpdate(tenantID string) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+
+	if err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", tenantID).Where("is_deleted = ?", false).Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (s *tenantDb) GetSoftDeletedTenants(limit int32) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+
+	if err := s.db.Where("is_deleted = ?", true).Order("updated_at ASC").Limit(int(limit)).Find(&tenants).Error; err != nil {
+		log.Error("GetSoftDeletedTenants error", zap.Error(err))
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (s *tenantDb) ListTenants(namePrefix string, startAfter string, limit int32) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+	query := s.db.Where("is_deleted = ?", false).Order("id ASC").Limit(int(limit))
+	if namePrefix != "" {
+		query = query.Where(`id LIKE ? ESCAPE '\'`, prefixPattern(namePrefix))
+	}
+	if startAfter != "" {
+		query = query.Where("id > ?", startAfter)
+	}
+
+	if err := query.Find(&tenants).Error; err != nil {
+		log.Error("ListTenants error", zap.Error(err))
 		return nil, err
 	}
 	return tenants, nil
@@ -62,6 +99,10 @@ func (s *tenantDb) Insert(tenant *dbmodel.Tenant) error {
 				return err
 			}
 		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Error("tenant already exists")
+			return common.ErrTenantUniqueConstraintViolation
+		}
 		return err
 	}
 	return nil
@@ -85,6 +126,40 @@ func (s *tenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactio
 	return nil
 }
 
+func (s *tenantDb) SoftDelete(tenantID string, ts types.Timestamp) error {
+	log.Info("SoftDelete tenant", zap.String("tenantID", tenantID))
+	result := s.db.Model(&dbmodel.Tenant{}).
+		Where("id = ?", tenantID).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{"is_deleted": true, "ts": ts})
+
+	if result.Error != nil {
+		log.Error("SoftDelete tenant error", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrTenantNotFound
+	}
+	return nil
+}
+
+func (s *tenantDb) UpdateTenantSuspended(tenantID string, isSuspended bool) error {
+	log.Info("UpdateTenantSuspended", zap.String("tenantID", tenantID), zap.Bool("isSuspended", isSuspended))
+	result := s.db.Model(&dbmodel.Tenant{}).
+		Where("id = ?", tenantID).
+		Where("is_deleted = ?", false).
+		Update("is_suspended", isSuspended)
+
+	if result.Error != nil {
+		log.Error("UpdateTenantSuspended error", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrTenantNotFound
+	}
+	return nil
+}
+
 func (s *tenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
 	log.Info("GetTenantsLastCompactionTime", zap.Any("tenantIDs", tenantIDs))
 	var tenants []*dbmodel.Tenant
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tenantMetadataDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ITenantMetadataDb = &tenantMetadataDb{}
+
+func (s *tenantMetadataDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.TenantMetadata{}).Error
+}
+
+func (s *tenantMetadataDb) GetByTenantID(tenantID string) ([]*dbmodel.TenantMetadata, error) {
+	var metadata []*dbmodel.TenantMetadata
+	err := s.db.Where("tenant_id = ?", tenantID).Order("key").Find(&metadata).Error
+	return metadata, err
+}
+
+func (s *tenantMetadataDb) DeleteByTenantID(tenantID string) (int, error) {
+	var metadata []dbmodel.TenantMetadata
+	err := s.db.Clauses(clause.Returning{}).Where("tenant_id = ?", tenantID).Delete(&metadata).Error
+	return len(metadata), err
+}
+
+func (s *tenantMetadataDb) DeleteByTenantIDAndKeys(tenantID string, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	var metadata []dbmodel.TenantMetadata
+	err := s.db.Clauses(clause.Returning{}).
+		Where("tenant_id = ?", tenantID).
+		Where("key IN ?", keys).
+		Delete(&metadata).Error
+	return len(metadata), err
+}
+
+func (s *tenantMetadataDb) Insert(in []*dbmodel.TenantMetadata) error {
+	return s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "bool_value", "updated_at"}),
+	}).Create(in).Error
+}
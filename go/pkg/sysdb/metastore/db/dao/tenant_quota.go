@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tenantQuotaDb struct {
+	db *gorm.DB
+}
+
+func (s *tenantQuotaDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.TenantQuota{}).Error
+}
+
+func (s *tenantQuotaDb) Get(tenantID string) (*dbmodel.TenantQuota, error) {
+	var tenantQuota dbmodel.TenantQuota
+	err := s.db.Where("tenant_id = ?", tenantID).First(&tenantQuota).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get tenant quota failed", zap.Error(err))
+		return nil, err
+	}
+	return &tenantQuota, nil
+}
+
+func (s *tenantQuotaDb) Upsert(in *dbmodel.TenantQuota) error {
+	err := s.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"max_databases":                    in.MaxDatabases,
+			"max_collections_per_database":     in.MaxCollectionsPerDatabase,
+			"max_metadata_keys_per_collection": in.MaxMetadataKeysPerCollection,
+			"max_metadata_value_size":          in.MaxMetadataValueSize,
+			"max_collection_dimension":         in.MaxCollectionDimension,
+			"updated_at":                       gorm.Expr("CURRENT_TIMESTAMP"),
+		}),
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert tenant quota failed", zap.Error(err))
+	}
+	return err
+}
+
+func (s *tenantQuotaDb) DeleteByTenantID(tenantID string) error {
+	return s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.TenantQuota{}).Error
+}
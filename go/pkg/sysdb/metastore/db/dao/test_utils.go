@@ -1,13 +1,13 @@
 package dao
 
 import (
+	"context"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
-	"gorm.io/gorm"
 )
 
 const SegmentType = "urn:chroma:segment/vector/hnsw-distributed"
@@ -16,16 +16,14 @@ func GetSegmentScopes() []string {
 	return []string{"VECTOR", "METADATA"}
 }
 
-func CreateTestTenantAndDatabase(db *gorm.DB, tenant string, database string) (string, error) {
-	log.Info("create test tenant and database", zap.String("tenant", tenant), zap.String("database", database))
-	tenantDb := &tenantDb{
-		db: db,
-	}
-	databaseDb := &databaseDb{
-		db: db,
-	}
+// The helpers below write through an IMetaDomain rather than a *gorm.DB, so
+// that the test suites can set up and clean up their fixtures the same way
+// against the SQL catalog and the in-memory one.
 
-	err := tenantDb.Insert(&dbmodel.Tenant{
+func CreateTestTenantAndDatabase(md dbmodel.IMetaDomain, tenant string, database string) (string, error) {
+	log.Info("create test tenant and database", zap.String("tenant", tenant), zap.String("database", database))
+	ctx := context.Background()
+	err := md.TenantDb(ctx).Insert(&dbmodel.Tenant{
 		ID:                 tenant,
 		LastCompactionTime: time.Now().Unix(),
 	})
@@ -34,7 +32,7 @@ func CreateTestTenantAndDatabase(db *gorm.DB, tenant string, database string) (s
 	}
 
 	databaseId := types.NewUniqueID().String()
-	err = databaseDb.Insert(&dbmodel.Database{
+	err = md.DatabaseDb(ctx).Insert(&dbmodel.Database{
 		ID:       databaseId,
 		Name:     database,
 		TenantID: tenant,
@@ -46,78 +44,71 @@ func CreateTestTenantAndDatabase(db *gorm.DB, tenant string, database string) (s
 	return databaseId, nil
 }
 
-func CleanUpTestDatabase(db *gorm.DB, tenantName string, databaseName string) error {
+func CleanUpTestDatabase(md dbmodel.IMetaDomain, tenantName string, databaseName string) error {
 	log.Info("clean up test database", zap.String("tenantName", tenantName), zap.String("databaseName", databaseName))
+	ctx := context.Background()
 	// clean up collections
-	collectionDb := &collectionDb{
-		db: db,
-	}
-	collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil)
+	collections, err := md.CollectionDb(ctx).GetCollections(nil, nil, tenantName, databaseName, nil, nil)
 	log.Info("clean up test database", zap.Int("collections", len(collections)))
 	if err != nil {
 		return err
 	}
 	for _, collection := range collections {
-		err = CleanUpTestCollection(db, collection.Collection.ID)
+		err = CleanUpTestCollection(md, collection.Collection.ID)
 		if err != nil {
 			return err
 		}
 	}
 
 	// clean up database
-	databaseDb := &databaseDb{
-		db: db,
-	}
-
-	_, err = databaseDb.DeleteByTenantIdAndName(tenantName, databaseName)
+	databases, err := md.DatabaseDb(ctx).GetDatabasesByTenantID(tenantName)
 	if err != nil {
 		return err
 	}
+	for _, database := range databases {
+		if database.Name != databaseName {
+			continue
+		}
+		_, err = md.DatabaseDb(ctx).DeleteByID(database.ID)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func CleanUpTestTenant(db *gorm.DB, tenantName string) error {
+func CleanUpTestTenant(md dbmodel.IMetaDomain, tenantName string) error {
 	log.Info("clean up test tenant", zap.String("tenantName", tenantName))
-	tenantDb := &tenantDb{
-		db: db,
-	}
-	databaseDb := &databaseDb{
-		db: db,
-	}
+	ctx := context.Background()
 
 	// clean up databases
-	databases, err := databaseDb.GetDatabasesByTenantID(tenantName)
+	databases, err := md.DatabaseDb(ctx).GetDatabasesByTenantID(tenantName)
 	if err != nil {
 		return err
 	}
 	for _, database := range databases {
-		err = CleanUpTestDatabase(db, tenantName, database.Name)
+		err = CleanUpTestDatabase(md, tenantName, database.Name)
 		if err != nil {
 			return err
 		}
 	}
 
 	// clean up tenant
-	_, err = tenantDb.DeleteByID(tenantName)
+	_, err = md.TenantDb(ctx).DeleteByID(tenantName)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func CreateTestCollection(db *gorm.DB, collectionName string, dimension int32, databaseID string) (string, error) {
+func CreateTestCollection(md dbmodel.IMetaDomain, collectionName string, dimension int32, databaseID string) (string, error) {
 	log.Info("create test collection", zap.String("collectionName", collectionName), zap.Int32("dimension", dimension), zap.String("databaseID", databaseID))
-	collectionDb := &collectionDb{
-		db: db,
-	}
-	segmentDb := &segmentDb{
-		db: db,
-	}
+	ctx := context.Background()
 	collectionId := types.NewUniqueID().String()
 
 	defaultConfigurationJsonStr := "{\"a\": \"param\", \"b\": \"param2\", \"3\": true}"
-	err := collectionDb.Insert(&dbmodel.Collection{
+	err := md.CollectionDb(ctx).Insert(&dbmodel.Collection{
 		ID:                   collectionId,
 		Name:                 &collectionName,
 		ConfigurationJsonStr: &defaultConfigurationJsonStr,
@@ -131,7 +122,7 @@ func CreateTestCollection(db *gorm.DB, collectionName string, dimension int32, d
 
 	for _, scope := range GetSegmentScopes() {
 		segmentId := types.NewUniqueID().String()
-		err = segmentDb.Insert(&dbmodel.Segment{
+		err = md.SegmentDb(ctx).Insert(&dbmodel.Segment{
 			CollectionID: &collectionId,
 			ID:           segmentId,
 			Type:         SegmentType,
@@ -146,39 +137,28 @@ func CreateTestCollection(db *gorm.DB, collectionName string, dimension int32, d
 	return collectionId, nil
 }
 
-func CleanUpTestCollection(db *gorm.DB, collectionId string) error {
+func CleanUpTestCollection(md dbmodel.IMetaDomain, collectionId string) error {
 	log.Info("clean up collection", zap.String("collectionId", collectionId))
-	collectionDb := &collectionDb{
-		db: db,
-	}
-	collectionMetadataDb := &collectionMetadataDb{
-		db: db,
-	}
-	segmentDb := &segmentDb{
-		db: db,
-	}
-	segmentMetadataDb := &segmentMetadataDb{
-		db: db,
-	}
+	ctx := context.Background()
 
-	_, err := collectionMetadataDb.DeleteByCollectionID(collectionId)
+	_, err := md.CollectionMetadataDb(ctx).DeleteByCollectionID(collectionId)
 	if err != nil {
 		return err
 	}
-	_, err = collectionDb.DeleteCollectionByID(collectionId)
+	_, err = md.CollectionDb(ctx).DeleteCollectionByID(collectionId)
 	if err != nil {
 		return err
 	}
-	segments, err := segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionId))
+	segments, err := md.SegmentDb(ctx).GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionId))
 	if err != nil {
 		return err
 	}
 	for _, segment := range segments {
-		err = segmentDb.DeleteSegmentByID(segment.Segment.ID)
+		err = md.SegmentDb(ctx).DeleteSegmentByID(segment.Segment.ID)
 		if err != nil {
 			return err
 		}
-		err = segmentMetadataDb.DeleteBySegmentID(segment.Segment.ID)
+		err = md.SegmentMetadataDb(ctx).DeleteBySegmentID(segment.Segment.ID)
 		if err != nil {
 			return err
 		}
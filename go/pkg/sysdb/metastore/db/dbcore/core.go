@@ -3,7 +3,9 @@ package dbcore
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
@@ -15,9 +17,11 @@ import (
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/migrations"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/plugin/opentelemetry/tracing"
@@ -25,6 +29,9 @@ import (
 
 var (
 	globalDB *gorm.DB
+	// replicaDB serves the reads that tolerate replication lag, nil when no
+	// read replica is configured.
+	replicaDB *gorm.DB
 )
 
 type DBConfig struct {
@@ -40,6 +47,27 @@ type DBConfig struct {
 
 func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 	log.Info("ConnectPostgres", zap.String("host", cfg.Address), zap.String("database", cfg.DBName), zap.Int("port", cfg.Port))
+	db, err := openPostgres(cfg)
+	if err != nil {
+		return nil, err
+	}
+	globalDB = db
+	return db, nil
+}
+
+// ConnectPostgresReplica opens the pool of a read replica of the catalog, which
+// serves the reads marked with CtxWithReplicaRead.
+func ConnectPostgresReplica(cfg DBConfig) (*gorm.DB, error) {
+	log.Info("ConnectPostgresReplica", zap.String("host", cfg.Address), zap.String("database", cfg.DBName), zap.Int("port", cfg.Port))
+	db, err := openPostgres(cfg)
+	if err != nil {
+		return nil, err
+	}
+	replicaDB = db
+	return db, nil
+}
+
+func openPostgres(cfg DBConfig) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=%s",
 		cfg.Address, cfg.Username, cfg.Password, cfg.DBName, cfg.Port, cfg.SslMode)
 
@@ -73,8 +101,6 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 	idb.SetMaxIdleConns(cfg.MaxIdleConns)
 	idb.SetMaxOpenConns(cfg.MaxOpenConns)
 
-	globalDB = db
-
 	log.Info("Postgres connected success",
 		zap.String("host", cfg.Address),
 		zap.String("database", cfg.DBName),
@@ -83,13 +109,98 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
+// ConnectSqlite opens an embedded SQLite catalog, creates the schema and seeds
+// the default tenant and database. An empty path keeps the catalog in memory.
+func ConnectSqlite(path string) (*gorm.DB, error) {
+	log.Info("ConnectSqlite", zap.String("path", path))
+	dsn := path
+	if dsn == "" {
+		dsn = "file::memory:"
+	}
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger:          logger.Default.LogMode(logger.Info),
+		CreateBatchSize: 100,
+		TranslateError:  true,
+	})
+	if err != nil {
+		log.Error("fail to open sqlite db", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+
+	idb, err := db.DB()
+	if err != nil {
+		log.Error("fail to create db instance", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	// SQLite has a single writer and every connection to an in-memory database
+	// sees its own empty database, so all queries share one connection.
+	idb.SetMaxOpenConns(1)
+
+	if err := CreateTables(db); err != nil {
+		log.Error("fail to create sqlite tables", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	if _, err := CreateDefaultTenantAndDatabase(db); err != nil {
+		log.Error("fail to create sqlite default tenant and database", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+
+	globalDB = db
+
+	log.Info("Sqlite connected success", zap.String("path", path))
+	return db, nil
+}
+
+// CreateTables creates any missing catalog table from the gorm models.
+func CreateTables(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&dbmodel.Tenant{},
+		&dbmodel.Database{},
+		&dbmodel.CollectionMetadata{},
+		&dbmodel.Collection{},
+		&dbmodel.SegmentMetadata{},
+		&dbmodel.Segment{},
+		&dbmodel.FileReference{},
+		&dbmodel.CollectionVersion{},
+		&dbmodel.IdempotencyKey{},
+		&dbmodel.Notification{},
+		&dbmodel.AuditEvent{},
+		&dbmodel.TenantQuota{},
+		&dbmodel.TenantMetadata{},
+		&dbmodel.DatabaseMetadata{},
+	)
+}
+
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
 	globalDB = db
 }
 
+// SetReplicaDB Only for test, nil removes the read replica
+func SetReplicaDB(db *gorm.DB) {
+	replicaDB = db
+}
+
 type ctxTransactionKey struct{}
 
+type ctxReplicaReadKey struct{}
+
+// CtxWithReplicaRead marks whether the reads of ctx tolerate replication lag,
+// and so can be served by the read replica when one is configured. Reads that
+// are not marked, and every transaction, use the primary.
+func CtxWithReplicaRead(ctx context.Context, replicaRead bool) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ctxReplicaReadKey{}, replicaRead)
+}
+
+func isReplicaRead(ctx context.Context) bool {
+	replicaRead, _ := ctx.Value(ctxReplicaReadKey{}).(bool)
+	return replicaRead
+}
+
 func CtxWithTransaction(ctx context.Context, tx *gorm.DB) context.Context {
 	if ctx == nil {
 		ctx = context.Background()
@@ -125,15 +236,21 @@ func GetDB(ctx context.Context) *gorm.DB {
 		return tx
 	}
 
+	if replicaDB != nil && isReplicaRead(ctx) {
+		return replicaDB.WithContext(ctx)
+	}
 	return globalDB.WithContext(ctx)
 }
 
-func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
+func CreateDefaultTenantAndDatabase(db *gorm.DB) (string, error) {
 	defaultTenant := &dbmodel.Tenant{
 		ID:                 common.DefaultTenant,
 		LastCompactionTime: time.Now().Unix(),
 	}
-	db.Model(&dbmodel.Tenant{}).Where("id = ?", common.DefaultTenant).Save(defaultTenant)
+	err := db.Model(&dbmodel.Tenant{}).Where("id = ?", common.DefaultTenant).Save(defaultTenant).Error
+	if err != nil {
+		return "", err
+	}
 
 	var database []dbmodel.Database
 	databaseId := types.NewUniqueID().String()
@@ -142,54 +259,70 @@ func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
 		Where("tenant_id = ?", common.DefaultTenant).
 		Find(&database)
 	if result.Error != nil {
-		return ""
+		return "", result.Error
 	}
 
 	if result.RowsAffected == 0 {
-		db.Create(&dbmodel.Database{
+		err = db.Create(&dbmodel.Database{
 			ID:       databaseId,
 			Name:     common.DefaultDatabase,
 			TenantID: common.DefaultTenant,
-		})
-		return databaseId
-	}
-
-	err := result.Row().Scan(&database)
-	if err != nil {
-		return ""
+		}).Error
+		if err != nil {
+			return "", err
+		}
+		return databaseId, nil
 	}
-	return database[0].ID
+	return database[0].ID, nil
 }
 
-func CreateTestTables(db *gorm.DB) {
+// CreateTestTables creates the catalog schema of a test database and seeds the
+// default tenant and database. Each provider has a single schema path: SQLite
+// is created from the gorm models as ConnectSqlite does, Postgres with the
+// Atlas migrations so that the tests catch a drift between the models and the
+// migrations.
+func CreateTestTables(db *gorm.DB) error {
 	log.Info("CreateTestTables")
-	tableExist := db.Migrator().HasTable(&dbmodel.Tenant{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Tenant{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Database{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Database{})
+	if db.Dialector.Name() == "sqlite" {
+		if err := CreateTables(db); err != nil {
+			log.Error("fail to create tables", zap.Error(err))
+			return err
+		}
+	} else if err := applyMigrations(db); err != nil {
+		log.Error("fail to apply migrations", zap.Error(err))
+		return err
 	}
-	tableExist = db.Migrator().HasTable(&dbmodel.CollectionMetadata{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.CollectionMetadata{})
+
+	// create default tenant and database
+	if _, err := CreateDefaultTenantAndDatabase(db); err != nil {
+		log.Error("fail to create default tenant and database", zap.Error(err))
+		return err
 	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Collection{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Collection{})
+	return nil
+}
+
+// applyMigrations runs the Atlas migrations of the catalog in order. The
+// migrations are not idempotent, so a database that already has the catalog
+// schema is left as is.
+func applyMigrations(db *gorm.DB) error {
+	if db.Migrator().HasTable(&dbmodel.Tenant{}) {
+		return nil
 	}
-	tableExist = db.Migrator().HasTable(&dbmodel.SegmentMetadata{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.SegmentMetadata{})
+	entries, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		return err
 	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Segment{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Segment{})
+	sort.Strings(entries)
+	for _, entry := range entries {
+		migration, err := fs.ReadFile(migrations.FS, entry)
+		if err != nil {
+			return err
+		}
+		if err := db.Exec(string(migration)).Error; err != nil {
+			return fmt.Errorf("migration %s: %w", entry, err)
+		}
 	}
-
-	// create default tenant and database
-	CreateDefaultTenantAndDatabase(db)
+	return nil
 }
 
 func GetDBConfigForTesting() DBConfig {
@@ -234,6 +367,8 @@ func ConfigDatabaseForTesting() *gorm.DB {
 		panic("failed to connect database")
 	}
 	SetGlobalDB(db)
-	CreateTestTables(db)
+	if err := CreateTestTables(db); err != nil {
+		panic("failed to create test tables")
+	}
 	return db
 }
@@ -0,0 +1,42 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// AuditEvent records who changed the catalog and how. It is written in the
+// transaction of the change, Before and After hold the JSON of the object
+// changed and are nil when it did not exist.
+type AuditEvent struct {
+	ID           int64     `gorm:"id;primaryKey;autoIncrement"`
+	Actor        string    `gorm:"actor;not null"`
+	Operation    string    `gorm:"operation;not null"`
+	TenantID     string    `gorm:"tenant_id;not null;default:'';index:idx_audit_events_tenant_id_created_at,priority:1"`
+	DatabaseID   string    `gorm:"database_id;not null;default:''"`
+	CollectionID string    `gorm:"collection_id;not null;default:''"`
+	Before       *string   `gorm:"before;type:text"`
+	After        *string   `gorm:"after;type:text"`
+	CreatedAt    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp;index:idx_audit_events_tenant_id_created_at,priority:2;index:idx_audit_events_created_at"`
+}
+
+func (v AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+// ListAuditEventsQuery selects the audit events created in [Start, End), in
+// creation order. Zero times and an empty tenant id do not filter. Events with
+// an id up to AfterID are skipped, so that a listing can be resumed.
+type ListAuditEventsQuery struct {
+	TenantID string
+	Start    time.Time
+	End      time.Time
+	AfterID  int64
+	Limit    int32
+}
+
+//go:generate mockery --name=IAuditEventDb
+type IAuditEventDb interface {
+	Insert(in *AuditEvent) error
+	ListAuditEvents(query *ListAuditEventsQuery) ([]*AuditEvent, error)
+	DeleteAll() error
+}
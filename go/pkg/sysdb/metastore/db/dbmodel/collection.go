@@ -3,27 +3,50 @@ package dbmodel
 import (
 	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
 type Collection struct {
-	ID                   string          `gorm:"id;primaryKey"`
+	ID                   string          `gorm:"id;primaryKey;index:idx_database_id_created_at_id,priority:3"`
 	Name                 *string         `gorm:"name;not null;index:idx_name,unique;"`
 	ConfigurationJsonStr *string         `gorm:"configuration_json_str"`
 	Dimension            *int32          `gorm:"dimension"`
-	DatabaseID           string          `gorm:"database_id;not null;index:idx_name,unique;"`
+	DatabaseID           string          `gorm:"database_id;not null;index:idx_name,unique;index:idx_database_id_created_at_id,priority:1"`
 	Ts                   types.Timestamp `gorm:"ts;type:bigint;default:0"`
 	IsDeleted            bool            `gorm:"is_deleted;type:bool;default:false"`
-	CreatedAt            time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt            time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp;index:idx_database_id_created_at_id,priority:2"`
 	UpdatedAt            time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 	LogPosition          int64           `gorm:"log_position;default:0"`
 	Version              int32           `gorm:"version;default:0"`
+	RowVersion           int64           `gorm:"row_version;not null;default:0"`
 }
 
 func (v Collection) TableName() string {
 	return "collections"
 }
 
+// CollectionCursor is the position of a collection in the (created_at, id)
+// order collections are listed in.
+type CollectionCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// ListCollectionsQuery selects live collections. Empty tenant or database
+// names do not filter. Rows come in (created_at, id) order, starting right
+// after the After cursor if set.
+type ListCollectionsQuery struct {
+	ID           *string
+	Name         *string
+	TenantID     string
+	DatabaseName string
+	Where        *model.CollectionMetadataFilter
+	After        *CollectionCursor
+	Limit        *int32
+	Offset       *int32
+}
+
 type CollectionAndMetadata struct {
 	Collection         *Collection
 	CollectionMetadata []*CollectionMetadata
@@ -34,12 +57,20 @@ type CollectionAndMetadata struct {
 //go:generate mockery --name=ICollectionDb
 type ICollectionDb interface {
 	GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*CollectionAndMetadata, error)
+	ListCollections(query *ListCollectionsQuery) ([]*CollectionAndMetadata, error)
+	ListSoftDeletedCollections(query *ListCollectionsQuery) ([]*CollectionAndMetadata, error)
 	DeleteCollectionByID(collectionID string) (int, error)
 	GetSoftDeletedCollections(collectionID *string, tenantID string, databaseName string, limit int32) ([]*CollectionAndMetadata, error)
+	GetCollectionsByDatabaseID(databaseID string) ([]*Collection, error)
+	GetExistingCollectionIDs(collectionIDs []string) ([]string, error)
+	CountCollections(tenantID string, databaseName *string) (uint64, error)
+	CheckRowVersion(collectionID string, expectedRowVersion int64) error
 	Insert(in *Collection) error
 	Update(in *Collection) error
+	Restore(collectionID string, name string) error
 	DeleteAll() error
 	UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error)
+	ResetLogPosition(collectionID string, logPosition int64) (int32, error)
 	CheckCollectionIsSoftDeleted(collectionName string, tenantID string, databaseName string) (bool, string, error)
 	GetCollectionEntry(collectionID *string, databaseName *string) (*Collection, error)
 }
@@ -25,6 +25,7 @@ func (v CollectionMetadata) TableName() string {
 //go:generate mockery --name=ICollectionMetadataDb
 type ICollectionMetadataDb interface {
 	DeleteByCollectionID(collectionID string) (int, error)
+	DeleteByCollectionIDAndKeys(collectionID string, keys []string) (int, error)
 	Insert(in []*CollectionMetadata) error
 	DeleteAll() error
 }
@@ -0,0 +1,31 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// CollectionVersion is a snapshot of a collection taken whenever its version
+// changes: the log position and the file paths of every segment, keyed by
+// segment id.
+type CollectionVersion struct {
+	CollectionID     string                         `gorm:"collection_id;primaryKey"`
+	Version          int32                          `gorm:"version;primaryKey"`
+	LogPosition      int64                          `gorm:"log_position;default:0"`
+	SegmentFilePaths map[string]map[string][]string `gorm:"segment_file_paths;serializer:json;default:'{}'"`
+	CreatedAt        time.Time                      `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v CollectionVersion) TableName() string {
+	return "collection_versions"
+}
+
+//go:generate mockery --name=ICollectionVersionDb
+type ICollectionVersionDb interface {
+	Insert(in *CollectionVersion) error
+	// ListByCollectionID returns the versions of a collection, newest first.
+	ListByCollectionID(collectionID string, limit int32) ([]*CollectionVersion, error)
+	// GetByCollectionIDAndVersion returns nil if the version is not recorded.
+	GetByCollectionIDAndVersion(collectionID string, version int32) (*CollectionVersion, error)
+	DeleteByCollectionID(collectionID string) (int, error)
+	DeleteAll() error
+}
@@ -14,6 +14,14 @@ type IMetaDomain interface {
 	CollectionMetadataDb(ctx context.Context) ICollectionMetadataDb
 	SegmentDb(ctx context.Context) ISegmentDb
 	SegmentMetadataDb(ctx context.Context) ISegmentMetadataDb
+	FileReferenceDb(ctx context.Context) IFileReferenceDb
+	CollectionVersionDb(ctx context.Context) ICollectionVersionDb
+	IdempotencyKeyDb(ctx context.Context) IIdempotencyKeyDb
+	NotificationDb(ctx context.Context) INotificationDb
+	TenantMetadataDb(ctx context.Context) ITenantMetadataDb
+	DatabaseMetadataDb(ctx context.Context) IDatabaseMetadataDb
+	TenantQuotaDb(ctx context.Context) ITenantQuotaDb
+	AuditEventDb(ctx context.Context) IAuditEventDb
 }
 
 //go:generate mockery --name=ITransaction
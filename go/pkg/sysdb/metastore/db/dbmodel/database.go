@@ -24,6 +24,15 @@ func (v Database) TableName() string {
 type IDatabaseDb interface {
 	GetAllDatabases() ([]*Database, error)
 	GetDatabases(tenantID string, databaseName string) ([]*Database, error)
+	GetDatabasesByTenantID(tenantID string) ([]*Database, error)
+	// GetDatabaseByID looks a database up by id, soft deleted or not.
+	GetDatabaseByID(databaseID string) ([]*Database, error)
+	ListDatabases(tenantID string, namePrefix string, startAfter string, limit int32) ([]*Database, error)
+	GetSoftDeletedDatabases(limit int32) ([]*Database, error)
+	// CountDatabases counts the databases of a tenant that are not soft deleted.
+	CountDatabases(tenantID string) (uint64, error)
 	Insert(in *Database) error
+	Update(in *Database) error
+	DeleteByID(databaseID string) (int, error)
 	DeleteAll() error
 }
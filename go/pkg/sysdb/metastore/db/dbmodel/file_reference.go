@@ -0,0 +1,28 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// FileReference records that a collection uses a file. A forked collection
+// shares the files of its source, so a file may only be garbage collected once
+// no other collection references it.
+type FileReference struct {
+	FilePath     string    `gorm:"file_path;primaryKey"`
+	CollectionID string    `gorm:"collection_id;primaryKey;index"`
+	CreatedAt    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v FileReference) TableName() string {
+	return "file_references"
+}
+
+//go:generate mockery --name=IFileReferenceDb
+type IFileReferenceDb interface {
+	// Insert skips the references that already exist.
+	Insert(in []*FileReference) error
+	GetSharedFilePaths(collectionID string, filePaths []string) ([]string, error)
+	DeleteByCollectionID(collectionID string) (int, error)
+	DeleteUnusedByCollectionID(collectionID string, usedFilePaths []string) (int, error)
+	DeleteAll() error
+}
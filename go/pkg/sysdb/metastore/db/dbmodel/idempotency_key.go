@@ -0,0 +1,43 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// IdempotencyKey remembers the response to a mutating request sent with an
+// idempotency key, so that a retry of the same request gets the same response.
+// RequestHash guards against the key being reused for a different request.
+// The key is pending while the request it was reserved for is running, a
+// reservation older than its lease is left over by a request that did not
+// finish and can be taken over.
+type IdempotencyKey struct {
+	Method      string    `gorm:"method;primaryKey"`
+	Key         string    `gorm:"key;primaryKey"`
+	RequestHash string    `gorm:"request_hash;not null"`
+	Response    []byte    `gorm:"response"`
+	Pending     bool      `gorm:"pending;not null;default:false"`
+	CreatedAt   time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp;index"`
+	ReservedAt  time.Time `gorm:"reserved_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+//go:generate mockery --name=IIdempotencyKeyDb
+type IIdempotencyKeyDb interface {
+	// Get returns nil if the key is not recorded for the method.
+	Get(method string, key string) (*IdempotencyKey, error)
+	// Insert records the key unless it is already recorded for the method, and
+	// reports whether it did.
+	Insert(in *IdempotencyKey) (bool, error)
+	// Complete records the response of a pending key.
+	Complete(method string, key string, response []byte) error
+	// TakeOver reserves the key again for a request if it is still pending
+	// and was reserved before reservedBefore, and reports whether it did.
+	TakeOver(in *IdempotencyKey, reservedBefore time.Time) (bool, error)
+	// DeletePending deletes the key if it is still pending.
+	DeletePending(method string, key string) error
+	DeleteCreatedBefore(before time.Time) (int, error)
+	DeleteAll() error
+}
@@ -47,6 +47,52 @@ func (_m *ICollectionDb) CheckCollectionIsSoftDeleted(collectionName string, ten
 	return r0, r1, r2
 }
 
+// CheckRowVersion provides a mock function with given fields: collectionID, expectedRowVersion
+func (_m *ICollectionDb) CheckRowVersion(collectionID string, expectedRowVersion int64) error {
+	ret := _m.Called(collectionID, expectedRowVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckRowVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(collectionID, expectedRowVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountCollections provides a mock function with given fields: tenantID, databaseName
+func (_m *ICollectionDb) CountCollections(tenantID string, databaseName *string) (uint64, error) {
+	ret := _m.Called(tenantID, databaseName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollections")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *string) (uint64, error)); ok {
+		return rf(tenantID, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(string, *string) uint64); ok {
+		r0 = rf(tenantID, databaseName)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *string) error); ok {
+		r1 = rf(tenantID, databaseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAll provides a mock function with given fields:
 func (_m *ICollectionDb) DeleteAll() error {
 	ret := _m.Called()
@@ -153,6 +199,66 @@ func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *st
 	return r0, r1
 }
 
+// GetCollectionsByDatabaseID provides a mock function with given fields: databaseID
+func (_m *ICollectionDb) GetCollectionsByDatabaseID(databaseID string) ([]*dbmodel.Collection, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsByDatabaseID")
+	}
+
+	var r0 []*dbmodel.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Collection, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Collection); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExistingCollectionIDs provides a mock function with given fields: collectionIDs
+func (_m *ICollectionDb) GetExistingCollectionIDs(collectionIDs []string) ([]string, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExistingCollectionIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]string, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []string); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSoftDeletedCollections provides a mock function with given fields: collectionID, tenantID, databaseName, limit
 func (_m *ICollectionDb) GetSoftDeletedCollections(collectionID *string, tenantID string, databaseName string, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
 	ret := _m.Called(collectionID, tenantID, databaseName, limit)
@@ -201,6 +307,112 @@ func (_m *ICollectionDb) Insert(in *dbmodel.Collection) error {
 	return r0
 }
 
+// ListCollections provides a mock function with given fields: query
+func (_m *ICollectionDb) ListCollections(query *dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollections")
+	}
+
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.ListCollectionsQuery) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.ListCollectionsQuery) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSoftDeletedCollections provides a mock function with given fields: query
+func (_m *ICollectionDb) ListSoftDeletedCollections(query *dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSoftDeletedCollections")
+	}
+
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.ListCollectionsQuery) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.ListCollectionsQuery) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetLogPosition provides a mock function with given fields: collectionID, logPosition
+func (_m *ICollectionDb) ResetLogPosition(collectionID string, logPosition int64) (int32, error) {
+	ret := _m.Called(collectionID, logPosition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetLogPosition")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (int32, error)); ok {
+		return rf(collectionID, logPosition)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) int32); ok {
+		r0 = rf(collectionID, logPosition)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(collectionID, logPosition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: collectionID, name
+func (_m *ICollectionDb) Restore(collectionID string, name string) error {
+	ret := _m.Called(collectionID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(collectionID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: in
 func (_m *ICollectionDb) Update(in *dbmodel.Collection) error {
 	ret := _m.Called(in)
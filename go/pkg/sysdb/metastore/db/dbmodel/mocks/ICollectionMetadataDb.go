@@ -58,6 +58,34 @@ func (_m *ICollectionMetadataDb) DeleteByCollectionID(collectionID string) (int,
 	return r0, r1
 }
 
+// DeleteByCollectionIDAndKeys provides a mock function with given fields: collectionID, keys
+func (_m *ICollectionMetadataDb) DeleteByCollectionIDAndKeys(collectionID string, keys []string) (int, error) {
+	ret := _m.Called(collectionID, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionIDAndKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) (int, error)); ok {
+		return rf(collectionID, keys)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) int); ok {
+		r0 = rf(collectionID, keys)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(collectionID, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *ICollectionMetadataDb) Insert(in []*dbmodel.CollectionMetadata) error {
 	ret := _m.Called(in)
@@ -12,6 +12,34 @@ type IDatabaseDb struct {
 	mock.Mock
 }
 
+// CountDatabases provides a mock function with given fields: tenantID
+func (_m *IDatabaseDb) CountDatabases(tenantID string) (uint64, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDatabases")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (uint64, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAll provides a mock function with given fields:
 func (_m *IDatabaseDb) DeleteAll() error {
 	ret := _m.Called()
@@ -30,6 +58,34 @@ func (_m *IDatabaseDb) DeleteAll() error {
 	return r0
 }
 
+// DeleteByID provides a mock function with given fields: databaseID
+func (_m *IDatabaseDb) DeleteByID(databaseID string) (int, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(databaseID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllDatabases provides a mock function with given fields:
 func (_m *IDatabaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	ret := _m.Called()
@@ -60,6 +116,36 @@ func (_m *IDatabaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	return r0, r1
 }
 
+// GetDatabaseByID provides a mock function with given fields: databaseID
+func (_m *IDatabaseDb) GetDatabaseByID(databaseID string) ([]*dbmodel.Database, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseByID")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Database, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Database); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDatabases provides a mock function with given fields: tenantID, databaseName
 func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmodel.Database, error) {
 	ret := _m.Called(tenantID, databaseName)
@@ -90,6 +176,66 @@ func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*db
 	return r0, r1
 }
 
+// GetDatabasesByTenantID provides a mock function with given fields: tenantID
+func (_m *IDatabaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabasesByTenantID")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Database, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Database); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSoftDeletedDatabases provides a mock function with given fields: limit
+func (_m *IDatabaseDb) GetSoftDeletedDatabases(limit int32) ([]*dbmodel.Database, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSoftDeletedDatabases")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32) ([]*dbmodel.Database, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int32) []*dbmodel.Database); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	ret := _m.Called(in)
@@ -108,6 +254,54 @@ func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	return r0
 }
 
+// ListDatabases provides a mock function with given fields: tenantID, namePrefix, startAfter, limit
+func (_m *IDatabaseDb) ListDatabases(tenantID string, namePrefix string, startAfter string, limit int32) ([]*dbmodel.Database, error) {
+	ret := _m.Called(tenantID, namePrefix, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabases")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int32) ([]*dbmodel.Database, error)); ok {
+		return rf(tenantID, namePrefix, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int32) []*dbmodel.Database); ok {
+		r0 = rf(tenantID, namePrefix, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int32) error); ok {
+		r1 = rf(tenantID, namePrefix, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: in
+func (_m *IDatabaseDb) Update(in *dbmodel.Database) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.Database) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIDatabaseDb creates a new instance of IDatabaseDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIDatabaseDb(t interface {
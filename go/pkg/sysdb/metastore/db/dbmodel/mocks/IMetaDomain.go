@@ -14,6 +14,24 @@ type IMetaDomain struct {
 	mock.Mock
 }
 
+// AuditEventDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) AuditEventDb(ctx context.Context) dbmodel.IAuditEventDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditEventDb")
+	}
+
+	var r0 dbmodel.IAuditEventDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IAuditEventDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.IAuditEventDb)
+	}
+
+	return r0
+}
+
 // CollectionDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	ret := _m.Called(ctx)
@@ -54,6 +72,24 @@ func (_m *IMetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollec
 	return r0
 }
 
+// CollectionVersionDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionVersionDb(ctx context.Context) dbmodel.ICollectionVersionDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionVersionDb")
+	}
+
+	var r0 dbmodel.ICollectionVersionDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionVersionDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.ICollectionVersionDb)
+	}
+
+	return r0
+}
+
 // DatabaseDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	ret := _m.Called(ctx)
@@ -74,6 +110,78 @@ func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	return r0
 }
 
+// DatabaseMetadataDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) DatabaseMetadataDb(ctx context.Context) dbmodel.IDatabaseMetadataDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DatabaseMetadataDb")
+	}
+
+	var r0 dbmodel.IDatabaseMetadataDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IDatabaseMetadataDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.IDatabaseMetadataDb)
+	}
+
+	return r0
+}
+
+// FileReferenceDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) FileReferenceDb(ctx context.Context) dbmodel.IFileReferenceDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileReferenceDb")
+	}
+
+	var r0 dbmodel.IFileReferenceDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IFileReferenceDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.IFileReferenceDb)
+	}
+
+	return r0
+}
+
+// IdempotencyKeyDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) IdempotencyKeyDb(ctx context.Context) dbmodel.IIdempotencyKeyDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdempotencyKeyDb")
+	}
+
+	var r0 dbmodel.IIdempotencyKeyDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IIdempotencyKeyDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.IIdempotencyKeyDb)
+	}
+
+	return r0
+}
+
+// NotificationDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationDb")
+	}
+
+	var r0 dbmodel.INotificationDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.INotificationDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.INotificationDb)
+	}
+
+	return r0
+}
+
 // SegmentDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
 	ret := _m.Called(ctx)
@@ -134,6 +242,42 @@ func (_m *IMetaDomain) TenantDb(ctx context.Context) dbmodel.ITenantDb {
 	return r0
 }
 
+// TenantMetadataDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) TenantMetadataDb(ctx context.Context) dbmodel.ITenantMetadataDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantMetadataDb")
+	}
+
+	var r0 dbmodel.ITenantMetadataDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ITenantMetadataDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.ITenantMetadataDb)
+	}
+
+	return r0
+}
+
+// TenantQuotaDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) TenantQuotaDb(ctx context.Context) dbmodel.ITenantQuotaDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantQuotaDb")
+	}
+
+	var r0 dbmodel.ITenantQuotaDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ITenantQuotaDb); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dbmodel.ITenantQuotaDb)
+	}
+
+	return r0
+}
+
 // NewIMetaDomain creates a new instance of IMetaDomain. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIMetaDomain(t interface {
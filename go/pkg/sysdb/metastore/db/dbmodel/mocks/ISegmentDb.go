@@ -16,6 +16,24 @@ type ISegmentDb struct {
 	mock.Mock
 }
 
+// CheckRowVersion provides a mock function with given fields: segmentID, expectedRowVersion
+func (_m *ISegmentDb) CheckRowVersion(segmentID string, expectedRowVersion int64) error {
+	ret := _m.Called(segmentID, expectedRowVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckRowVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(segmentID, expectedRowVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAll provides a mock function with given fields:
 func (_m *ISegmentDb) DeleteAll() error {
 	ret := _m.Called()
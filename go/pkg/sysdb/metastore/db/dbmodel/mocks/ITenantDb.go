@@ -5,6 +5,8 @@ package mocks
 import (
 	dbmodel "github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/chroma-core/chroma/go/pkg/types"
 )
 
 // ITenantDb is an autogenerated mock type for the ITenantDb type
@@ -30,6 +32,34 @@ func (_m *ITenantDb) DeleteAll() error {
 	return r0
 }
 
+// DeleteByID provides a mock function with given fields: tenantID
+func (_m *ITenantDb) DeleteByID(tenantID string) (int, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllTenants provides a mock function with given fields:
 func (_m *ITenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 	ret := _m.Called()
@@ -60,6 +90,36 @@ func (_m *ITenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 	return r0, r1
 }
 
+// GetSoftDeletedTenants provides a mock function with given fields: limit
+func (_m *ITenantDb) GetSoftDeletedTenants(limit int32) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSoftDeletedTenants")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32) ([]*dbmodel.Tenant, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int32) []*dbmodel.Tenant); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTenants provides a mock function with given fields: tenantID
 func (_m *ITenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	ret := _m.Called(tenantID)
@@ -90,6 +150,36 @@ func (_m *ITenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	return r0, r1
 }
 
+// GetTenantsForUpdate provides a mock function with given fields: tenantID
+func (_m *ITenantDb) GetTenantsForUpdate(tenantID string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantsForUpdate")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Tenant); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTenantsLastCompactionTime provides a mock function with given fields: tenantIDs
 func (_m *ITenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
 	ret := _m.Called(tenantIDs)
@@ -138,6 +228,54 @@ func (_m *ITenantDb) Insert(in *dbmodel.Tenant) error {
 	return r0
 }
 
+// ListTenants provides a mock function with given fields: namePrefix, startAfter, limit
+func (_m *ITenantDb) ListTenants(namePrefix string, startAfter string, limit int32) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(namePrefix, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenants")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32) ([]*dbmodel.Tenant, error)); ok {
+		return rf(namePrefix, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32) []*dbmodel.Tenant); ok {
+		r0 = rf(namePrefix, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32) error); ok {
+		r1 = rf(namePrefix, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDelete provides a mock function with given fields: tenantID, ts
+func (_m *ITenantDb) SoftDelete(tenantID string, ts types.Timestamp) error {
+	ret := _m.Called(tenantID, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.Timestamp) error); ok {
+		r0 = rf(tenantID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTenantLastCompactionTime provides a mock function with given fields: tenantID, lastCompactionTime
 func (_m *ITenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error {
 	ret := _m.Called(tenantID, lastCompactionTime)
@@ -156,6 +294,24 @@ func (_m *ITenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompact
 	return r0
 }
 
+// UpdateTenantSuspended provides a mock function with given fields: tenantID, isSuspended
+func (_m *ITenantDb) UpdateTenantSuspended(tenantID string, isSuspended bool) error {
+	ret := _m.Called(tenantID, isSuspended)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTenantSuspended")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(tenantID, isSuspended)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewITenantDb creates a new instance of ITenantDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewITenantDb(t interface {
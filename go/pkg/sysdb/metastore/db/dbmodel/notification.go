@@ -0,0 +1,52 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// Notification is an entry of the outbox of collection changes. It is written
+// in the transaction of the change it records, and its ID is the revision of
+// the change, allocated from a sequence. Revisions are allocated in the order
+// changes are written, so a change can commit before one with a lower
+// revision, and a rolled back change leaves a gap.
+type Notification struct {
+	ID           int64     `gorm:"id;primaryKey;autoIncrement"`
+	CollectionID string    `gorm:"collection_id;not null"`
+	TenantID     string    `gorm:"tenant_id;not null;index:idx_notifications_tenant_id_database_id_id,priority:1"`
+	DatabaseID   string    `gorm:"database_id;not null;index:idx_notifications_tenant_id_database_id_id,priority:2"`
+	DatabaseName string    `gorm:"database_name;not null"`
+	Type         string    `gorm:"type;not null"`
+	CreatedAt    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp;index:idx_notifications_created_at"`
+}
+
+func (v Notification) TableName() string {
+	return "notifications"
+}
+
+// ListNotificationsQuery selects the notifications with a revision in
+// (AfterRevision, UpToRevision], in revision order. Empty tenant or database
+// ids do not filter.
+type ListNotificationsQuery struct {
+	TenantID      string
+	DatabaseID    string
+	AfterRevision int64
+	UpToRevision  int64
+	Limit         int32
+}
+
+//go:generate mockery --name=INotificationDb
+type INotificationDb interface {
+	// Insert allocates the next revision to the notification and records it.
+	Insert(in *Notification) error
+	ListNotifications(query *ListNotificationsQuery) ([]*Notification, error)
+	// ListRevisions returns the revisions after afterRevision of all tenants,
+	// in order.
+	ListRevisions(afterRevision int64, limit int32) ([]int64, error)
+	// GetRevisionRange returns the oldest and the latest recorded revisions,
+	// both zero if none is recorded.
+	GetRevisionRange() (int64, int64, error)
+	// DeleteCreatedBefore deletes the notifications created before a time,
+	// except the latest one, so that the revision range stays known.
+	DeleteCreatedBefore(before time.Time) (int, error)
+	DeleteAll() error
+}
@@ -22,6 +22,7 @@ type Segment struct {
 	CreatedAt    time.Time           `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
 	UpdatedAt    time.Time           `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 	FilePaths    map[string][]string `gorm:"file_paths;serializer:json;default:'{}'"`
+	RowVersion   int64               `gorm:"row_version;not null;default:0"`
 }
 
 func (s Segment) TableName() string {
@@ -48,4 +49,5 @@ type ISegmentDb interface {
 	Update(*UpdateSegment) error
 	DeleteAll() error
 	RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction) error
+	CheckRowVersion(segmentID string, expectedRowVersion int64) error
 }
@@ -10,6 +10,7 @@ type Tenant struct {
 	ID                 string          `gorm:"id;primaryKey;unique"`
 	Ts                 types.Timestamp `gorm:"ts;type:bigint;default:0"`
 	IsDeleted          bool            `gorm:"is_deleted;type:bool;default:false"`
+	IsSuspended        bool            `gorm:"is_suspended;type:bool;default:false"`
 	CreatedAt          time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
 	UpdatedAt          time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 	LastCompactionTime int64           `gorm:"last_compaction_time;not null"`
@@ -23,7 +24,15 @@ func (v Tenant) TableName() string {
 type ITenantDb interface {
 	GetAllTenants() ([]*Tenant, error)
 	GetTenants(tenantID string) ([]*Tenant, error)
+	// GetTenantsForUpdate is GetTenants, locking the row of the tenant until
+	// the transaction ends.
+	GetTenantsForUpdate(tenantID string) ([]*Tenant, error)
+	GetSoftDeletedTenants(limit int32) ([]*Tenant, error)
+	ListTenants(namePrefix string, startAfter string, limit int32) ([]*Tenant, error)
 	Insert(in *Tenant) error
+	SoftDelete(tenantID string, ts types.Timestamp) error
+	UpdateTenantSuspended(tenantID string, isSuspended bool) error
+	DeleteByID(tenantID string) (int, error)
 	DeleteAll() error
 	UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error
 	GetTenantsLastCompactionTime(tenantIDs []string) ([]*Tenant, error)
@@ -0,0 +1,29 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type TenantMetadata struct {
+	TenantID   string    `gorm:"tenant_id;primaryKey"`
+	Key        *string   `gorm:"key;primaryKey"`
+	StrValue   *string   `gorm:"str_value"`
+	IntValue   *int64    `gorm:"int_value"`
+	FloatValue *float64  `gorm:"float_value"`
+	BoolValue  *bool     `gorm:"bool_value"`
+	CreatedAt  time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+	UpdatedAt  time.Time `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v TenantMetadata) TableName() string {
+	return "tenant_metadata"
+}
+
+//go:generate mockery --name=ITenantMetadataDb
+type ITenantMetadataDb interface {
+	GetByTenantID(tenantID string) ([]*TenantMetadata, error)
+	DeleteByTenantID(tenantID string) (int, error)
+	DeleteByTenantIDAndKeys(tenantID string, keys []string) (int, error)
+	Insert(in []*TenantMetadata) error
+	DeleteAll() error
+}
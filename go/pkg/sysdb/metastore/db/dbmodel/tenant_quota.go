@@ -0,0 +1,32 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// TenantQuota overrides the default limits of a tenant. A nil limit keeps the
+// default.
+type TenantQuota struct {
+	TenantID                     string    `gorm:"tenant_id;primaryKey"`
+	MaxDatabases                 *int32    `gorm:"max_databases"`
+	MaxCollectionsPerDatabase    *int32    `gorm:"max_collections_per_database"`
+	MaxMetadataKeysPerCollection *int32    `gorm:"max_metadata_keys_per_collection"`
+	MaxMetadataValueSize         *int32    `gorm:"max_metadata_value_size"`
+	MaxCollectionDimension       *int32    `gorm:"max_collection_dimension"`
+	CreatedAt                    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+	UpdatedAt                    time.Time `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v TenantQuota) TableName() string {
+	return "tenant_quotas"
+}
+
+//go:generate mockery --name=ITenantQuotaDb
+type ITenantQuotaDb interface {
+	// Get returns nil if the tenant has no quota of its own.
+	Get(tenantID string) (*TenantQuota, error)
+	// Upsert records the quota, replacing the previous quota of the tenant.
+	Upsert(in *TenantQuota) error
+	DeleteByTenantID(tenantID string) error
+	DeleteAll() error
+}
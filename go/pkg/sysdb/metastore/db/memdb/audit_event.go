@@ -0,0 +1,66 @@
+package memdb
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type auditEventDb struct {
+	*session
+}
+
+var _ dbmodel.IAuditEventDb = &auditEventDb{}
+
+func (s *auditEventDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.auditEvents = nil
+		return nil
+	})
+}
+
+func (s *auditEventDb) Insert(in *dbmodel.AuditEvent) error {
+	return s.write(func(t *tables) error {
+		t.auditEventID++
+		in.ID = t.auditEventID
+		if in.CreatedAt.IsZero() {
+			in.CreatedAt = time.Now()
+		}
+		row := cloneAuditEvent(in)
+		t.auditEvents = append(t.auditEvents, row)
+		return nil
+	})
+}
+
+func (s *auditEventDb) ListAuditEvents(query *dbmodel.ListAuditEventsQuery) ([]*dbmodel.AuditEvent, error) {
+	auditEvents := []*dbmodel.AuditEvent{}
+	err := s.read(func(t *tables) error {
+		for _, auditEvent := range t.auditEvents {
+			if auditEvent.ID <= query.AfterID {
+				continue
+			}
+			if query.TenantID != "" && auditEvent.TenantID != query.TenantID {
+				continue
+			}
+			if !query.Start.IsZero() && auditEvent.CreatedAt.Before(query.Start) {
+				continue
+			}
+			if !query.End.IsZero() && !auditEvent.CreatedAt.Before(query.End) {
+				continue
+			}
+			auditEvents = append(auditEvents, cloneAuditEvent(auditEvent))
+			if len(auditEvents) == int(query.Limit) {
+				break
+			}
+		}
+		return nil
+	})
+	return auditEvents, err
+}
+
+func cloneAuditEvent(auditEvent *dbmodel.AuditEvent) *dbmodel.AuditEvent {
+	row := *auditEvent
+	row.Before = cloneString(auditEvent.Before)
+	row.After = cloneString(auditEvent.After)
+	return &row
+}
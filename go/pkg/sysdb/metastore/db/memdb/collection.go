@@ -0,0 +1,371 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+type collectionDb struct {
+	*session
+}
+
+var _ dbmodel.ICollectionDb = &collectionDb{}
+
+func (s *collectionDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.collections = map[string]*dbmodel.Collection{}
+		return nil
+	})
+}
+
+func (s *collectionDb) GetCollectionEntry(collectionID *string, databaseName *string) (*dbmodel.Collection, error) {
+	var result *dbmodel.Collection
+	err := s.read(func(t *tables) error {
+		if collectionID == nil || databaseName == nil {
+			return nil
+		}
+		collection, ok := t.collections[*collectionID]
+		if !ok {
+			return nil
+		}
+		database, ok := t.databases[collection.DatabaseID]
+		if !ok || database.Name != *databaseName {
+			return nil
+		}
+		result = cloneCollection(collection)
+		return nil
+	})
+	return result, err
+}
+
+func (s *collectionDb) GetCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(&dbmodel.ListCollectionsQuery{
+		ID:           id,
+		Name:         name,
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		Limit:        limit,
+		Offset:       offset,
+	}, false)
+}
+
+func (s *collectionDb) ListCollections(query *dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(query, false)
+}
+
+func (s *collectionDb) ListSoftDeletedCollections(query *dbmodel.ListCollectionsQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(query, true)
+}
+
+func (s *collectionDb) getCollections(query *dbmodel.ListCollectionsQuery, isDeleted bool) ([]*dbmodel.CollectionAndMetadata, error) {
+	// The database translation rejects the filters it can not express.
+	if query.Where != nil {
+		if err := query.Where.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	id, name, after := query.ID, query.Name, query.After
+	collections := []*dbmodel.CollectionAndMetadata{}
+	err := s.read(func(t *tables) error {
+		for _, collection := range t.collections {
+			if collection.IsDeleted != isDeleted {
+				continue
+			}
+			if id != nil && collection.ID != *id {
+				continue
+			}
+			if name != nil && (collection.Name == nil || *collection.Name != *name) {
+				continue
+			}
+			if after != nil && !collectionAfter(collection, after) {
+				continue
+			}
+			if query.Where != nil && !collectionMetadataMatches(t.collectionMetadata[collection.ID], query.Where) {
+				continue
+			}
+			database, ok := t.databaseMatches(collection.DatabaseID, query.TenantID, query.DatabaseName)
+			if !ok {
+				continue
+			}
+			collections = append(collections, &dbmodel.CollectionAndMetadata{
+				Collection:         cloneCollection(collection),
+				CollectionMetadata: collectionMetadataList(t, collection.ID),
+				TenantID:           database.TenantID,
+				DatabaseName:       database.Name,
+			})
+		}
+		sort.Slice(collections, func(i, j int) bool {
+			a, b := collections[i].Collection, collections[j].Collection
+			return collectionAfter(b, &dbmodel.CollectionCursor{CreatedAt: a.CreatedAt, ID: a.ID})
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	offset, limit := query.Offset, query.Limit
+	if offset != nil {
+		if int(*offset) >= len(collections) {
+			collections = collections[:0]
+		} else {
+			collections = collections[*offset:]
+		}
+	}
+	if limit != nil && int(*limit) < len(collections) {
+		collections = collections[:*limit]
+	}
+	return collections, nil
+}
+
+// collectionAfter reports whether a collection comes after the cursor in the
+// (created_at, id) listing order.
+func collectionAfter(collection *dbmodel.Collection, cursor *dbmodel.CollectionCursor) bool {
+	if !collection.CreatedAt.Equal(cursor.CreatedAt) {
+		return collection.CreatedAt.After(cursor.CreatedAt)
+	}
+	return collection.ID > cursor.ID
+}
+
+func (s *collectionDb) GetSoftDeletedCollections(collectionID *string, tenantID string, databaseName string, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(&dbmodel.ListCollectionsQuery{
+		ID:           collectionID,
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		Limit:        &limit,
+	}, true)
+}
+
+func (s *collectionDb) GetCollectionsByDatabaseID(databaseID string) ([]*dbmodel.Collection, error) {
+	collections := []*dbmodel.Collection{}
+	err := s.read(func(t *tables) error {
+		for _, collection := range t.collections {
+			if collection.DatabaseID == databaseID {
+				collections = append(collections, cloneCollection(collection))
+			}
+		}
+		return nil
+	})
+	sort.Slice(collections, func(i, j int) bool { return collections[i].ID < collections[j].ID })
+	return collections, err
+}
+
+func (s *collectionDb) GetExistingCollectionIDs(collectionIDs []string) ([]string, error) {
+	existingCollectionIDs := []string{}
+	err := s.read(func(t *tables) error {
+		for _, collectionID := range collectionIDs {
+			if _, ok := t.collections[collectionID]; ok {
+				existingCollectionIDs = append(existingCollectionIDs, collectionID)
+			}
+		}
+		return nil
+	})
+	return existingCollectionIDs, err
+}
+
+func (s *collectionDb) CountCollections(tenantID string, databaseName *string) (uint64, error) {
+	var count uint64
+	err := s.read(func(t *tables) error {
+		name := ""
+		if databaseName != nil {
+			name = *databaseName
+		}
+		for _, collection := range t.collections {
+			if collection.IsDeleted {
+				continue
+			}
+			database, ok := t.databases[collection.DatabaseID]
+			if !ok || database.TenantID != tenantID || (databaseName != nil && database.Name != name) {
+				continue
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+func (s *collectionDb) DeleteCollectionByID(collectionID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		if _, ok := t.collections[collectionID]; ok {
+			delete(t.collections, collectionID)
+			deleted = 1
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+func (s *collectionDb) Insert(in *dbmodel.Collection) error {
+	return s.write(func(t *tables) error {
+		if _, ok := t.collections[in.ID]; ok {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		if in.Name != nil && collectionNameTaken(t, *in.Name, in.DatabaseID, in.ID) {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		now := time.Now()
+		if in.CreatedAt.IsZero() {
+			in.CreatedAt = now
+		}
+		if in.UpdatedAt.IsZero() {
+			in.UpdatedAt = now
+		}
+		t.collections[in.ID] = cloneCollection(in)
+		return nil
+	})
+}
+
+func (s *collectionDb) Update(in *dbmodel.Collection) error {
+	return s.write(func(t *tables) error {
+		collection, ok := t.collections[in.ID]
+		if !ok {
+			return nil
+		}
+		name := collection.Name
+		if in.Name != nil {
+			name = in.Name
+		}
+		databaseID := collection.DatabaseID
+		if in.DatabaseID != "" {
+			databaseID = in.DatabaseID
+		}
+		if name != nil && (in.Name != nil || in.DatabaseID != "") {
+			if collectionNameTaken(t, *name, databaseID, collection.ID) {
+				return common.ErrCollectionUniqueConstraintViolation
+			}
+		}
+		collection.Name = cloneString(name)
+		collection.DatabaseID = databaseID
+		if in.ConfigurationJsonStr != nil {
+			collection.ConfigurationJsonStr = cloneString(in.ConfigurationJsonStr)
+		}
+		if in.Dimension != nil {
+			collection.Dimension = cloneInt32(in.Dimension)
+		}
+		if in.IsDeleted {
+			collection.IsDeleted = true
+		}
+		collection.UpdatedAt = time.Now()
+		collection.RowVersion++
+		return nil
+	})
+}
+
+func (s *collectionDb) Restore(collectionID string, name string) error {
+	return s.write(func(t *tables) error {
+		collection, ok := t.collections[collectionID]
+		if !ok || !collection.IsDeleted {
+			return common.ErrCollectionNotFound
+		}
+		if collectionNameTaken(t, name, collection.DatabaseID, collection.ID) {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		collection.Name = &name
+		collection.IsDeleted = false
+		collection.UpdatedAt = time.Now()
+		collection.RowVersion++
+		return nil
+	})
+}
+
+func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
+	var version int32
+	err := s.write(func(t *tables) error {
+		collection, ok := t.collections[collectionID]
+		if !ok {
+			return gorm.ErrRecordNotFound
+		}
+		if collection.LogPosition > logPosition {
+			return common.ErrCollectionLogPositionStale
+		}
+		if collection.Version > currentCollectionVersion {
+			return common.ErrCollectionVersionStale
+		}
+		if collection.Version < currentCollectionVersion {
+			// this should not happen, potentially a bug
+			return common.ErrCollectionVersionInvalid
+		}
+		version = currentCollectionVersion + 1
+		collection.LogPosition = logPosition
+		collection.Version = version
+		collection.UpdatedAt = time.Now()
+		collection.RowVersion++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (s *collectionDb) ResetLogPosition(collectionID string, logPosition int64) (int32, error) {
+	var version int32
+	err := s.write(func(t *tables) error {
+		collection, ok := t.collections[collectionID]
+		if !ok || collection.IsDeleted {
+			return common.ErrCollectionNotFound
+		}
+		version = collection.Version + 1
+		collection.LogPosition = logPosition
+		collection.Version = version
+		collection.UpdatedAt = time.Now()
+		collection.RowVersion++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (s *collectionDb) CheckRowVersion(collectionID string, expectedRowVersion int64) error {
+	return s.read(func(t *tables) error {
+		collection, ok := t.collections[collectionID]
+		if !ok {
+			return common.ErrCollectionNotFound
+		}
+		if collection.RowVersion != expectedRowVersion {
+			return &common.RowVersionMismatchError{CurrentRowVersion: collection.RowVersion}
+		}
+		return nil
+	})
+}
+
+func (s *collectionDb) CheckCollectionIsSoftDeleted(collectionName string, tenantID string, databaseName string) (bool, string, error) {
+	var matches []*dbmodel.Collection
+	err := s.read(func(t *tables) error {
+		for _, collection := range t.collections {
+			if collection.Name == nil || *collection.Name != collectionName {
+				continue
+			}
+			if _, ok := t.databaseMatches(collection.DatabaseID, tenantID, databaseName); !ok {
+				continue
+			}
+			matches = append(matches, collection)
+		}
+		return nil
+	})
+	if err != nil || len(matches) == 0 {
+		return false, "", err
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches[0].IsDeleted, matches[0].ID, nil
+}
+
+// collectionNameTaken enforces the unique (name, database_id) index.
+func collectionNameTaken(t *tables, name string, databaseID string, exceptID string) bool {
+	for _, collection := range t.collections {
+		if collection.ID == exceptID || collection.DatabaseID != databaseID {
+			continue
+		}
+		if collection.Name != nil && *collection.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package memdb
+
+import (
+	"cmp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type collectionMetadataDb struct {
+	*session
+}
+
+var _ dbmodel.ICollectionMetadataDb = &collectionMetadataDb{}
+
+func (s *collectionMetadataDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.collectionMetadata = map[string]map[string]*dbmodel.CollectionMetadata{}
+		return nil
+	})
+}
+
+func (s *collectionMetadataDb) DeleteByCollectionID(collectionID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		deleted = len(t.collectionMetadata[collectionID])
+		delete(t.collectionMetadata, collectionID)
+		return nil
+	})
+	return deleted, err
+}
+
+func (s *collectionMetadataDb) DeleteByCollectionIDAndKeys(collectionID string, keys []string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		rows := t.collectionMetadata[collectionID]
+		for _, key := range keys {
+			if _, ok := rows[key]; ok {
+				delete(rows, key)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// Insert upserts on (collection_id, key) like the SQL implementation.
+func (s *collectionMetadataDb) Insert(in []*dbmodel.CollectionMetadata) error {
+	return s.write(func(t *tables) error {
+		now := time.Now()
+		for _, metadata := range in {
+			rows, ok := t.collectionMetadata[metadata.CollectionID]
+			if !ok {
+				rows = map[string]*dbmodel.CollectionMetadata{}
+				t.collectionMetadata[metadata.CollectionID] = rows
+			}
+			row := cloneCollectionMetadata(metadata)
+			if existing, ok := rows[*metadata.Key]; ok {
+				row.CreatedAt = existing.CreatedAt
+			} else if row.CreatedAt.IsZero() {
+				row.CreatedAt = now
+			}
+			row.UpdatedAt = now
+			rows[*metadata.Key] = row
+		}
+		return nil
+	})
+}
+
+func collectionMetadataList(t *tables, collectionID string) []*dbmodel.CollectionMetadata {
+	metadata := []*dbmodel.CollectionMetadata{}
+	for _, row := range t.collectionMetadata[collectionID] {
+		metadata = append(metadata, cloneCollectionMetadata(row))
+	}
+	sort.Slice(metadata, func(i, j int) bool { return *metadata[i].Key < *metadata[j].Key })
+	return metadata
+}
+
+// collectionMetadataMatches evaluates a collection metadata filter the way
+// the database translation does.
+func collectionMetadataMatches(metadata map[string]*dbmodel.CollectionMetadata, filter *model.CollectionMetadataFilter) bool {
+	switch filter.Operator {
+	case model.CollectionMetadataFilterAnd:
+		for _, child := range filter.Children {
+			if !collectionMetadataMatches(metadata, child) {
+				return false
+			}
+		}
+		return true
+	case model.CollectionMetadataFilterOr:
+		for _, child := range filter.Children {
+			if collectionMetadataMatches(metadata, child) {
+				return true
+			}
+		}
+		return false
+	}
+
+	row, ok := metadata[filter.Key]
+	matched := false
+	for _, value := range filter.Values {
+		if !ok {
+			break
+		}
+		order, comparable := compareCollectionMetadataValue(row, value)
+		if !comparable {
+			continue
+		}
+		switch filter.Operator {
+		case model.CollectionMetadataFilterEq, model.CollectionMetadataFilterNe, model.CollectionMetadataFilterIn, model.CollectionMetadataFilterNin:
+			matched = order == 0
+		case model.CollectionMetadataFilterGt:
+			matched = order > 0
+		case model.CollectionMetadataFilterGte:
+			matched = order >= 0
+		case model.CollectionMetadataFilterLt:
+			matched = order < 0
+		case model.CollectionMetadataFilterLte:
+			matched = order <= 0
+		}
+		if matched {
+			break
+		}
+	}
+	if filter.Operator == model.CollectionMetadataFilterNe || filter.Operator == model.CollectionMetadataFilterNin {
+		return !matched
+	}
+	return matched
+}
+
+// compareCollectionMetadataValue compares a stored value to a filter value.
+// Ints and floats compare with each other; other values of different types
+// are not comparable.
+func compareCollectionMetadataValue(row *dbmodel.CollectionMetadata, value model.CollectionMetadataValueType) (int, bool) {
+	switch v := value.(type) {
+	case *model.CollectionMetadataValueStringType:
+		if row.StrValue == nil {
+			return 0, false
+		}
+		return strings.Compare(*row.StrValue, v.Value), true
+	case *model.CollectionMetadataValueBoolType:
+		if row.BoolValue == nil {
+			return 0, false
+		}
+		if *row.BoolValue != v.Value {
+			return 1, true
+		}
+		return 0, true
+	case *model.CollectionMetadataValueInt64Type:
+		if row.IntValue != nil {
+			return cmp.Compare(*row.IntValue, v.Value), true
+		}
+		if row.FloatValue != nil {
+			return cmp.Compare(*row.FloatValue, float64(v.Value)), true
+		}
+	case *model.CollectionMetadataValueFloat64Type:
+		if row.IntValue != nil {
+			return cmp.Compare(float64(*row.IntValue), v.Value), true
+		}
+		if row.FloatValue != nil {
+			return cmp.Compare(*row.FloatValue, v.Value), true
+		}
+	}
+	return 0, false
+}
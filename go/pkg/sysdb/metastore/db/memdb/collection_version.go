@@ -0,0 +1,88 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+type collectionVersionDb struct {
+	*session
+}
+
+var _ dbmodel.ICollectionVersionDb = &collectionVersionDb{}
+
+func (s *collectionVersionDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.collectionVersions = map[string]map[int32]*dbmodel.CollectionVersion{}
+		return nil
+	})
+}
+
+func (s *collectionVersionDb) Insert(in *dbmodel.CollectionVersion) error {
+	return s.write(func(t *tables) error {
+		versions, ok := t.collectionVersions[in.CollectionID]
+		if !ok {
+			versions = map[int32]*dbmodel.CollectionVersion{}
+			t.collectionVersions[in.CollectionID] = versions
+		}
+		if _, ok := versions[in.Version]; ok {
+			return gorm.ErrDuplicatedKey
+		}
+		row := cloneCollectionVersion(in)
+		if row.CreatedAt.IsZero() {
+			row.CreatedAt = time.Now()
+		}
+		versions[in.Version] = row
+		return nil
+	})
+}
+
+func (s *collectionVersionDb) ListByCollectionID(collectionID string, limit int32) ([]*dbmodel.CollectionVersion, error) {
+	versions := []*dbmodel.CollectionVersion{}
+	err := s.read(func(t *tables) error {
+		for _, version := range t.collectionVersions[collectionID] {
+			versions = append(versions, cloneCollectionVersion(version))
+		}
+		return nil
+	})
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version > versions[j].Version })
+	if int(limit) < len(versions) {
+		versions = versions[:limit]
+	}
+	return versions, err
+}
+
+func (s *collectionVersionDb) GetByCollectionIDAndVersion(collectionID string, version int32) (*dbmodel.CollectionVersion, error) {
+	var result *dbmodel.CollectionVersion
+	err := s.read(func(t *tables) error {
+		if row, ok := t.collectionVersions[collectionID][version]; ok {
+			result = cloneCollectionVersion(row)
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (s *collectionVersionDb) DeleteByCollectionID(collectionID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		deleted = len(t.collectionVersions[collectionID])
+		delete(t.collectionVersions, collectionID)
+		return nil
+	})
+	return deleted, err
+}
+
+func cloneCollectionVersion(in *dbmodel.CollectionVersion) *dbmodel.CollectionVersion {
+	c := *in
+	if in.SegmentFilePaths != nil {
+		c.SegmentFilePaths = make(map[string]map[string][]string, len(in.SegmentFilePaths))
+		for segmentID, filePaths := range in.SegmentFilePaths {
+			c.SegmentFilePaths[segmentID] = cloneFilePaths(filePaths)
+		}
+	}
+	return &c
+}
@@ -0,0 +1,165 @@
+package memdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+// MetaDomain is a map-backed implementation of dbmodel.IMetaDomain and
+// dbmodel.ITransaction. It keeps the whole catalog in process memory and is
+// meant for fast, hermetic catalog tests.
+//
+// Transactions run against a private snapshot of the tables that replaces the
+// shared tables on commit, so a failed transaction leaves no trace. The
+// snapshot copies a table only when the transaction first writes to it.
+// Writes are serialized; reads outside a transaction see the last committed
+// state.
+type MetaDomain struct {
+	txMu   sync.Mutex
+	mu     sync.RWMutex
+	tables *tables
+}
+
+var _ dbmodel.IMetaDomain = &MetaDomain{}
+var _ dbmodel.ITransaction = &MetaDomain{}
+
+func NewMetaDomain() *MetaDomain {
+	return &MetaDomain{
+		tables: newTables(),
+	}
+}
+
+type ctxTransactionKey struct{}
+
+func (md *MetaDomain) Transaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if parent, ok := ctx.Value(ctxTransactionKey{}).(*tables); ok {
+		// Nested transactions behave like savepoints of the outer one.
+		savepoint := parent.snapshot()
+		if err := fn(context.WithValue(ctx, ctxTransactionKey{}, savepoint)); err != nil {
+			return err
+		}
+		// Tables the savepoint did not copy are still the parent's own.
+		savepoint.owned |= parent.owned
+		*parent = *savepoint
+		return nil
+	}
+
+	md.txMu.Lock()
+	defer md.txMu.Unlock()
+
+	md.mu.RLock()
+	snapshot := md.tables.snapshot()
+	md.mu.RUnlock()
+
+	if err := fn(context.WithValue(ctx, ctxTransactionKey{}, snapshot)); err != nil {
+		return err
+	}
+
+	md.mu.Lock()
+	md.tables = snapshot
+	md.mu.Unlock()
+	return nil
+}
+
+func (md *MetaDomain) session(ctx context.Context, table table) *session {
+	s := &session{md: md, table: table}
+	if ctx != nil {
+		if tx, ok := ctx.Value(ctxTransactionKey{}).(*tables); ok {
+			s.tx = tx
+		}
+	}
+	return s
+}
+
+func (md *MetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
+	return &databaseDb{md.session(ctx, databasesTable)}
+}
+
+func (md *MetaDomain) TenantDb(ctx context.Context) dbmodel.ITenantDb {
+	return &tenantDb{md.session(ctx, tenantsTable)}
+}
+
+func (md *MetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
+	return &collectionDb{md.session(ctx, collectionsTable)}
+}
+
+func (md *MetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollectionMetadataDb {
+	return &collectionMetadataDb{md.session(ctx, collectionMetadataTable)}
+}
+
+func (md *MetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	return &segmentDb{md.session(ctx, segmentsTable)}
+}
+
+func (md *MetaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetadataDb {
+	return &segmentMetadataDb{md.session(ctx, segmentMetadataTable)}
+}
+
+func (md *MetaDomain) FileReferenceDb(ctx context.Context) dbmodel.IFileReferenceDb {
+	return &fileReferenceDb{md.session(ctx, fileReferencesTable)}
+}
+
+func (md *MetaDomain) CollectionVersionDb(ctx context.Context) dbmodel.ICollectionVersionDb {
+	return &collectionVersionDb{md.session(ctx, collectionVersionsTable)}
+}
+
+func (md *MetaDomain) IdempotencyKeyDb(ctx context.Context) dbmodel.IIdempotencyKeyDb {
+	return &idempotencyKeyDb{md.session(ctx, idempotencyKeysTable)}
+}
+
+func (md *MetaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
+	return &notificationDb{md.session(ctx, notificationsTable)}
+}
+
+func (md *MetaDomain) TenantMetadataDb(ctx context.Context) dbmodel.ITenantMetadataDb {
+	return &tenantMetadataDb{md.session(ctx, tenantMetadataTable)}
+}
+
+func (md *MetaDomain) DatabaseMetadataDb(ctx context.Context) dbmodel.IDatabaseMetadataDb {
+	return &databaseMetadataDb{md.session(ctx, databaseMetadataTable)}
+}
+
+func (md *MetaDomain) TenantQuotaDb(ctx context.Context) dbmodel.ITenantQuotaDb {
+	return &tenantQuotaDb{md.session(ctx, tenantQuotasTable)}
+}
+
+func (md *MetaDomain) AuditEventDb(ctx context.Context) dbmodel.IAuditEventDb {
+	return &auditEventDb{md.session(ctx, auditEventsTable)}
+}
+
+// session gives a DAO access to the tables, either those of the enclosing
+// transaction or the shared committed ones. A DAO only writes to its own
+// table.
+type session struct {
+	md    *MetaDomain
+	tx    *tables
+	table table
+}
+
+func (s *session) read(fn func(t *tables) error) error {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
+	s.md.mu.RLock()
+	defer s.md.mu.RUnlock()
+	return fn(s.md.tables)
+}
+
+// write runs fn atomically. Outside a transaction every write is its own
+// single-statement transaction.
+func (s *session) write(fn func(t *tables) error) error {
+	if s.tx != nil {
+		s.tx.own(s.table)
+		return fn(s.tx)
+	}
+	return s.md.Transaction(context.Background(), func(txCtx context.Context) error {
+		tx := txCtx.Value(ctxTransactionKey{}).(*tables)
+		tx.own(s.table)
+		return fn(tx)
+	})
+}
@@ -0,0 +1,302 @@
+package memdb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/logservice"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type MetaDomainTestSuite struct {
+	suite.Suite
+	md      *MetaDomain
+	catalog *coordinator.Catalog
+}
+
+func (suite *MetaDomainTestSuite) SetupTest() {
+	suite.md = NewMetaDomain()
+	suite.catalog = coordinator.NewTableCatalog(suite.md, suite.md)
+	suite.catalog.SetLogService(logservice.NewMockLogService())
+	suite.NoError(suite.catalog.ResetState(context.Background()))
+}
+
+func (suite *MetaDomainTestSuite) TestTransaction_RollbackOnError() {
+	ctx := context.Background()
+	errAbort := errors.New("abort")
+	err := suite.md.Transaction(ctx, func(txCtx context.Context) error {
+		err := suite.md.TenantDb(txCtx).Insert(&dbmodel.Tenant{ID: "rolled_back"})
+		suite.NoError(err)
+		// The write is visible inside the transaction but not outside it.
+		tenants, err := suite.md.TenantDb(txCtx).GetTenants("rolled_back")
+		suite.NoError(err)
+		suite.Len(tenants, 1)
+		tenants, err = suite.md.TenantDb(ctx).GetTenants("rolled_back")
+		suite.NoError(err)
+		suite.Len(tenants, 0)
+		return errAbort
+	})
+	suite.ErrorIs(err, errAbort)
+
+	tenants, err := suite.md.TenantDb(ctx).GetTenants("rolled_back")
+	suite.NoError(err)
+	suite.Len(tenants, 0)
+}
+
+func (suite *MetaDomainTestSuite) TestTransaction_NestedRollback() {
+	ctx := context.Background()
+	err := suite.md.Transaction(ctx, func(txCtx context.Context) error {
+		suite.NoError(suite.md.TenantDb(txCtx).Insert(&dbmodel.Tenant{ID: "outer"}))
+		err := suite.md.Transaction(txCtx, func(nestedCtx context.Context) error {
+			suite.NoError(suite.md.TenantDb(nestedCtx).Insert(&dbmodel.Tenant{ID: "inner"}))
+			return common.ErrTenantNotFound
+		})
+		suite.ErrorIs(err, common.ErrTenantNotFound)
+		return nil
+	})
+	suite.NoError(err)
+
+	tenants, err := suite.md.TenantDb(ctx).GetTenants("outer")
+	suite.NoError(err)
+	suite.Len(tenants, 1)
+	tenants, err = suite.md.TenantDb(ctx).GetTenants("inner")
+	suite.NoError(err)
+	suite.Len(tenants, 0)
+}
+
+func (suite *MetaDomainTestSuite) TestTransaction_CopiesOnlyWrittenTables() {
+	ctx := context.Background()
+	suite.NoError(suite.md.TenantDb(ctx).Insert(&dbmodel.Tenant{ID: "tenant"}))
+	committed := suite.md.tables
+
+	errAbort := errors.New("abort")
+	err := suite.md.Transaction(ctx, func(txCtx context.Context) error {
+		suite.NoError(suite.md.TenantDb(txCtx).UpdateTenantSuspended("tenant", true))
+		tx := txCtx.Value(ctxTransactionKey{}).(*tables)
+		suite.NotEqual(reflect.ValueOf(committed.tenants).Pointer(), reflect.ValueOf(tx.tenants).Pointer())
+		suite.Equal(reflect.ValueOf(committed.collections).Pointer(), reflect.ValueOf(tx.collections).Pointer())
+		return errAbort
+	})
+	suite.ErrorIs(err, errAbort)
+
+	// The in-place update was made on the transaction's copy of the row.
+	tenants, err := suite.md.TenantDb(ctx).GetTenants("tenant")
+	suite.NoError(err)
+	suite.Len(tenants, 1)
+	suite.False(tenants[0].IsSuspended)
+}
+
+func (suite *MetaDomainTestSuite) TestCatalog_CollectionLifecycle() {
+	ctx := context.Background()
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("str", &model.CollectionMetadataValueStringType{Value: "value"})
+	dimension := int32(128)
+	collectionID := types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+
+	collection, created, err := suite.catalog.CreateCollectionAndSegments(ctx, &model.CreateCollection{
+		ID:           collectionID,
+		Name:         "collection",
+		Dimension:    &dimension,
+		Metadata:     metadata,
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+	}, []*model.CreateSegment{
+		{
+			ID:    segmentID,
+			Type:  "urn:chroma:segment/vector/hnsw-distributed",
+			Scope: "VECTOR",
+		},
+	}, 0)
+	suite.NoError(err)
+	suite.True(created)
+	suite.Equal("collection", collection.Name)
+	suite.True(metadata.Equals(collection.Metadata))
+
+	// A second collection with the same name is rejected and leaves no segment behind.
+	_, _, err = suite.catalog.CreateCollectionAndSegments(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "collection",
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+	}, []*model.CreateSegment{{ID: types.NewUniqueID(), Scope: "VECTOR"}}, 0)
+	suite.ErrorIs(err, common.ErrCollectionUniqueConstraintViolation)
+
+	newName := "renamed"
+	collection, err = suite.catalog.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:            collectionID,
+		Name:          &newName,
+		ResetMetadata: true,
+		TenantID:      common.DefaultTenant,
+		DatabaseName:  common.DefaultDatabase,
+	}, 0)
+	suite.NoError(err)
+	suite.Equal(newName, collection.Name)
+	suite.Nil(collection.Metadata)
+
+	flushInfo, err := suite.catalog.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       collectionID,
+		TenantID:                 common.DefaultTenant,
+		LogPosition:              10,
+		CurrentCollectionVersion: 0,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segmentID, FilePaths: map[string][]string{"hnsw": {"path"}}},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(int32(1), flushInfo.CollectionVersion)
+
+	segments, err := suite.catalog.GetSegments(ctx, types.NilUniqueID(), nil, nil, collectionID)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	suite.Equal(map[string][]string{"hnsw": {"path"}}, segments[0].FilePaths)
+
+	// A stale flush fails without touching the collection.
+	_, err = suite.catalog.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       collectionID,
+		TenantID:                 common.DefaultTenant,
+		LogPosition:              5,
+		CurrentCollectionVersion: 1,
+	})
+	suite.ErrorIs(err, common.ErrCollectionLogPositionStale)
+
+	err = suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           collectionID,
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+	}, true)
+	suite.NoError(err)
+	collections, err := suite.catalog.GetCollections(ctx, collectionID, nil, common.DefaultTenant, common.DefaultDatabase, nil, nil)
+	suite.NoError(err)
+	suite.Len(collections, 0)
+	deleted, err := suite.catalog.GetSoftDeletedCollections(ctx, nil, common.DefaultTenant, common.DefaultDatabase, 10)
+	suite.NoError(err)
+	suite.Len(deleted, 1)
+
+	err = suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           collectionID,
+		DatabaseName: common.DefaultDatabase,
+	}, false)
+	suite.NoError(err)
+	segments, err = suite.catalog.GetSegments(ctx, types.NilUniqueID(), nil, nil, collectionID)
+	suite.NoError(err)
+	suite.Len(segments, 0)
+}
+
+func (suite *MetaDomainTestSuite) TestCatalog_GetCollectionsPagination() {
+	ctx := context.Background()
+	ids := make([]types.UniqueID, 0, 5)
+	for i := 0; i < 5; i++ {
+		id := types.NewUniqueID()
+		ids = append(ids, id)
+		_, _, err := suite.catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           id,
+			Name:         "collection_" + id.String(),
+			TenantID:     common.DefaultTenant,
+			DatabaseName: common.DefaultDatabase,
+		}, 0)
+		suite.NoError(err)
+	}
+	limit, offset := int32(2), int32(3)
+	collections, err := suite.catalog.GetCollections(ctx, types.NilUniqueID(), nil, common.DefaultTenant, common.DefaultDatabase, &limit, &offset)
+	suite.NoError(err)
+	suite.Len(collections, 2)
+	suite.Equal(ids[3], collections[0].ID)
+	suite.Equal(ids[4], collections[1].ID)
+}
+
+func (suite *MetaDomainTestSuite) TestCatalog_ListCollectionsWhere() {
+	ctx := context.Background()
+	owners := []string{"alice", "bob", "alice"}
+	ids := make([]types.UniqueID, 0, len(owners))
+	for i, owner := range owners {
+		id := types.NewUniqueID()
+		ids = append(ids, id)
+		metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+		metadata.Add("owner", &model.CollectionMetadataValueStringType{Value: owner})
+		metadata.Add("schema_version", &model.CollectionMetadataValueInt64Type{Value: int64(i)})
+		_, _, err := suite.catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           id,
+			Name:         "collection_" + id.String(),
+			Metadata:     metadata,
+			TenantID:     common.DefaultTenant,
+			DatabaseName: common.DefaultDatabase,
+		}, 0)
+		suite.NoError(err)
+	}
+
+	collections, _, err := suite.catalog.ListCollections(ctx, &model.ListCollections{
+		ID:           types.NilUniqueID(),
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+		Where: &model.CollectionMetadataFilter{Operator: model.CollectionMetadataFilterAnd, Children: []*model.CollectionMetadataFilter{
+			{Operator: model.CollectionMetadataFilterEq, Key: "owner", Values: []model.CollectionMetadataValueType{&model.CollectionMetadataValueStringType{Value: "alice"}}},
+			{Operator: model.CollectionMetadataFilterGt, Key: "schema_version", Values: []model.CollectionMetadataValueType{&model.CollectionMetadataValueFloat64Type{Value: 0.5}}},
+		}},
+	})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(ids[2], collections[0].ID)
+
+	collections, _, err = suite.catalog.ListCollections(ctx, &model.ListCollections{
+		ID:           types.NilUniqueID(),
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+		Where:        &model.CollectionMetadataFilter{Operator: model.CollectionMetadataFilterNin, Key: "env", Values: []model.CollectionMetadataValueType{&model.CollectionMetadataValueStringType{Value: "prod"}}},
+	})
+	suite.NoError(err)
+	suite.Len(collections, 3)
+}
+
+func (suite *MetaDomainTestSuite) TestCatalog_ForkCollection() {
+	ctx := context.Background()
+	sourceID := types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+	filePaths := []string{"a", "b"}
+	_, _, err := suite.catalog.CreateCollectionAndSegments(ctx, &model.CreateCollection{
+		ID:           sourceID,
+		Name:         "source",
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+	}, []*model.CreateSegment{
+		{ID: segmentID, Type: "test_type_a", Scope: "VECTOR", FilePaths: map[string][]string{"hnsw": filePaths}},
+	}, 0)
+	suite.NoError(err)
+
+	fork, err := suite.catalog.ForkCollection(ctx, &model.ForkCollection{
+		SourceCollectionID:   sourceID,
+		TargetCollectionID:   types.NewUniqueID(),
+		TargetCollectionName: "fork",
+	})
+	suite.NoError(err)
+	segments, err := suite.catalog.GetSegments(ctx, types.NilUniqueID(), nil, nil, fork.ID)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	suite.Equal(map[string][]string{"hnsw": filePaths}, segments[0].FilePaths)
+
+	shared, err := suite.catalog.GetSharedFilePaths(ctx, sourceID, append(filePaths, "c"))
+	suite.NoError(err)
+	suite.Equal(filePaths, shared)
+
+	err = suite.catalog.DeleteCollection(ctx, &model.DeleteCollection{
+		ID:           fork.ID,
+		TenantID:     common.DefaultTenant,
+		DatabaseName: common.DefaultDatabase,
+	}, false)
+	suite.NoError(err)
+	shared, err = suite.catalog.GetSharedFilePaths(ctx, sourceID, filePaths)
+	suite.NoError(err)
+	suite.Empty(shared)
+}
+
+func TestMetaDomainTestSuite(t *testing.T) {
+	testSuite := new(MetaDomainTestSuite)
+	suite.Run(t, testSuite)
+}
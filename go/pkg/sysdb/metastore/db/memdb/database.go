@@ -0,0 +1,183 @@
+package memdb
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type databaseDb struct {
+	*session
+}
+
+var _ dbmodel.IDatabaseDb = &databaseDb{}
+
+func (s *databaseDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.databases = map[string]*dbmodel.Database{}
+		return nil
+	})
+}
+
+func (s *databaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	err := s.read(func(t *tables) error {
+		for _, database := range t.databases {
+			databases = append(databases, cloneDatabase(database))
+		}
+		return nil
+	})
+	sort.Slice(databases, func(i, j int) bool { return databases[i].ID < databases[j].ID })
+	return databases, err
+}
+
+func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmodel.Database, error) {
+	databases := []*dbmodel.Database{}
+	err := s.read(func(t *tables) error {
+		for _, database := range t.databases {
+			if database.TenantID == tenantID && database.Name == databaseName && !database.IsDeleted {
+				databases = append(databases, cloneDatabase(database))
+			}
+		}
+		return nil
+	})
+	return databases, err
+}
+
+func (s *databaseDb) GetDatabaseByID(databaseID string) ([]*dbmodel.Database, error) {
+	databases := []*dbmodel.Database{}
+	err := s.read(func(t *tables) error {
+		if database, ok := t.databases[databaseID]; ok {
+			databases = append(databases, cloneDatabase(database))
+		}
+		return nil
+	})
+	return databases, err
+}
+
+func (s *databaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
+	databases := []*dbmodel.Database{}
+	err := s.read(func(t *tables) error {
+		for _, database := range t.databases {
+			if database.TenantID == tenantID {
+				databases = append(databases, cloneDatabase(database))
+			}
+		}
+		return nil
+	})
+	sort.Slice(databases, func(i, j int) bool { return databases[i].ID < databases[j].ID })
+	return databases, err
+}
+
+func (s *databaseDb) ListDatabases(tenantID string, namePrefix string, startAfter string, limit int32) ([]*dbmodel.Database, error) {
+	databases := []*dbmodel.Database{}
+	err := s.read(func(t *tables) error {
+		for _, database := range t.databases {
+			if database.TenantID != tenantID || database.IsDeleted {
+				continue
+			}
+			if !strings.HasPrefix(database.Name, namePrefix) || database.Name <= startAfter {
+				continue
+			}
+			databases = append(databases, cloneDatabase(database))
+		}
+		return nil
+	})
+	sort.Slice(databases, func(i, j int) bool { return databases[i].Name < databases[j].Name })
+	if len(databases) > int(limit) {
+		databases = databases[:limit]
+	}
+	return databases, err
+}
+
+func (s *databaseDb) CountDatabases(tenantID string) (uint64, error) {
+	var count uint64
+	err := s.read(func(t *tables) error {
+		for _, database := range t.databases {
+			if database.TenantID == tenantID && !database.IsDeleted {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+func (s *databaseDb) GetSoftDeletedDatabases(limit int32) ([]*dbmodel.Database, error) {
+	databases := []*dbmodel.Database{}
+	err := s.read(func(t *tables) error {
+		for _, database := range t.databases {
+			if database.IsDeleted {
+				databases = append(databases, cloneDatabase(database))
+			}
+		}
+		return nil
+	})
+	sort.Slice(databases, func(i, j int) bool { return databases[i].UpdatedAt.Before(databases[j].UpdatedAt) })
+	if len(databases) > int(limit) {
+		databases = databases[:limit]
+	}
+	return databases, err
+}
+
+func (s *databaseDb) Insert(database *dbmodel.Database) error {
+	return s.write(func(t *tables) error {
+		if _, ok := t.databases[database.ID]; ok {
+			return common.ErrDatabaseUniqueConstraintViolation
+		}
+		for _, existing := range t.databases {
+			if existing.TenantID == database.TenantID && existing.Name == database.Name {
+				return common.ErrDatabaseUniqueConstraintViolation
+			}
+		}
+		now := time.Now()
+		if database.CreatedAt.IsZero() {
+			database.CreatedAt = now
+		}
+		if database.UpdatedAt.IsZero() {
+			database.UpdatedAt = now
+		}
+		t.databases[database.ID] = cloneDatabase(database)
+		return nil
+	})
+}
+
+func (s *databaseDb) Update(in *dbmodel.Database) error {
+	return s.write(func(t *tables) error {
+		database, ok := t.databases[in.ID]
+		if !ok {
+			return nil
+		}
+		if in.Name != "" {
+			for _, existing := range t.databases {
+				if existing.ID != database.ID && existing.TenantID == database.TenantID && existing.Name == in.Name {
+					return common.ErrDatabaseUniqueConstraintViolation
+				}
+			}
+			database.Name = in.Name
+		}
+		if in.IsDeleted {
+			database.IsDeleted = true
+		}
+		if in.Ts != 0 {
+			database.Ts = in.Ts
+		}
+		database.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+func (s *databaseDb) DeleteByID(databaseID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		if _, ok := t.databases[databaseID]; ok {
+			delete(t.databases, databaseID)
+			deleted = 1
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,91 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type databaseMetadataDb struct {
+	*session
+}
+
+var _ dbmodel.IDatabaseMetadataDb = &databaseMetadataDb{}
+
+func (s *databaseMetadataDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.databaseMetadata = map[string]map[string]*dbmodel.DatabaseMetadata{}
+		return nil
+	})
+}
+
+func (s *databaseMetadataDb) GetByDatabaseID(databaseID string) ([]*dbmodel.DatabaseMetadata, error) {
+	var metadata []*dbmodel.DatabaseMetadata
+	err := s.read(func(t *tables) error {
+		for _, row := range t.databaseMetadata[databaseID] {
+			metadata = append(metadata, cloneDatabaseMetadata(row))
+		}
+		sort.Slice(metadata, func(i, j int) bool { return *metadata[i].Key < *metadata[j].Key })
+		return nil
+	})
+	return metadata, err
+}
+
+func (s *databaseMetadataDb) DeleteByDatabaseID(databaseID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		deleted = len(t.databaseMetadata[databaseID])
+		delete(t.databaseMetadata, databaseID)
+		return nil
+	})
+	return deleted, err
+}
+
+func (s *databaseMetadataDb) DeleteByDatabaseIDAndKeys(databaseID string, keys []string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		rows := t.databaseMetadata[databaseID]
+		for _, key := range keys {
+			if _, ok := rows[key]; ok {
+				delete(rows, key)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// Insert upserts on (database_id, key) like the SQL implementation.
+func (s *databaseMetadataDb) Insert(in []*dbmodel.DatabaseMetadata) error {
+	return s.write(func(t *tables) error {
+		now := time.Now()
+		for _, metadata := range in {
+			rows, ok := t.databaseMetadata[metadata.DatabaseID]
+			if !ok {
+				rows = map[string]*dbmodel.DatabaseMetadata{}
+				t.databaseMetadata[metadata.DatabaseID] = rows
+			}
+			row := cloneDatabaseMetadata(metadata)
+			if existing, ok := rows[*metadata.Key]; ok {
+				row.CreatedAt = existing.CreatedAt
+			} else if row.CreatedAt.IsZero() {
+				row.CreatedAt = now
+			}
+			row.UpdatedAt = now
+			rows[*metadata.Key] = row
+		}
+		return nil
+	})
+}
+
+func cloneDatabaseMetadata(in *dbmodel.DatabaseMetadata) *dbmodel.DatabaseMetadata {
+	c := *in
+	c.Key = cloneString(in.Key)
+	c.StrValue = cloneString(in.StrValue)
+	c.IntValue = cloneInt64(in.IntValue)
+	c.FloatValue = cloneFloat64(in.FloatValue)
+	c.BoolValue = cloneBool(in.BoolValue)
+	return &c
+}
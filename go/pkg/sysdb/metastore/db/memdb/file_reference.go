@@ -0,0 +1,95 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type fileReferenceDb struct {
+	*session
+}
+
+var _ dbmodel.IFileReferenceDb = &fileReferenceDb{}
+
+func (s *fileReferenceDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.fileReferences = map[string]map[string]*dbmodel.FileReference{}
+		return nil
+	})
+}
+
+func (s *fileReferenceDb) Insert(in []*dbmodel.FileReference) error {
+	return s.write(func(t *tables) error {
+		now := time.Now()
+		for _, reference := range in {
+			rows, ok := t.fileReferences[reference.FilePath]
+			if !ok {
+				rows = map[string]*dbmodel.FileReference{}
+				t.fileReferences[reference.FilePath] = rows
+			}
+			if _, ok := rows[reference.CollectionID]; ok {
+				continue
+			}
+			row := *reference
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = now
+			}
+			rows[reference.CollectionID] = &row
+		}
+		return nil
+	})
+}
+
+func (s *fileReferenceDb) GetSharedFilePaths(collectionID string, filePaths []string) ([]string, error) {
+	shared := []string{}
+	err := s.read(func(t *tables) error {
+		seen := map[string]bool{}
+		for _, filePath := range filePaths {
+			if seen[filePath] {
+				continue
+			}
+			seen[filePath] = true
+			for referencingID := range t.fileReferences[filePath] {
+				if referencingID != collectionID {
+					shared = append(shared, filePath)
+					break
+				}
+			}
+		}
+		return nil
+	})
+	sort.Strings(shared)
+	return shared, err
+}
+
+func (s *fileReferenceDb) DeleteByCollectionID(collectionID string) (int, error) {
+	return s.deleteWhere(collectionID, func(string) bool { return true })
+}
+
+func (s *fileReferenceDb) DeleteUnusedByCollectionID(collectionID string, usedFilePaths []string) (int, error) {
+	used := make(map[string]bool, len(usedFilePaths))
+	for _, filePath := range usedFilePaths {
+		used[filePath] = true
+	}
+	return s.deleteWhere(collectionID, func(filePath string) bool { return !used[filePath] })
+}
+
+func (s *fileReferenceDb) deleteWhere(collectionID string, match func(filePath string) bool) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		for filePath, rows := range t.fileReferences {
+			if _, ok := rows[collectionID]; !ok || !match(filePath) {
+				continue
+			}
+			delete(rows, collectionID)
+			deleted++
+			if len(rows) == 0 {
+				delete(t.fileReferences, filePath)
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,115 @@
+package memdb
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type idempotencyKeyDb struct {
+	*session
+}
+
+var _ dbmodel.IIdempotencyKeyDb = &idempotencyKeyDb{}
+
+func (s *idempotencyKeyDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.idempotencyKeys = map[string]map[string]*dbmodel.IdempotencyKey{}
+		return nil
+	})
+}
+
+func (s *idempotencyKeyDb) Get(method string, key string) (*dbmodel.IdempotencyKey, error) {
+	var result *dbmodel.IdempotencyKey
+	err := s.read(func(t *tables) error {
+		if row, ok := t.idempotencyKeys[method][key]; ok {
+			result = cloneIdempotencyKey(row)
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (s *idempotencyKeyDb) Insert(in *dbmodel.IdempotencyKey) (bool, error) {
+	inserted := false
+	err := s.write(func(t *tables) error {
+		rows, ok := t.idempotencyKeys[in.Method]
+		if !ok {
+			rows = map[string]*dbmodel.IdempotencyKey{}
+			t.idempotencyKeys[in.Method] = rows
+		}
+		if _, ok := rows[in.Key]; ok {
+			return nil
+		}
+		row := cloneIdempotencyKey(in)
+		if row.CreatedAt.IsZero() {
+			row.CreatedAt = time.Now()
+		}
+		if row.ReservedAt.IsZero() {
+			row.ReservedAt = time.Now()
+		}
+		rows[in.Key] = row
+		inserted = true
+		return nil
+	})
+	return inserted, err
+}
+
+func (s *idempotencyKeyDb) Complete(method string, key string, response []byte) error {
+	return s.write(func(t *tables) error {
+		if row, ok := t.idempotencyKeys[method][key]; ok && row.Pending {
+			row.Response = append([]byte(nil), response...)
+			row.Pending = false
+		}
+		return nil
+	})
+}
+
+func (s *idempotencyKeyDb) TakeOver(in *dbmodel.IdempotencyKey, reservedBefore time.Time) (bool, error) {
+	takenOver := false
+	err := s.write(func(t *tables) error {
+		row, ok := t.idempotencyKeys[in.Method][in.Key]
+		if !ok || !row.Pending || !row.ReservedAt.Before(reservedBefore) {
+			return nil
+		}
+		row.RequestHash = in.RequestHash
+		row.ReservedAt = in.ReservedAt
+		takenOver = true
+		return nil
+	})
+	return takenOver, err
+}
+
+func (s *idempotencyKeyDb) DeletePending(method string, key string) error {
+	return s.write(func(t *tables) error {
+		if row, ok := t.idempotencyKeys[method][key]; ok && row.Pending {
+			delete(t.idempotencyKeys[method], key)
+		}
+		return nil
+	})
+}
+
+func (s *idempotencyKeyDb) DeleteCreatedBefore(before time.Time) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		for method, rows := range t.idempotencyKeys {
+			for key, row := range rows {
+				if row.CreatedAt.Before(before) {
+					delete(rows, key)
+					deleted++
+				}
+			}
+			if len(rows) == 0 {
+				delete(t.idempotencyKeys, method)
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+func cloneIdempotencyKey(in *dbmodel.IdempotencyKey) *dbmodel.IdempotencyKey {
+	c := *in
+	c.Response = append([]byte(nil), in.Response...)
+	return &c
+}
@@ -0,0 +1,113 @@
+package memdb
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type notificationDb struct {
+	*session
+}
+
+var _ dbmodel.INotificationDb = &notificationDb{}
+
+func (s *notificationDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.notifications = nil
+		t.notificationRevision = 0
+		return nil
+	})
+}
+
+// Insert relies on transactions being serialized, like every write of the
+// in-memory catalog, so revisions are allocated in commit order without gaps.
+func (s *notificationDb) Insert(in *dbmodel.Notification) error {
+	return s.write(func(t *tables) error {
+		t.notificationRevision++
+		in.ID = t.notificationRevision
+		row := *in
+		if row.CreatedAt.IsZero() {
+			row.CreatedAt = time.Now()
+		}
+		t.notifications = append(t.notifications, &row)
+		return nil
+	})
+}
+
+func (s *notificationDb) ListNotifications(query *dbmodel.ListNotificationsQuery) ([]*dbmodel.Notification, error) {
+	notifications := []*dbmodel.Notification{}
+	err := s.read(func(t *tables) error {
+		for _, notification := range t.notifications {
+			if notification.ID <= query.AfterRevision {
+				continue
+			}
+			if notification.ID > query.UpToRevision {
+				break
+			}
+			if query.TenantID != "" && notification.TenantID != query.TenantID {
+				continue
+			}
+			if query.DatabaseID != "" && notification.DatabaseID != query.DatabaseID {
+				continue
+			}
+			row := *notification
+			notifications = append(notifications, &row)
+			if len(notifications) == int(query.Limit) {
+				break
+			}
+		}
+		return nil
+	})
+	return notifications, err
+}
+
+func (s *notificationDb) ListRevisions(afterRevision int64, limit int32) ([]int64, error) {
+	revisions := []int64{}
+	err := s.read(func(t *tables) error {
+		for _, notification := range t.notifications {
+			if notification.ID <= afterRevision {
+				continue
+			}
+			revisions = append(revisions, notification.ID)
+			if len(revisions) == int(limit) {
+				break
+			}
+		}
+		return nil
+	})
+	return revisions, err
+}
+
+func (s *notificationDb) GetRevisionRange() (int64, int64, error) {
+	var oldest, latest int64
+	err := s.read(func(t *tables) error {
+		if len(t.notifications) > 0 {
+			oldest = t.notifications[0].ID
+			latest = t.notifications[len(t.notifications)-1].ID
+		}
+		return nil
+	})
+	return oldest, latest, err
+}
+
+func (s *notificationDb) DeleteCreatedBefore(before time.Time) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		if len(t.notifications) == 0 {
+			return nil
+		}
+		latest := len(t.notifications) - 1
+		notifications := make([]*dbmodel.Notification, 0, len(t.notifications))
+		for i, notification := range t.notifications {
+			if i != latest && notification.CreatedAt.Before(before) {
+				deleted++
+				continue
+			}
+			notifications = append(notifications, notification)
+		}
+		t.notifications = notifications
+		return nil
+	})
+	return deleted, err
+}
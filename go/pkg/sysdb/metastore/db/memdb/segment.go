@@ -0,0 +1,135 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/coordinator/model"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+type segmentDb struct {
+	*session
+}
+
+var _ dbmodel.ISegmentDb = &segmentDb{}
+
+func (s *segmentDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.segments = map[string]*dbmodel.Segment{}
+		return nil
+	})
+}
+
+func (s *segmentDb) DeleteSegmentByID(id string) error {
+	return s.write(func(t *tables) error {
+		delete(t.segments, id)
+		return nil
+	})
+}
+
+func (s *segmentDb) Insert(in *dbmodel.Segment) error {
+	return s.write(func(t *tables) error {
+		if _, ok := t.segments[in.ID]; ok {
+			return common.ErrSegmentUniqueConstraintViolation
+		}
+		now := time.Now()
+		if in.CreatedAt.IsZero() {
+			in.CreatedAt = now
+		}
+		if in.UpdatedAt.IsZero() {
+			in.UpdatedAt = now
+		}
+		segment := cloneSegment(in)
+		if segment.FilePaths == nil {
+			segment.FilePaths = map[string][]string{}
+		}
+		t.segments[in.ID] = segment
+		return nil
+	})
+}
+
+func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error) {
+	if collectionID == types.NilUniqueID() {
+		return nil, common.ErrMissingCollectionID
+	}
+
+	var segments []*dbmodel.SegmentAndMetadata
+	err := s.read(func(t *tables) error {
+		for _, segment := range t.segments {
+			if segment.CollectionID == nil || *segment.CollectionID != collectionID.String() {
+				continue
+			}
+			if id != types.NilUniqueID() && segment.ID != id.String() {
+				continue
+			}
+			if segmentType != nil && segment.Type != *segmentType {
+				continue
+			}
+			if scope != nil && segment.Scope != *scope {
+				continue
+			}
+			segments = append(segments, &dbmodel.SegmentAndMetadata{
+				Segment:         cloneSegment(segment),
+				SegmentMetadata: segmentMetadataList(t, segment.ID),
+			})
+		}
+		return nil
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].Segment.ID < segments[j].Segment.ID })
+	return segments, err
+}
+
+// Update mirrors the SQL implementation, which currently has no updatable
+// segment column.
+func (s *segmentDb) Update(in *dbmodel.UpdateSegment) error {
+	return s.write(func(t *tables) error {
+		if segment, ok := t.segments[in.ID]; ok {
+			segment.UpdatedAt = time.Now()
+			segment.RowVersion++
+		}
+		return nil
+	})
+}
+
+func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction) error {
+	return s.write(func(t *tables) error {
+		for _, flushSegmentCompaction := range flushSegmentCompactions {
+			if segment, ok := t.segments[flushSegmentCompaction.ID.String()]; ok {
+				segment.FilePaths = cloneFilePaths(flushSegmentCompaction.FilePaths)
+				segment.UpdatedAt = time.Now()
+				segment.RowVersion++
+			}
+		}
+		return nil
+	})
+}
+
+func (s *segmentDb) CheckRowVersion(segmentID string, expectedRowVersion int64) error {
+	return s.read(func(t *tables) error {
+		segment, ok := t.segments[segmentID]
+		if !ok {
+			return common.ErrSegmentUpdateNonExistingSegment
+		}
+		if segment.RowVersion != expectedRowVersion {
+			return &common.RowVersionMismatchError{CurrentRowVersion: segment.RowVersion}
+		}
+		return nil
+	})
+}
+
+func (s *segmentDb) GetSegmentsByCollectionID(collectionID string) ([]*dbmodel.Segment, error) {
+	segments := []*dbmodel.Segment{}
+	err := s.read(func(t *tables) error {
+		for _, segment := range t.segments {
+			if segment.CollectionID != nil && *segment.CollectionID == collectionID {
+				segments = append(segments, cloneSegment(segment))
+			}
+		}
+		return nil
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].ID < segments[j].ID })
+	return segments, err
+}
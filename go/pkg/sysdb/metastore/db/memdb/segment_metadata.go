@@ -0,0 +1,74 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type segmentMetadataDb struct {
+	*session
+}
+
+var _ dbmodel.ISegmentMetadataDb = &segmentMetadataDb{}
+
+func (s *segmentMetadataDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.segmentMetadata = map[string]map[string]*dbmodel.SegmentMetadata{}
+		return nil
+	})
+}
+
+func (s *segmentMetadataDb) DeleteBySegmentID(segmentID string) error {
+	return s.write(func(t *tables) error {
+		delete(t.segmentMetadata, segmentID)
+		return nil
+	})
+}
+
+func (s *segmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID string, keys []string) error {
+	return s.write(func(t *tables) error {
+		rows := t.segmentMetadata[segmentID]
+		for _, key := range keys {
+			delete(rows, key)
+		}
+		return nil
+	})
+}
+
+// Insert upserts on (segment_id, key) like the SQL implementation.
+func (s *segmentMetadataDb) Insert(in []*dbmodel.SegmentMetadata) error {
+	return s.write(func(t *tables) error {
+		now := time.Now()
+		for _, metadata := range in {
+			rows, ok := t.segmentMetadata[metadata.SegmentID]
+			if !ok {
+				rows = map[string]*dbmodel.SegmentMetadata{}
+				t.segmentMetadata[metadata.SegmentID] = rows
+			}
+			row := cloneSegmentMetadata(metadata)
+			if existing, ok := rows[*metadata.Key]; ok {
+				row.CreatedAt = existing.CreatedAt
+			} else if row.CreatedAt.IsZero() {
+				row.CreatedAt = now
+			}
+			row.UpdatedAt = now
+			rows[*metadata.Key] = row
+		}
+		return nil
+	})
+}
+
+func segmentMetadataList(t *tables, segmentID string) []*dbmodel.SegmentMetadata {
+	rows, ok := t.segmentMetadata[segmentID]
+	if !ok {
+		return nil
+	}
+	metadata := make([]*dbmodel.SegmentMetadata, 0, len(rows))
+	for _, row := range rows {
+		metadata = append(metadata, cloneSegmentMetadata(row))
+	}
+	sort.Slice(metadata, func(i, j int) bool { return *metadata[i].Key < *metadata[j].Key })
+	return metadata
+}
@@ -0,0 +1,258 @@
+package memdb
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+// table identifies one of the tables of a tables value.
+type table uint
+
+const (
+	tenantsTable table = iota
+	tenantMetadataTable
+	databasesTable
+	databaseMetadataTable
+	collectionsTable
+	collectionMetadataTable
+	segmentsTable
+	segmentMetadataTable
+	fileReferencesTable
+	collectionVersionsTable
+	idempotencyKeysTable
+	notificationsTable
+	tenantQuotasTable
+	auditEventsTable
+)
+
+// tables holds one map per catalog table. A tables value shares the maps
+// of the tables it was copied from until it takes ownership of a table,
+// which deep-copies only that table.
+type tables struct {
+	tenants            map[string]*dbmodel.Tenant
+	tenantMetadata     map[string]map[string]*dbmodel.TenantMetadata
+	databases          map[string]*dbmodel.Database
+	databaseMetadata   map[string]map[string]*dbmodel.DatabaseMetadata
+	collections        map[string]*dbmodel.Collection
+	collectionMetadata map[string]map[string]*dbmodel.CollectionMetadata
+	segments           map[string]*dbmodel.Segment
+	segmentMetadata    map[string]map[string]*dbmodel.SegmentMetadata
+	// file path -> collection id -> reference
+	fileReferences map[string]map[string]*dbmodel.FileReference
+	// collection id -> version -> snapshot
+	collectionVersions map[string]map[int32]*dbmodel.CollectionVersion
+	// method -> idempotency key -> record
+	idempotencyKeys map[string]map[string]*dbmodel.IdempotencyKey
+	// notifications in revision order
+	notifications        []*dbmodel.Notification
+	notificationRevision int64
+	// tenant id -> quota
+	tenantQuotas map[string]*dbmodel.TenantQuota
+	// audit events in id order
+	auditEvents  []*dbmodel.AuditEvent
+	auditEventID int64
+
+	// owned has one bit per table this value may modify in place.
+	owned uint64
+}
+
+func newTables() *tables {
+	return &tables{
+		tenants:            map[string]*dbmodel.Tenant{},
+		tenantMetadata:     map[string]map[string]*dbmodel.TenantMetadata{},
+		databases:          map[string]*dbmodel.Database{},
+		databaseMetadata:   map[string]map[string]*dbmodel.DatabaseMetadata{},
+		collections:        map[string]*dbmodel.Collection{},
+		collectionMetadata: map[string]map[string]*dbmodel.CollectionMetadata{},
+		segments:           map[string]*dbmodel.Segment{},
+		segmentMetadata:    map[string]map[string]*dbmodel.SegmentMetadata{},
+		fileReferences:     map[string]map[string]*dbmodel.FileReference{},
+		collectionVersions: map[string]map[int32]*dbmodel.CollectionVersion{},
+		idempotencyKeys:    map[string]map[string]*dbmodel.IdempotencyKey{},
+		tenantQuotas:       map[string]*dbmodel.TenantQuota{},
+	}
+}
+
+// snapshot returns a copy of t that shares every table with t.
+func (t *tables) snapshot() *tables {
+	c := *t
+	c.owned = 0
+	return &c
+}
+
+// own makes table private to t, copying it the first time it is written.
+func (t *tables) own(table table) {
+	if t.owned&(1<<table) != 0 {
+		return
+	}
+	t.owned |= 1 << table
+	switch table {
+	case tenantsTable:
+		t.tenants = cloneRows(t.tenants, cloneTenant)
+	case tenantMetadataTable:
+		t.tenantMetadata = cloneNestedRows(t.tenantMetadata, cloneTenantMetadata)
+	case databasesTable:
+		t.databases = cloneRows(t.databases, cloneDatabase)
+	case databaseMetadataTable:
+		t.databaseMetadata = cloneNestedRows(t.databaseMetadata, cloneDatabaseMetadata)
+	case collectionsTable:
+		t.collections = cloneRows(t.collections, cloneCollection)
+	case collectionMetadataTable:
+		t.collectionMetadata = cloneNestedRows(t.collectionMetadata, cloneCollectionMetadata)
+	case segmentsTable:
+		t.segments = cloneRows(t.segments, cloneSegment)
+	case segmentMetadataTable:
+		t.segmentMetadata = cloneNestedRows(t.segmentMetadata, cloneSegmentMetadata)
+	case fileReferencesTable:
+		t.fileReferences = cloneNestedRows(t.fileReferences, func(in *dbmodel.FileReference) *dbmodel.FileReference {
+			c := *in
+			return &c
+		})
+	case collectionVersionsTable:
+		t.collectionVersions = cloneNestedRows(t.collectionVersions, cloneCollectionVersion)
+	case idempotencyKeysTable:
+		t.idempotencyKeys = cloneNestedRows(t.idempotencyKeys, cloneIdempotencyKey)
+	case notificationsTable:
+		notifications := make([]*dbmodel.Notification, 0, len(t.notifications))
+		for _, v := range t.notifications {
+			row := *v
+			notifications = append(notifications, &row)
+		}
+		t.notifications = notifications
+	case tenantQuotasTable:
+		t.tenantQuotas = cloneRows(t.tenantQuotas, cloneTenantQuota)
+	case auditEventsTable:
+		auditEvents := make([]*dbmodel.AuditEvent, 0, len(t.auditEvents))
+		for _, v := range t.auditEvents {
+			auditEvents = append(auditEvents, cloneAuditEvent(v))
+		}
+		t.auditEvents = auditEvents
+	}
+}
+
+func cloneRows[K comparable, V any](in map[K]*V, clone func(*V) *V) map[K]*V {
+	c := make(map[K]*V, len(in))
+	for k, v := range in {
+		c[k] = clone(v)
+	}
+	return c
+}
+
+func cloneNestedRows[K1 comparable, K2 comparable, V any](in map[K1]map[K2]*V, clone func(*V) *V) map[K1]map[K2]*V {
+	c := make(map[K1]map[K2]*V, len(in))
+	for k, v := range in {
+		c[k] = cloneRows(v, clone)
+	}
+	return c
+}
+
+// databaseMatches mirrors the join on databases used by the SQL DAOs,
+// where an empty tenant or database name does not filter.
+func (t *tables) databaseMatches(databaseID string, tenantID string, databaseName string) (*dbmodel.Database, bool) {
+	database, ok := t.databases[databaseID]
+	if !ok {
+		return nil, false
+	}
+	if tenantID != "" && database.TenantID != tenantID {
+		return nil, false
+	}
+	if databaseName != "" && database.Name != databaseName {
+		return nil, false
+	}
+	return database, true
+}
+
+func cloneString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	return &c
+}
+
+func cloneInt32(i *int32) *int32 {
+	if i == nil {
+		return nil
+	}
+	c := *i
+	return &c
+}
+
+func cloneInt64(i *int64) *int64 {
+	if i == nil {
+		return nil
+	}
+	c := *i
+	return &c
+}
+
+func cloneFloat64(f *float64) *float64 {
+	if f == nil {
+		return nil
+	}
+	c := *f
+	return &c
+}
+
+func cloneBool(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	c := *b
+	return &c
+}
+
+func cloneTenant(in *dbmodel.Tenant) *dbmodel.Tenant {
+	c := *in
+	return &c
+}
+
+func cloneDatabase(in *dbmodel.Database) *dbmodel.Database {
+	c := *in
+	return &c
+}
+
+func cloneCollection(in *dbmodel.Collection) *dbmodel.Collection {
+	c := *in
+	c.Name = cloneString(in.Name)
+	c.ConfigurationJsonStr = cloneString(in.ConfigurationJsonStr)
+	c.Dimension = cloneInt32(in.Dimension)
+	return &c
+}
+
+func cloneCollectionMetadata(in *dbmodel.CollectionMetadata) *dbmodel.CollectionMetadata {
+	c := *in
+	c.Key = cloneString(in.Key)
+	c.StrValue = cloneString(in.StrValue)
+	c.IntValue = cloneInt64(in.IntValue)
+	c.FloatValue = cloneFloat64(in.FloatValue)
+	c.BoolValue = cloneBool(in.BoolValue)
+	return &c
+}
+
+func cloneSegment(in *dbmodel.Segment) *dbmodel.Segment {
+	c := *in
+	c.CollectionID = cloneString(in.CollectionID)
+	c.FilePaths = cloneFilePaths(in.FilePaths)
+	return &c
+}
+
+func cloneFilePaths(in map[string][]string) map[string][]string {
+	if in == nil {
+		return nil
+	}
+	c := make(map[string][]string, len(in))
+	for k, v := range in {
+		c[k] = append([]string{}, v...)
+	}
+	return c
+}
+
+func cloneSegmentMetadata(in *dbmodel.SegmentMetadata) *dbmodel.SegmentMetadata {
+	c := *in
+	c.Key = cloneString(in.Key)
+	c.StrValue = cloneString(in.StrValue)
+	c.IntValue = cloneInt64(in.IntValue)
+	c.FloatValue = cloneFloat64(in.FloatValue)
+	c.BoolValue = cloneBool(in.BoolValue)
+	return &c
+}
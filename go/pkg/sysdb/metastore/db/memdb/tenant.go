@@ -0,0 +1,170 @@
+package memdb
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+type tenantDb struct {
+	*session
+}
+
+var _ dbmodel.ITenantDb = &tenantDb{}
+
+func (s *tenantDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.tenants = map[string]*dbmodel.Tenant{}
+		return nil
+	})
+}
+
+func (s *tenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+	err := s.read(func(t *tables) error {
+		for _, tenant := range t.tenants {
+			tenants = append(tenants, cloneTenant(tenant))
+		}
+		return nil
+	})
+	sort.Slice(tenants, func(i, j int) bool { return tenants[i].ID < tenants[j].ID })
+	return tenants, err
+}
+
+func (s *tenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
+	tenants := []*dbmodel.Tenant{}
+	err := s.read(func(t *tables) error {
+		if tenant, ok := t.tenants[tenantID]; ok && !tenant.IsDeleted {
+			tenants = append(tenants, cloneTenant(tenant))
+		}
+		return nil
+	})
+	return tenants, err
+}
+
+// GetTenantsForUpdate needs no lock, writes of the in-memory catalog are
+// serialized.
+func (s *tenantDb) GetTenantsForUpdate(tenantID string) ([]*dbmodel.Tenant, error) {
+	return s.GetTenants(tenantID)
+}
+
+func (s *tenantDb) GetSoftDeletedTenants(limit int32) ([]*dbmodel.Tenant, error) {
+	tenants := []*dbmodel.Tenant{}
+	err := s.read(func(t *tables) error {
+		for _, tenant := range t.tenants {
+			if tenant.IsDeleted {
+				tenants = append(tenants, cloneTenant(tenant))
+			}
+		}
+		return nil
+	})
+	sort.Slice(tenants, func(i, j int) bool { return tenants[i].UpdatedAt.Before(tenants[j].UpdatedAt) })
+	if len(tenants) > int(limit) {
+		tenants = tenants[:limit]
+	}
+	return tenants, err
+}
+
+func (s *tenantDb) ListTenants(namePrefix string, startAfter string, limit int32) ([]*dbmodel.Tenant, error) {
+	tenants := []*dbmodel.Tenant{}
+	err := s.read(func(t *tables) error {
+		for _, tenant := range t.tenants {
+			if tenant.IsDeleted || !strings.HasPrefix(tenant.ID, namePrefix) || tenant.ID <= startAfter {
+				continue
+			}
+			tenants = append(tenants, cloneTenant(tenant))
+		}
+		return nil
+	})
+	sort.Slice(tenants, func(i, j int) bool { return tenants[i].ID < tenants[j].ID })
+	if len(tenants) > int(limit) {
+		tenants = tenants[:limit]
+	}
+	return tenants, err
+}
+
+func (s *tenantDb) Insert(tenant *dbmodel.Tenant) error {
+	return s.write(func(t *tables) error {
+		if _, ok := t.tenants[tenant.ID]; ok {
+			return common.ErrTenantUniqueConstraintViolation
+		}
+		now := time.Now()
+		if tenant.CreatedAt.IsZero() {
+			tenant.CreatedAt = now
+		}
+		if tenant.UpdatedAt.IsZero() {
+			tenant.UpdatedAt = now
+		}
+		t.tenants[tenant.ID] = cloneTenant(tenant)
+		return nil
+	})
+}
+
+func (s *tenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error {
+	return s.write(func(t *tables) error {
+		tenant, ok := t.tenants[tenantID]
+		if !ok {
+			return common.ErrTenantNotFound
+		}
+		tenant.LastCompactionTime = lastCompactionTime
+		tenant.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+func (s *tenantDb) SoftDelete(tenantID string, ts types.Timestamp) error {
+	return s.write(func(t *tables) error {
+		tenant, ok := t.tenants[tenantID]
+		if !ok || tenant.IsDeleted {
+			return common.ErrTenantNotFound
+		}
+		tenant.IsDeleted = true
+		tenant.Ts = ts
+		tenant.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+func (s *tenantDb) UpdateTenantSuspended(tenantID string, isSuspended bool) error {
+	return s.write(func(t *tables) error {
+		tenant, ok := t.tenants[tenantID]
+		if !ok || tenant.IsDeleted {
+			return common.ErrTenantNotFound
+		}
+		tenant.IsSuspended = isSuspended
+		tenant.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+func (s *tenantDb) DeleteByID(tenantID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		if _, ok := t.tenants[tenantID]; ok {
+			delete(t.tenants, tenantID)
+			deleted = 1
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+func (s *tenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	tenants := []*dbmodel.Tenant{}
+	err := s.read(func(t *tables) error {
+		for _, tenantID := range tenantIDs {
+			if tenant, ok := t.tenants[tenantID]; ok {
+				tenants = append(tenants, &dbmodel.Tenant{
+					ID:                 tenant.ID,
+					LastCompactionTime: tenant.LastCompactionTime,
+				})
+			}
+		}
+		return nil
+	})
+	return tenants, err
+}
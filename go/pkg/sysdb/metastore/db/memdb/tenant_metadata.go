@@ -0,0 +1,91 @@
+package memdb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type tenantMetadataDb struct {
+	*session
+}
+
+var _ dbmodel.ITenantMetadataDb = &tenantMetadataDb{}
+
+func (s *tenantMetadataDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.tenantMetadata = map[string]map[string]*dbmodel.TenantMetadata{}
+		return nil
+	})
+}
+
+func (s *tenantMetadataDb) GetByTenantID(tenantID string) ([]*dbmodel.TenantMetadata, error) {
+	var metadata []*dbmodel.TenantMetadata
+	err := s.read(func(t *tables) error {
+		for _, row := range t.tenantMetadata[tenantID] {
+			metadata = append(metadata, cloneTenantMetadata(row))
+		}
+		sort.Slice(metadata, func(i, j int) bool { return *metadata[i].Key < *metadata[j].Key })
+		return nil
+	})
+	return metadata, err
+}
+
+func (s *tenantMetadataDb) DeleteByTenantID(tenantID string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		deleted = len(t.tenantMetadata[tenantID])
+		delete(t.tenantMetadata, tenantID)
+		return nil
+	})
+	return deleted, err
+}
+
+func (s *tenantMetadataDb) DeleteByTenantIDAndKeys(tenantID string, keys []string) (int, error) {
+	deleted := 0
+	err := s.write(func(t *tables) error {
+		rows := t.tenantMetadata[tenantID]
+		for _, key := range keys {
+			if _, ok := rows[key]; ok {
+				delete(rows, key)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// Insert upserts on (tenant_id, key) like the SQL implementation.
+func (s *tenantMetadataDb) Insert(in []*dbmodel.TenantMetadata) error {
+	return s.write(func(t *tables) error {
+		now := time.Now()
+		for _, metadata := range in {
+			rows, ok := t.tenantMetadata[metadata.TenantID]
+			if !ok {
+				rows = map[string]*dbmodel.TenantMetadata{}
+				t.tenantMetadata[metadata.TenantID] = rows
+			}
+			row := cloneTenantMetadata(metadata)
+			if existing, ok := rows[*metadata.Key]; ok {
+				row.CreatedAt = existing.CreatedAt
+			} else if row.CreatedAt.IsZero() {
+				row.CreatedAt = now
+			}
+			row.UpdatedAt = now
+			rows[*metadata.Key] = row
+		}
+		return nil
+	})
+}
+
+func cloneTenantMetadata(in *dbmodel.TenantMetadata) *dbmodel.TenantMetadata {
+	c := *in
+	c.Key = cloneString(in.Key)
+	c.StrValue = cloneString(in.StrValue)
+	c.IntValue = cloneInt64(in.IntValue)
+	c.FloatValue = cloneFloat64(in.FloatValue)
+	c.BoolValue = cloneBool(in.BoolValue)
+	return &c
+}
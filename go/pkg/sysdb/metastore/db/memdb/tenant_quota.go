@@ -0,0 +1,62 @@
+package memdb
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/sysdb/metastore/db/dbmodel"
+)
+
+type tenantQuotaDb struct {
+	*session
+}
+
+var _ dbmodel.ITenantQuotaDb = &tenantQuotaDb{}
+
+func (s *tenantQuotaDb) DeleteAll() error {
+	return s.write(func(t *tables) error {
+		t.tenantQuotas = map[string]*dbmodel.TenantQuota{}
+		return nil
+	})
+}
+
+func (s *tenantQuotaDb) Get(tenantID string) (*dbmodel.TenantQuota, error) {
+	var result *dbmodel.TenantQuota
+	err := s.read(func(t *tables) error {
+		if row, ok := t.tenantQuotas[tenantID]; ok {
+			result = cloneTenantQuota(row)
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (s *tenantQuotaDb) Upsert(in *dbmodel.TenantQuota) error {
+	return s.write(func(t *tables) error {
+		row := cloneTenantQuota(in)
+		now := time.Now()
+		row.CreatedAt = now
+		if existing, ok := t.tenantQuotas[in.TenantID]; ok {
+			row.CreatedAt = existing.CreatedAt
+		}
+		row.UpdatedAt = now
+		t.tenantQuotas[in.TenantID] = row
+		return nil
+	})
+}
+
+func (s *tenantQuotaDb) DeleteByTenantID(tenantID string) error {
+	return s.write(func(t *tables) error {
+		delete(t.tenantQuotas, tenantID)
+		return nil
+	})
+}
+
+func cloneTenantQuota(in *dbmodel.TenantQuota) *dbmodel.TenantQuota {
+	c := *in
+	c.MaxDatabases = cloneInt32(in.MaxDatabases)
+	c.MaxCollectionsPerDatabase = cloneInt32(in.MaxCollectionsPerDatabase)
+	c.MaxMetadataKeysPerCollection = cloneInt32(in.MaxMetadataKeysPerCollection)
+	c.MaxMetadataValueSize = cloneInt32(in.MaxMetadataValueSize)
+	c.MaxCollectionDimension = cloneInt32(in.MaxCollectionDimension)
+	return &c
+}
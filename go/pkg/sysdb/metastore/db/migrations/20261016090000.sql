@@ -0,0 +1,2 @@
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ADD COLUMN "is_suspended" boolean NULL DEFAULT false;
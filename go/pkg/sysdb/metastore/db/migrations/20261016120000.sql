@@ -0,0 +1,2 @@
+-- Create index "idx_database_id_created_at_id" to table: "collections"
+CREATE INDEX "idx_database_id_created_at_id" ON "public"."collections" ("database_id", "created_at", "id");
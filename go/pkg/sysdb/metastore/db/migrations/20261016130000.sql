@@ -0,0 +1,4 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "row_version" bigint NOT NULL DEFAULT 0;
+-- Modify "segments" table
+ALTER TABLE "public"."segments" ADD COLUMN "row_version" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,12 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "public"."idempotency_keys" (
+  "method" text NOT NULL,
+  "key" text NOT NULL,
+  "request_hash" text NOT NULL,
+  "response" bytea NULL,
+  "pending" boolean NOT NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("method", "key")
+);
+-- Create index "idx_idempotency_keys_created_at" to table: "idempotency_keys"
+CREATE INDEX "idx_idempotency_keys_created_at" ON "public"."idempotency_keys" ("created_at");
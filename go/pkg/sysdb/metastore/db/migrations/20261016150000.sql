@@ -0,0 +1,15 @@
+-- Create "notifications" table
+CREATE TABLE "public"."notifications" (
+  "id" bigserial NOT NULL,
+  "collection_id" text NOT NULL,
+  "tenant_id" text NOT NULL,
+  "database_id" text NOT NULL,
+  "database_name" text NOT NULL,
+  "type" text NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_notifications_created_at" to table: "notifications"
+CREATE INDEX "idx_notifications_created_at" ON "public"."notifications" ("created_at");
+-- Create index "idx_notifications_tenant_id_database_id_id" to table: "notifications"
+CREATE INDEX "idx_notifications_tenant_id_database_id_id" ON "public"."notifications" ("tenant_id", "database_id", "id");
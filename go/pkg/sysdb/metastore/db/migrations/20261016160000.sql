@@ -0,0 +1,17 @@
+-- Create "audit_events" table
+CREATE TABLE "public"."audit_events" (
+  "id" bigserial NOT NULL,
+  "actor" text NOT NULL,
+  "operation" text NOT NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "database_id" text NOT NULL DEFAULT '',
+  "collection_id" text NOT NULL DEFAULT '',
+  "before" text NULL,
+  "after" text NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_audit_events_created_at" to table: "audit_events"
+CREATE INDEX "idx_audit_events_created_at" ON "public"."audit_events" ("created_at");
+-- Create index "idx_audit_events_tenant_id_created_at" to table: "audit_events"
+CREATE INDEX "idx_audit_events_tenant_id_created_at" ON "public"."audit_events" ("tenant_id", "created_at");
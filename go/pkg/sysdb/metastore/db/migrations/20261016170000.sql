@@ -0,0 +1,12 @@
+-- Create "tenant_quotas" table
+CREATE TABLE "public"."tenant_quotas" (
+  "tenant_id" text NOT NULL,
+  "max_databases" integer NULL,
+  "max_collections_per_database" integer NULL,
+  "max_metadata_keys_per_collection" integer NULL,
+  "max_metadata_value_size" integer NULL,
+  "max_collection_dimension" integer NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("tenant_id")
+);
@@ -0,0 +1,24 @@
+-- Create "database_metadata" table
+CREATE TABLE "public"."database_metadata" (
+  "database_id" text NOT NULL,
+  "key" text NOT NULL,
+  "str_value" text NULL,
+  "int_value" bigint NULL,
+  "float_value" numeric NULL,
+  "bool_value" boolean NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("database_id", "key")
+);
+-- Create "tenant_metadata" table
+CREATE TABLE "public"."tenant_metadata" (
+  "tenant_id" text NOT NULL,
+  "key" text NOT NULL,
+  "str_value" text NULL,
+  "int_value" bigint NULL,
+  "float_value" numeric NULL,
+  "bool_value" boolean NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("tenant_id", "key")
+);
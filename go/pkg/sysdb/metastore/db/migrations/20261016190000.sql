@@ -0,0 +1,2 @@
+-- Modify "idempotency_keys" table
+ALTER TABLE "public"."idempotency_keys" ADD COLUMN "reserved_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP;
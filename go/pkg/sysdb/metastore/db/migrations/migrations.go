@@ -0,0 +1,9 @@
+// Package migrations embeds the Atlas migrations of the catalog so that Go
+// code can apply them without locating the source tree. Atlas itself only
+// reads the .sql files of this directory.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -20,6 +20,15 @@ func (id UniqueID) String() string {
 	return uuid.UUID(id).String()
 }
 
+// MarshalText encodes the id in its string form, as uuid.UUID does.
+func (id UniqueID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+func (id *UniqueID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
 func MustParse(s string) UniqueID {
 	return UniqueID(uuid.MustParse(s))
 }
@@ -14,6 +14,7 @@ use std::fmt::Debug;
 use std::sync::Arc;
 use std::time::Duration;
 use thiserror::Error;
+use tonic::metadata::MetadataValue;
 use tonic::service::interceptor;
 use tonic::transport::Endpoint;
 use tonic::Request;
@@ -167,6 +168,19 @@ impl Configurable<SysDbConfig> for GrpcSysDb {
     }
 }
 
+// Reads of sysdb may be served by a read replica that lags behind the primary.
+// The worker flushes the file paths it reads and must not query files that were
+// already garbage collected, so it always reads from the primary.
+const READ_YOUR_WRITES_HEADER: &str = "x-chroma-read-your-writes";
+
+fn read_your_writes<T>(message: T) -> Request<T> {
+    let mut request = Request::new(message);
+    request
+        .metadata_mut()
+        .insert(READ_YOUR_WRITES_HEADER, MetadataValue::from_static("true"));
+    request
+}
+
 impl GrpcSysDb {
     async fn get_collections(
         &mut self,
@@ -179,14 +193,16 @@ impl GrpcSysDb {
         let collection_id_str = collection_id.map(|id| String::from(id.0));
         let res = self
             .client
-            .get_collections(chroma_proto::GetCollectionsRequest {
+            .get_collections(read_your_writes(chroma_proto::GetCollectionsRequest {
                 id: collection_id_str,
                 name,
                 limit: None,
                 offset: None,
                 tenant: tenant.unwrap_or("".to_string()),
                 database: database.unwrap_or("".to_string()),
-            })
+                page_token: None,
+                r#where: None,
+            }))
             .await;
 
         match res {
@@ -216,13 +232,13 @@ impl GrpcSysDb {
     ) -> Result<Vec<Segment>, GetSegmentsError> {
         let res = self
             .client
-            .get_segments(chroma_proto::GetSegmentsRequest {
+            .get_segments(read_your_writes(chroma_proto::GetSegmentsRequest {
                 // TODO: modularize
                 id: id.as_ref().map(ToString::to_string),
                 r#type,
                 scope: scope.map(|x| x as i32),
                 collection: collection.to_string(),
-            })
+            }))
             .await;
         match res {
             Ok(res) => {